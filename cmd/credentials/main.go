@@ -0,0 +1,90 @@
+// The credentials command encrypts the credentials of an existing context configuration and rotates the key used for
+// encrypting them. The configuration is kept either in a file or, when running in a pod, in a Secret of the hosting
+// cluster. The application must be given the same key (credentialsKeyFile or KUBOXY_CREDENTIALS_KEY) after the
+// migration.
+//
+// Usage:
+//
+//	credentials -generateKey > ~/.kuboxy/credentials.key
+//	credentials -keyFile ~/.kuboxy/credentials.key
+//	credentials -keyFile ~/.kuboxy/new.key -oldKeyFile ~/.kuboxy/credentials.key
+//	credentials -store secret -secretName kuboxy-contexts -keyFile /etc/kuboxy/credentials.key
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/twuillemin/kuboxy/internal/configuration"
+	"github.com/twuillemin/kuboxy/pkg/context"
+)
+
+func main() {
+
+	store := flag.String("store", context.FileStore, "The backend storing the context configuration to encrypt: file or secret (a Secret of the hosting cluster)")
+	fileName := flag.String("file", filepath.Join(homeDir(), ".kuboxy", "kube.config"), "The context configuration file to encrypt, for the file store")
+	secretNamespace := flag.String("secretNamespace", "", "The namespace of the Secret storing the context configuration, by default the namespace of the pod")
+	secretName := flag.String("secretName", "", "The name of the Secret storing the context configuration, by default "+context.DefaultSecretName)
+	keyFileName := flag.String("keyFile", "", "The file holding the key for encrypting the credentials. If not given, the key is read from "+context.CredentialsKeyEnvironmentVariable)
+	oldKeyFileName := flag.String("oldKeyFile", "", "The file holding the key currently used, for rotating the key")
+	generateKey := flag.Bool("generateKey", false, "Generate a new key, encoded in base64, in the standard output")
+
+	flag.Parse()
+
+	if *generateKey {
+		key, err := context.GenerateCredentialsKey()
+		if err != nil {
+			log.Fatalf("Unable to generate a key due to error: \"%v\"", err)
+		}
+		fmt.Println(key)
+		return
+	}
+
+	key, err := context.LoadCredentialsKey(*keyFileName)
+	if err != nil {
+		log.Fatalf("Unable to read the key due to error: \"%v\"", err)
+	}
+	if key == nil {
+		log.Fatalf("A key must be given with -keyFile or with the environment variable %s", context.CredentialsKeyEnvironmentVariable)
+	}
+
+	var oldKey []byte
+	if len(*oldKeyFileName) > 0 {
+		oldKey, err = context.ReadCredentialsKeyFile(*oldKeyFileName)
+		if err != nil {
+			log.Fatalf("Unable to read the old key due to error: \"%v\"", err)
+		}
+	}
+
+	if *store != context.FileStore && *store != context.SecretStore {
+		log.Fatalf("The store must be either %s or %s", context.FileStore, context.SecretStore)
+	}
+
+	configStore, err := context.NewConfigStore(configuration.ApplicationConfiguration{
+		ContextStore:                 *store,
+		KubeContextConfigurationFile: *fileName,
+		ContextStoreSecretNamespace:  *secretNamespace,
+		ContextStoreSecretName:       *secretName,
+	})
+	if err != nil {
+		log.Fatalf("Unable to access the context configuration due to error: \"%v\"", err)
+	}
+
+	count, err := context.EncryptCredentials(configStore, key, oldKey)
+	if err != nil {
+		log.Fatalf("Unable to encrypt the credentials due to error: \"%v\"", err)
+	}
+
+	fmt.Printf("%d credentials encrypted in the %s store\n", count, *store)
+}
+
+// homeDir returns the home directory of the user depending on the OS
+func homeDir() string {
+	if h := os.Getenv("HOME"); h != "" {
+		return h
+	}
+	return os.Getenv("USERPROFILE") // windows
+}
@@ -1,14 +1,26 @@
 package main
 
 import (
+	stdcontext "context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
-	"log"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/twuillemin/kuboxy/internal/audit"
+	"github.com/twuillemin/kuboxy/internal/authentication"
+	"github.com/twuillemin/kuboxy/internal/authorization"
 	"github.com/twuillemin/kuboxy/internal/configuration"
 	"github.com/twuillemin/kuboxy/internal/controller"
 	"github.com/twuillemin/kuboxy/pkg/context"
+	"github.com/twuillemin/kuboxy/pkg/event"
 )
 
 func main() {
@@ -22,6 +34,21 @@ func main() {
 	}
 	config.Print()
 
+	// Check the configuration. Only the check may be requested
+	problems := config.Validate()
+	if len(problems) > 0 {
+		fmt.Printf("Problems found in the configuration:\n")
+		for _, problem := range problems {
+			fmt.Printf(" * %v\n", problem.Error())
+		}
+		os.Exit(1)
+	}
+
+	if configuration.IsCheckRequested() {
+		fmt.Printf("The configuration is valid\n")
+		return
+	}
+
 	// Then load the possible configuration
 	err = context.LoadContexts(config)
 	if err != nil {
@@ -30,52 +57,261 @@ func main() {
 
 	fmt.Printf("Contexts configuration loaded\n")
 
+	// Follow the modifications of the contexts configuration file
+	event.FollowConfigurationChanges()
+	stopWatching := make(chan struct{})
+	context.WatchContexts(stopWatching)
+
 	// Display the configuration
 	contextNames := context.GetContextNames()
 	for _, contextName := range contextNames {
 		fmt.Printf(" * %s\n", contextName)
 	}
 
+	// The TLS configuration and the middleware are shared by all the servers
+	tlsConfig, err := buildTLSConfig(config)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	middlewares, err := buildMiddlewares(config)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	// The policy must be set before the controllers are registered
+	if err = setAuthorizationPolicy(config); err != nil {
+		panic(err.Error())
+	}
+
+	if err = startAudit(config); err != nil {
+		panic(err.Error())
+	}
+
 	fmt.Printf("Starting service\n")
 
-	// Create the REST server
-	go createServer(
-		fmt.Sprintf("%s:%d", config.Address, config.RestPort),
-		config.CertificateFileName,
-		config.PrivateKeyFileName,
-		controller.RegisterControllers)
-
-	// Create the WebSocket server
-	go createServer(
-		fmt.Sprintf("%s:%d", config.Address, config.WebSocketPort),
-		config.CertificateFileName,
-		config.PrivateKeyFileName,
-		controller.RegisterEventWebSocketController)
-
-	// Wait until the end of the world
-	<-make(chan interface{})
+	// The errors of the servers, which stop the application
+	serverErrors := make(chan error, 2)
+	servers := make([]*echo.Echo, 0, 2)
+
+	if config.SinglePort {
+
+		// Create a single server for the REST services and the WebSocket
+		servers = append(servers, createServer(
+			fmt.Sprintf("%s:%d", config.Address, config.RestPort),
+			tlsConfig,
+			middlewares,
+			controller.RegisterSinglePortControllers,
+			serverErrors))
+
+	} else {
+
+		// Create the REST server
+		servers = append(servers, createServer(
+			fmt.Sprintf("%s:%d", config.Address, config.RestPort),
+			tlsConfig,
+			middlewares,
+			controller.RegisterControllers,
+			serverErrors))
+
+		// Create the WebSocket server
+		servers = append(servers, createServer(
+			fmt.Sprintf("%s:%d", config.Address, config.WebSocketPort),
+			tlsConfig,
+			middlewares,
+			controller.RegisterEventWebSocketController,
+			serverErrors))
+	}
+
+	// Wait until the application is asked to stop, or until a server fails
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	exitCode := 0
+	select {
+	case receivedSignal := <-signals:
+		fmt.Printf("Received signal %v, stopping\n", receivedSignal)
+	case err = <-serverErrors:
+		fmt.Printf("Unable to start server due to error: \"%v\"\n", err)
+		exitCode = 1
+	}
+
+	if !shutdown(servers, stopWatching, config.ShutdownTimeout) {
+		exitCode = 1
+	}
+
+	os.Exit(exitCode)
 }
 
-func createServer(address string, certificateFileName string, privateKeyFileName string, controllerRegistration func(e *echo.Echo)) {
+// createServer creates a server and starts it in the background. If the server can not be started or fails, the error
+// is sent to the given channel
+func createServer(address string, tlsConfig *tls.Config, middlewares []echo.MiddlewareFunc, controllerRegistration func(e *echo.Echo), serverErrors chan<- error) *echo.Echo {
 
-	// Create an Echo server with the basic middleware
+	// Create an Echo server with the middleware shared by all the servers
 	e := echo.New()
-	e.Use(middleware.Logger())
-	e.Use(middleware.Recover())
+	e.Use(middlewares...)
 
 	// Register the controllers
 	controllerRegistration(e)
 
 	// Start the Server
-	var err error
-	if len(certificateFileName) > 0 && len(privateKeyFileName) > 0 {
-		err = e.StartTLS(address, certificateFileName, privateKeyFileName)
+	go func() {
+		var err error
+		if tlsConfig != nil {
+			e.TLSServer.Addr = address
+			e.TLSServer.TLSConfig = tlsConfig.Clone()
+			err = e.StartServer(e.TLSServer)
+		} else {
+			err = e.Start(address)
+		}
+
+		// The server returns ErrServerClosed when it is stopped
+		if err != nil && err != http.ErrServerClosed {
+			serverErrors <- err
+		}
+	}()
+
+	return e
+}
+
+// shutdown stops the application within the given duration: the servers stop accepting connections, the websockets
+// are closed and the requests in progress are finished. Then the watch of the context configuration, given by its
+// stop channel, and the receivers of the events are stopped. If everything could not be done in time, false is returned
+func shutdown(servers []*echo.Echo, stopWatching chan struct{}, timeout time.Duration) bool {
+
+	shutdownContext, cancel := stdcontext.WithTimeout(stdcontext.Background(), timeout)
+	defer cancel()
+
+	// Stop the servers in parallel, as each one waits for its requests in progress
+	shutdownErrors := make(chan error, len(servers))
+	for _, server := range servers {
+		go func(server *echo.Echo) {
+			shutdownErrors <- server.Shutdown(shutdownContext)
+		}(server)
+	}
+
+	// The servers don't follow the websockets once upgraded, so they are closed separately
+	success := true
+	if !controller.CloseEventWebSockets(shutdownContext.Done()) {
+		fmt.Printf("Unable to close all the websockets in %v\n", timeout)
+		success = false
+	}
+
+	for range servers {
+		if err := <-shutdownErrors; err != nil {
+			fmt.Printf("Unable to stop a server due to: %v\n", err.Error())
+			success = false
+		}
+	}
+
+	close(stopWatching)
+	event.StopAllContextReceivers()
+
+	// The requests are finished, so the audit is complete
+	if err := audit.CloseSinks(); err != nil {
+		fmt.Printf("Unable to close the audit due to error: \"%v\"\n", err)
+		success = false
+	}
+
+	fmt.Printf("Stopped\n")
+
+	return success
+}
+
+// buildTLSConfig creates the TLS configuration of the servers, nil if HTTPS is not used. If a client certificate
+// authority is given, the client certificates are requested and verified, but not required so that the other
+// authentication methods are still available
+func buildTLSConfig(config configuration.ApplicationConfiguration) (*tls.Config, error) {
+
+	if len(config.CertificateFileName) == 0 || len(config.PrivateKeyFileName) == 0 {
+		return nil, nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(config.CertificateFileName, config.PrivateKeyFileName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the certificate due to: %v", err.Error())
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		NextProtos:   []string{"h2"},
+	}
+
+	if len(config.ClientCAFileName) > 0 {
+		clientCA, err := ioutil.ReadFile(config.ClientCAFileName)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the client certificate authority due to: %v", err.Error())
+		}
+
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(clientCA) {
+			return nil, fmt.Errorf("no certificate found in the client certificate authority %s", config.ClientCAFileName)
+		}
+
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return tlsConfig, nil
+}
+
+// buildMiddlewares creates the middleware used by all the servers, whatever the controllers they serve. The audit
+// follows the authentication so that the callers are known
+func buildMiddlewares(config configuration.ApplicationConfiguration) ([]echo.MiddlewareFunc, error) {
+
+	middlewares := []echo.MiddlewareFunc{
+		controller.RedactQueryTokenMiddleware,
+		middleware.Logger(),
+		middleware.Recover(),
+	}
+
+	if len(config.AuthenticationFile) == 0 {
+		fmt.Printf("No authentication file given: the authentication is disabled\n")
 	} else {
-		err = e.Start(address)
+		authenticators, err := authentication.LoadAuthenticators(config.AuthenticationFile)
+		if err != nil {
+			return nil, err
+		}
+		middlewares = append(middlewares, controller.AuthenticationMiddleware(authenticators))
+	}
+
+	return append(middlewares, controller.AuditMiddleware(config.AuditRequestBodies)), nil
+}
+
+// setAuthorizationPolicy loads the policy authorizing the requests, if any
+func setAuthorizationPolicy(config configuration.ApplicationConfiguration) error {
+
+	if len(config.AuthorizationFile) == 0 {
+		fmt.Printf("No authorization file given: all the requests are allowed\n")
+		return nil
 	}
 
-	// In case of error, quit
+	policy, err := authorization.LoadPolicy(config.AuthorizationFile)
 	if err != nil {
-		log.Fatalf("Unable to start server due to error: \"%v\"", err)
+		return err
+	}
+
+	controller.SetAuthorizationPolicy(policy)
+
+	return nil
+}
+
+// startAudit creates the sinks receiving the audit of the modifications
+func startAudit(config configuration.ApplicationConfiguration) error {
+
+	if len(config.AuditFile) > 0 {
+		fileSink, err := audit.NewFileSink(config.AuditFile, int64(config.AuditFileMaxSize)*1024*1024, config.AuditFileMaxBackups)
+		if err != nil {
+			return err
+		}
+		audit.AddSink(fileSink)
 	}
+
+	if config.AuditMemorySize > 0 {
+		memorySink := audit.NewMemorySink(config.AuditMemorySize)
+		audit.AddSink(memorySink)
+		controller.SetAuditMemorySink(memorySink)
+	}
+
+	return nil
 }
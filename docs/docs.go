@@ -1,6 +1,6 @@
 // GENERATED BY THE COMMAND ABOVE; DO NOT EDIT
 // This file was generated by swaggo/swag at
-// 2019-06-17 22:26:55.413497284 +0300 EEST m=+0.145456388
+// 2026-10-18 06:54:27.991029142 +0000 UTC m=+0.212553002
 
 package docs
 
@@ -14,18 +14,113 @@ import (
 var doc = `{
     "swagger": "2.0",
     "info": {
-        "description": "{{.Description}}",
-        "title": "{{.Title}}",
-        "contact": {},
-        "license": {},
-        "version": "{{.Version}}"
+        "description": "A single proxy for multiple Kubernetes clusters",
+        "title": "Kubernetes Proxy",
+        "contact": {
+            "name": "Thomas Wuillemin",
+            "url": "http://www.wuillemin.net/",
+            "email": "thomas.wuillemin@gmail.com"
+        },
+        "license": {
+            "name": "Apache 2.0",
+            "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+        },
+        "version": "0.3.0"
     },
-    "host": "{{.Host}}",
-    "basePath": "{{.BasePath}}",
+    "host": "localhost:8080",
+    "basePath": "/api/v1",
     "paths": {
+        "/api/v1/audit": {
+            "get": {
+                "description": "Get the most recent entries of the audit of the requests creating, updating or deleting the objects or the configuration, the most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audit"
+                ],
+                "summary": "Get the audit of the modifications",
+                "operationId": "get-audit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the caller",
+                        "name": "user",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the type of the objects",
+                        "name": "objectType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the verb: create, update or delete",
+                        "name": "verb",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the outcome: success or failure",
+                        "name": "outcome",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the oldest time of the entries, in RFC 3339 format",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the maximum number of entries",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/audit.Entry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/configuration/": {
             "get": {
-                "description": "get the configuration",
+                "description": "get the configuration. The credentials of the users are replaced by REDACTED unless the reveal\nparameter is set, revealing the credentials is allowed by the configuration of the application and\nthe verb reveal on the configuration is explicitly granted by the authorization policy",
                 "produces": [
                     "application/json"
                 ],
@@ -34,6 +129,14 @@ var doc = `{
                 ],
                 "summary": "Retrieve the configuration",
                 "operationId": "get-configuration",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "return the credentials in clear text",
+                        "name": "reveal",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -42,6 +145,97 @@ var doc = `{
                             "$ref": "#/definitions/context.KubeConfig"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/configuration/certificates/expiring": {
+            "get": {
+                "description": "get the client certificates of the users, the certificate authorities of the clusters and the\ncertificate of the application that expire within the given number of days. The certificates already\nexpired and the certificates that can not be read are also returned",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configuration"
+                ],
+                "summary": "Retrieve the certificates expiring soon",
+                "operationId": "get-configuration-expiring-certificates",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "the number of days, 30 by default",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/context.ExpiringCertificate"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/configuration/client-settings/": {
+            "get": {
+                "description": "get the settings of the clients (QPS, burst, timeout, user agent and proxy) of the contexts having some",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Configuration"
+                ],
+                "summary": "Retrieve the settings of the clients",
+                "operationId": "get-configuration-client-settings",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/context.NamedClientSettings"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -54,7 +248,7 @@ var doc = `{
         },
         "/api/v1/configuration/clusters/": {
             "get": {
-                "description": "get the clusters",
+                "description": "get the clusters. The certificate authorities are described (subject, issuer, SANs, validity and days\nremaining)",
                 "produces": [
                     "application/json"
                 ],
@@ -83,6 +277,70 @@ var doc = `{
                 }
             }
         },
+        "/api/v1/configuration/clusters/{name}": {
+            "delete": {
+                "description": "Delete an existing cluster. If the cluster is still used by some contexts, the deletion is refused unless\nthe cascade parameter is set, in which case the contexts are deleted as well",
+                "tags": [
+                    "Configuration"
+                ],
+                "summary": "Delete an existing cluster",
+                "operationId": "delete-configuration-cluster",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the cluster in the configuration",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete also the contexts using the cluster",
+                        "name": "cascade",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/configuration/clusters/{name}/certificate-embedded": {
             "put": {
                 "description": "Update an existing cluster for which the TLS certificate is given embedded (raw text)",
@@ -624,27 +882,29 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/configuration/users/": {
-            "get": {
-                "description": "get the users",
-                "produces": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Delete an existing context. The events received from the context are stopped",
                 "tags": [
                     "Configuration"
                 ],
-                "summary": "Retrieve the users",
-                "operationId": "get-configuration-users",
+                "summary": "Delete an existing context",
+                "operationId": "delete-configuration-context",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context in the configuration",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/context.NamedUser"
-                            }
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
                         }
                     },
                     "500": {
@@ -657,37 +917,24 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/configuration/users/{name}/certificate-embedded": {
-            "put": {
-                "description": "Update an existing user by giving its name in the configuration and its certificates embedded",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/configuration/contexts/{name}/client-settings": {
+            "get": {
+                "description": "get the settings of the clients (QPS, burst, timeout, user agent and proxy) of a context",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Configuration"
                 ],
-                "summary": "Update an existing user with the certificates embedded",
-                "operationId": "put-configuration-user-embedded",
+                "summary": "Retrieve the settings of the clients of a context",
+                "operationId": "get-configuration-context-client-settings",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the user in the configuration",
+                        "description": "the name of the context in the configuration",
                         "name": "name",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "the credentials",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/context.ParamCredentialsCertificateEmbedded"
-                        }
                     }
                 ],
                 "responses": {
@@ -695,7 +942,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/context.NamedUser"
+                            "$ref": "#/definitions/context.NamedClientSettings"
                         }
                     },
                     "404": {
@@ -705,13 +952,6 @@ var doc = `{
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -721,8 +961,8 @@ var doc = `{
                     }
                 }
             },
-            "post": {
-                "description": "Create a new user by giving its name in the configuration and its certificates embedded",
+            "put": {
+                "description": "Define the settings of the clients of a context: QPS, burst, request timeout (such as 30s), a suffix for\nthe user agent and a proxy. Empty values keep the default behaviour. The clients of the context are\nrebuilt on next use",
                 "consumes": [
                     "application/json"
                 ],
@@ -732,24 +972,24 @@ var doc = `{
                 "tags": [
                     "Configuration"
                 ],
-                "summary": "Create a new user with the certificates embedded",
-                "operationId": "post-configuration-user-embedded",
+                "summary": "Define the settings of the clients of a context",
+                "operationId": "put-configuration-context-client-settings",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the user in the configuration",
+                        "description": "the name of the context in the configuration",
                         "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "the credentials",
+                        "description": "the settings of the clients",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/context.ParamCredentialsCertificateEmbedded"
+                            "$ref": "#/definitions/context.ParamClientSettings"
                         }
                     }
                 ],
@@ -758,7 +998,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/context.NamedUser"
+                            "$ref": "#/definitions/context.NamedClientSettings"
                         }
                     },
                     "400": {
@@ -768,8 +1008,8 @@ var doc = `{
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
@@ -783,49 +1023,24 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/configuration/users/{name}/certificate-file": {
-            "put": {
-                "description": "Update an existing user by giving its name in the configuration and its certificates as local files",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Delete the settings of the clients of a context, which then uses the default settings",
                 "tags": [
                     "Configuration"
                 ],
-                "summary": "Update an existing user with the certificates given as local files",
-                "operationId": "put-configuration-user-file",
+                "summary": "Delete the settings of the clients of a context",
+                "operationId": "delete-configuration-context-client-settings",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the user in the configuration",
+                        "description": "the name of the context in the configuration",
                         "name": "name",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "the credentials",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/context.ParamCredentialsCertificateFile"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/context.NamedUser"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -833,13 +1048,6 @@ var doc = `{
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -848,37 +1056,26 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new user by giving its name in the configuration and its certificates as local files",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/api/v1/configuration/contexts/{name}/status": {
+            "get": {
+                "description": "Check whether a context actually works: dial the API server, report its version and the round-trip\nlatency, check the TLS and the credentials, the availability of the metrics-server and the permissions\nof the identity in the namespace of the context",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Configuration"
                 ],
-                "summary": "Create a new user with the certificates given as local files",
-                "operationId": "post-configuration-user-file",
+                "summary": "Probe a context",
+                "operationId": "get-configuration-context-status",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the user in the configuration",
+                        "description": "the name of the context in the configuration",
                         "name": "name",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "the credentials",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/context.ParamCredentialsCertificateFile"
-                        }
                     }
                 ],
                 "responses": {
@@ -886,18 +1083,11 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/context.NamedUser"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/HTTPError"
+                            "$ref": "#/definitions/context.ContextHealth"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
@@ -913,37 +1103,43 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/configuration/users/{name}/username-password": {
-            "put": {
-                "description": "Update an existing user by giving its name in the configuration and the username and the\npassword to connect to the server",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/configuration/export": {
+            "get": {
+                "description": "Generate a kubeconfig holding only the requested contexts, along with the clusters and the users\nthey are referencing. As the credentials are exported in clear text, the export is refused if\nrevealing the credentials is disabled by the configuration of the application or if the verb reveal\non the configuration of the exported contexts is not explicitly granted by the authorization policy",
                 "produces": [
+                    "application/x-yaml",
                     "application/json"
                 ],
                 "tags": [
                     "Configuration"
                 ],
-                "summary": "Update an existing user",
-                "operationId": "put-configuration-user-username-password",
+                "summary": "Export a kubeconfig",
+                "operationId": "get-configuration-export",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "the name of the user in the configuration",
-                        "name": "name",
-                        "in": "path",
+                        "type": "[]string",
+                        "description": "the name of the contexts to export",
+                        "name": "context",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "the credentials",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/context.ParamCredentialsUserNamePassword"
-                        }
+                        "type": "boolean",
+                        "description": "embed the certificates given as local files, if they were referenced at startup or are in an allowed directory",
+                        "name": "inline",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the current context of the kubeconfig (default the first exported context)",
+                        "name": "currentContext",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the format of the kubeconfig: yaml (default) or json",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -951,18 +1147,25 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/context.NamedUser"
+                            "$ref": "#/definitions/context.KubeConfig"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
@@ -976,11 +1179,14 @@ var doc = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/configuration/import": {
             "post": {
-                "description": "Create a new user by giving its name in the configuration and the username and the\npassword to connect to the server",
+                "description": "Merge the clusters, users and contexts of a kubeconfig document (YAML or JSON) into the configuration.\nWhen an object already exists, it is skipped, overwritten or imported under a new name depending on\nthe strategy. In dry run mode, the changes are reported but not applied",
                 "consumes": [
-                    "application/json"
+                    "application/json",
+                    "application/x-yaml"
                 ],
                 "produces": [
                     "application/json"
@@ -988,25 +1194,36 @@ var doc = `{
                 "tags": [
                     "Configuration"
                 ],
-                "summary": "Create a new user",
-                "operationId": "post-configuration-user-username-password",
+                "summary": "Import a kubeconfig",
+                "operationId": "post-configuration-import",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "the name of the user in the configuration",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "the credentials",
+                        "description": "the kubeconfig to import",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/context.ParamCredentialsUserNamePassword"
+                            "$ref": "#/definitions/context.KubeConfig"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "the conflict strategy: skip (default), overwrite or rename",
+                        "name": "strategy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the suffix added to the name of the renamed objects (default -imported)",
+                        "name": "suffix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only report the changes without applying them",
+                        "name": "dryRun",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1014,7 +1231,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/context.NamedUser"
+                            "$ref": "#/definitions/context.ImportReport"
                         }
                     },
                     "400": {
@@ -1024,13 +1241,6 @@ var doc = `{
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1041,80 +1251,68 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/events/": {
+        "/api/v1/configuration/states/": {
             "get": {
-                "description": "the websocket used for receiving the configuration and then return the requested events. Each event is a full object when created / updated / deleted",
+                "description": "get the contexts known by the application along with the state of their connection",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Events"
-                ],
-                "summary": "Connect to a WebSocket for managing events (port 8081)",
-                "operationId": "get-events-by-websocket",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "the name of the configuration",
-                        "name": "contextName",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Configuration"
                 ],
+                "summary": "Retrieve the state of the contexts",
+                "operationId": "get-configuration-states",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/HTTPError"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/context.ContextState"
+                            }
                         }
                     }
                 }
             }
         },
-        "/api/v1/labels/{contextName}/{namespace}": {
+        "/api/v1/configuration/users/": {
             "get": {
-                "description": "Get all the labels and their values",
+                "description": "get the users. The credentials are replaced by REDACTED unless the reveal parameter is set,\nrevealing the credentials is allowed by the configuration of the application and the verb reveal on\nthe configuration is explicitly granted by the authorization policy. The client certificates\nare described (subject, issuer, SANs, validity and days remaining)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
+                    "Configuration"
                 ],
-                "summary": "Get all the labels and their values",
-                "operationId": "get-labels",
+                "summary": "Retrieve the users",
+                "operationId": "get-configuration-users",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "return the credentials in clear text",
+                        "name": "reveal",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/context.NamedUser"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/MapOfStrings"
+                            "$ref": "#/definitions/HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
@@ -1130,24 +1328,27 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/clusterRoleBindings": {
-            "get": {
-                "description": "Get all clusterRoleBindings",
-                "produces": [
-                    "application/json"
-                ],
+        "/api/v1/configuration/users/{name}": {
+            "delete": {
+                "description": "Delete an existing user. If the user is still used by some contexts, the deletion is refused unless\nthe cascade parameter is set, in which case the contexts are deleted as well",
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "Configuration"
                 ],
-                "summary": "Get all clusterRoleBindings",
-                "operationId": "get-object-clusterRoleBindings",
+                "summary": "Delete an existing user",
+                "operationId": "delete-configuration-user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
+                        "description": "the name of the user in the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "delete also the contexts using the user",
+                        "name": "cascade",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1156,10 +1357,17 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/ClusterRoleBinding"
+                                "type": "string"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1167,17 +1375,26 @@ var doc = `{
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
                         }
-                    }
-                }
-            },
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/configuration/users/{name}/auth-provider": {
             "put": {
-                "description": "Update a clusterRoleBinding.",
+                "description": "Update an existing user by giving its name in the configuration and the authentication provider (gcp, oidc, azure, ...) providing its credentials",
                 "consumes": [
                     "application/json"
                 ],
@@ -1185,26 +1402,26 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "Configuration"
                 ],
-                "summary": "Update a clusterRoleBinding",
-                "operationId": "update-object-clusterRoleBinding",
+                "summary": "Update an existing user with credentials provided by an authentication provider",
+                "operationId": "put-configuration-user-auth-provider",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
+                        "description": "the name of the user in the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "the definition of the clusterRoleBinding",
+                        "description": "the credentials",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ClusterRoleBinding"
+                            "$ref": "#/definitions/context.ParamCredentialsAuthProvider"
                         }
                     }
                 ],
@@ -1213,18 +1430,18 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ClusterRoleBinding"
+                            "$ref": "#/definitions/context.NamedUser"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
@@ -1240,7 +1457,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a clusterRoleBinding.",
+                "description": "Create a new user by giving its name in the configuration and the authentication provider (gcp, oidc, azure, ...) providing its credentials",
                 "consumes": [
                     "application/json"
                 ],
@@ -1248,26 +1465,26 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "Configuration"
                 ],
-                "summary": "Create a clusterRoleBinding",
-                "operationId": "create-object-clusterRoleBinding",
+                "summary": "Create a new user with credentials provided by an authentication provider",
+                "operationId": "post-configuration-user-auth-provider",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
+                        "description": "the name of the user in the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "the definition of the clusterRoleBinding",
+                        "description": "the credentials",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ClusterRoleBinding"
+                            "$ref": "#/definitions/context.ParamCredentialsAuthProvider"
                         }
                     }
                 ],
@@ -1276,7 +1493,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ClusterRoleBinding"
+                            "$ref": "#/definitions/context.NamedUser"
                         }
                     },
                     "400": {
@@ -1286,8 +1503,8 @@ var doc = `{
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
@@ -1303,31 +1520,37 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/clusterRoleBindings/{name}": {
-            "get": {
-                "description": "Get a clusterRoleBinding by name",
+        "/api/v1/configuration/users/{name}/certificate-embedded": {
+            "put": {
+                "description": "Update an existing user by giving its name in the configuration and its certificates embedded",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "Configuration"
                 ],
-                "summary": "Get a clusterRoleBinding",
-                "operationId": "get-object-clusterRoleBinding",
+                "summary": "Update an existing user with the certificates embedded",
+                "operationId": "put-configuration-user-embedded",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
+                        "description": "the name of the user in the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the object",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
+                        "description": "the credentials",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/context.ParamCredentialsCertificateEmbedded"
+                        }
                     }
                 ],
                 "responses": {
@@ -1335,7 +1558,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ClusterRoleBinding"
+                            "$ref": "#/definitions/context.NamedUser"
                         }
                     },
                     "404": {
@@ -1345,6 +1568,13 @@ var doc = `{
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1354,32 +1584,55 @@ var doc = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Delete a clusterRoleBinding by name",
+            "post": {
+                "description": "Create a new user by giving its name in the configuration and its certificates embedded",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "Configuration"
                 ],
-                "summary": "Delete a clusterRoleBinding",
-                "operationId": "delete-object-clusterRoleBinding",
+                "summary": "Create a new user with the certificates embedded",
+                "operationId": "post-configuration-user-embedded",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
+                        "description": "the name of the user in the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the object",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
+                        "description": "the credentials",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/context.ParamCredentialsCertificateEmbedded"
+                        }
                     }
                 ],
                 "responses": {
-                    "404": {
-                        "description": "Not Found",
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/context.NamedUser"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
@@ -1395,34 +1648,45 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/clusterRoles": {
-            "get": {
-                "description": "Get all clusterRoles",
+        "/api/v1/configuration/users/{name}/certificate-file": {
+            "put": {
+                "description": "Update an existing user by giving its name in the configuration and its certificates as local files",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "Configuration"
                 ],
-                "summary": "Get all clusterRoles",
-                "operationId": "get-object-clusterRoles",
+                "summary": "Update an existing user with the certificates given as local files",
+                "operationId": "put-configuration-user-file",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
+                        "description": "the name of the user in the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "the credentials",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/context.ParamCredentialsCertificateFile"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/ClusterRole"
-                            }
+                            "type": "object",
+                            "$ref": "#/definitions/context.NamedUser"
                         }
                     },
                     "404": {
@@ -1432,6 +1696,13 @@ var doc = `{
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1441,8 +1712,8 @@ var doc = `{
                     }
                 }
             },
-            "put": {
-                "description": "Update a clusterRole.",
+            "post": {
+                "description": "Create a new user by giving its name in the configuration and its certificates as local files",
                 "consumes": [
                     "application/json"
                 ],
@@ -1450,26 +1721,26 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "Configuration"
                 ],
-                "summary": "Update a clusterRole",
-                "operationId": "update-object-clusterRole",
+                "summary": "Create a new user with the certificates given as local files",
+                "operationId": "post-configuration-user-file",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
+                        "description": "the name of the user in the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "the definition of the clusterRole",
+                        "description": "the credentials",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ClusterRole"
+                            "$ref": "#/definitions/context.ParamCredentialsCertificateFile"
                         }
                     }
                 ],
@@ -1478,7 +1749,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ClusterRole"
+                            "$ref": "#/definitions/context.NamedUser"
                         }
                     },
                     "400": {
@@ -1488,8 +1759,8 @@ var doc = `{
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
@@ -1503,9 +1774,11 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a clusterRole.",
+            }
+        },
+        "/api/v1/configuration/users/{name}/exec": {
+            "put": {
+                "description": "Update an existing user by giving its name in the configuration and the external command providing its credentials",
                 "consumes": [
                     "application/json"
                 ],
@@ -1513,26 +1786,26 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "Configuration"
                 ],
-                "summary": "Create a clusterRole",
-                "operationId": "create-object-clusterRole",
+                "summary": "Update an existing user with credentials provided by an external command",
+                "operationId": "put-configuration-user-exec",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
+                        "description": "the name of the user in the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "the definition of the clusterRole",
+                        "description": "the credentials",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ClusterRole"
+                            "$ref": "#/definitions/context.ParamCredentialsExec"
                         }
                     }
                 ],
@@ -1541,18 +1814,18 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ClusterRole"
+                            "$ref": "#/definitions/context.NamedUser"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
@@ -1566,33 +1839,37 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/objects/{contextName}/clusterRoles/{name}": {
-            "get": {
-                "description": "Get a clusterRole by name",
+            },
+            "post": {
+                "description": "Create a new user by giving its name in the configuration and the external command providing its credentials",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "Configuration"
                 ],
-                "summary": "Get a clusterRole",
-                "operationId": "get-object-clusterRole",
+                "summary": "Create a new user with credentials provided by an external command",
+                "operationId": "post-configuration-user-exec",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
+                        "description": "the name of the user in the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the object",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
+                        "description": "the credentials",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/context.ParamCredentialsExec"
+                        }
                     }
                 ],
                 "responses": {
@@ -1600,11 +1877,18 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ClusterRole"
+                            "$ref": "#/definitions/context.NamedUser"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
@@ -1618,31 +1902,49 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a clusterRole by name",
+            }
+        },
+        "/api/v1/configuration/users/{name}/token": {
+            "put": {
+                "description": "Update an existing user by giving its name in the configuration and its bearer token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "Configuration"
                 ],
-                "summary": "Delete a clusterRole",
-                "operationId": "delete-object-clusterRole",
+                "summary": "Update an existing user with a bearer token",
+                "operationId": "put-configuration-user-token",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
+                        "description": "the name of the user in the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the object",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
+                        "description": "the credentials",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/context.ParamCredentialsToken"
+                        }
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/context.NamedUser"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1650,6 +1952,13 @@ var doc = `{
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1658,47 +1967,56 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/objects/{contextName}/configMaps/{namespace}": {
-            "get": {
-                "description": "Get all configMaps",
+            },
+            "post": {
+                "description": "Create a new user by giving its name in the configuration and its bearer token",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "Configuration"
                 ],
-                "summary": "Get all configMaps",
-                "operationId": "get-object-configMaps",
+                "summary": "Create a new user with a bearer token",
+                "operationId": "post-configuration-user-token",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
+                        "description": "the name of the user in the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
+                        "description": "the credentials",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/context.ParamCredentialsToken"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/ConfigMap"
-                            }
+                            "type": "object",
+                            "$ref": "#/definitions/context.NamedUser"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
@@ -1712,9 +2030,11 @@ var doc = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/configuration/users/{name}/token-file": {
             "put": {
-                "description": "Update a configMap.",
+                "description": "Update an existing user by giving its name in the configuration and its bearer token given as a local file",
                 "consumes": [
                     "application/json"
                 ],
@@ -1722,33 +2042,26 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "Configuration"
                 ],
-                "summary": "Update a configMap",
-                "operationId": "update-object-configMap",
+                "summary": "Update an existing user with a bearer token given as a local file",
+                "operationId": "put-configuration-user-token-file",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
+                        "description": "the name of the user in the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "the definition of the configMap",
+                        "description": "the credentials",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ConfigMap"
+                            "$ref": "#/definitions/context.ParamCredentialsTokenFile"
                         }
                     }
                 ],
@@ -1757,18 +2070,18 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ConfigMap"
+                            "$ref": "#/definitions/context.NamedUser"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
@@ -1784,7 +2097,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a configMap.",
+                "description": "Create a new user by giving its name in the configuration and its bearer token given as a local file",
                 "consumes": [
                     "application/json"
                 ],
@@ -1792,33 +2105,26 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "Configuration"
                 ],
-                "summary": "Create a configMap",
-                "operationId": "create-object-configMap",
+                "summary": "Create a new user with a bearer token given as a local file",
+                "operationId": "post-configuration-user-token-file",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
+                        "description": "the name of the user in the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "the definition of the configMap",
+                        "description": "the credentials",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ConfigMap"
+                            "$ref": "#/definitions/context.ParamCredentialsTokenFile"
                         }
                     }
                 ],
@@ -1827,7 +2133,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ConfigMap"
+                            "$ref": "#/definitions/context.NamedUser"
                         }
                     },
                     "400": {
@@ -1837,8 +2143,8 @@ var doc = `{
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
@@ -1854,38 +2160,37 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/configMaps/{namespace}/{name}": {
-            "get": {
-                "description": "Get a configMap by name",
+        "/api/v1/configuration/users/{name}/username-password": {
+            "put": {
+                "description": "Update an existing user by giving its name in the configuration and the username and the\npassword to connect to the server",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "Configuration"
                 ],
-                "summary": "Get a configMap",
-                "operationId": "get-object-configMap",
+                "summary": "Update an existing user",
+                "operationId": "put-configuration-user-username-password",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
+                        "description": "the name of the user in the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the object",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
+                        "description": "the credentials",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/context.ParamCredentialsUserNamePassword"
+                        }
                     }
                 ],
                 "responses": {
@@ -1893,7 +2198,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ConfigMap"
+                            "$ref": "#/definitions/context.NamedUser"
                         }
                     },
                     "404": {
@@ -1903,6 +2208,13 @@ var doc = `{
                             "$ref": "#/definitions/HTTPError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1912,39 +2224,55 @@ var doc = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Delete a configMap by name",
+            "post": {
+                "description": "Create a new user by giving its name in the configuration and the username and the\npassword to connect to the server",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "Configuration"
                 ],
-                "summary": "Delete a configMap",
-                "operationId": "delete-object-configMap",
+                "summary": "Create a new user",
+                "operationId": "post-configuration-user-username-password",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
+                        "description": "the name of the user in the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the object",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "404": {
-                        "description": "Not Found",
+                        "description": "the credentials",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/context.ParamCredentialsUserNamePassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/context.NamedUser"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/HTTPError"
@@ -1960,17 +2288,68 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/cronJobs/{namespace}": {
+        "/api/v1/events/": {
             "get": {
-                "description": "Get all cronJobs",
+                "description": "the websocket used for receiving the configuration and then return the requested events. Each event is a full object when created / updated / deleted\nA ConfigurationEvent listing the added, removed and changed contexts is also sent when the contexts configuration file is modified",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Connect to a WebSocket for managing events (port 8081, or along the REST services in single port mode)",
+                "operationId": "get-events-by-websocket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the configuration",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/labels/{contextName}/{namespace}": {
+            "get": {
+                "description": "Get all the labels and their values",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "Labels"
                 ],
-                "summary": "Get all cronJobs",
-                "operationId": "get-object-cronJobs",
+                "summary": "Get all the labels and their values",
+                "operationId": "get-labels",
                 "parameters": [
                     {
                         "type": "string",
@@ -1987,13 +2366,58 @@ var doc = `{
                         "required": true
                     }
                 ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/MapOfStrings"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/objects/{contextName}/clusterRoleBindings": {
+            "get": {
+                "description": "Get all clusterRoleBindings",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ObjectsClusterLevel"
+                ],
+                "summary": "Get all clusterRoleBindings",
+                "operationId": "get-object-clusterRoleBindings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/CronJob"
+                                "$ref": "#/definitions/ClusterRoleBinding"
                             }
                         }
                     },
@@ -2014,7 +2438,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a cronJob.",
+                "description": "Update a clusterRoleBinding.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2022,10 +2446,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Update a cronJob",
-                "operationId": "update-object-cronJob",
+                "summary": "Update a clusterRoleBinding",
+                "operationId": "update-object-clusterRoleBinding",
                 "parameters": [
                     {
                         "type": "string",
@@ -2035,20 +2459,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "the definition of the cronJob",
+                        "description": "the definition of the clusterRoleBinding",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/CronJob"
+                            "$ref": "#/definitions/ClusterRoleBinding"
                         }
                     }
                 ],
@@ -2057,7 +2474,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/CronJob"
+                            "$ref": "#/definitions/ClusterRoleBinding"
                         }
                     },
                     "400": {
@@ -2084,7 +2501,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a cronJob.",
+                "description": "Create a clusterRoleBinding.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2092,10 +2509,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Create a cronJob",
-                "operationId": "create-object-cronJob",
+                "summary": "Create a clusterRoleBinding",
+                "operationId": "create-object-clusterRoleBinding",
                 "parameters": [
                     {
                         "type": "string",
@@ -2105,20 +2522,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "the definition of the cronJob",
+                        "description": "the definition of the clusterRoleBinding",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/CronJob"
+                            "$ref": "#/definitions/ClusterRoleBinding"
                         }
                     }
                 ],
@@ -2127,7 +2537,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/CronJob"
+                            "$ref": "#/definitions/ClusterRoleBinding"
                         }
                     },
                     "400": {
@@ -2154,17 +2564,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/cronJobs/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/clusterRoleBindings/{name}": {
             "get": {
-                "description": "Get a cronJob by name",
+                "description": "Get a clusterRoleBinding by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Get a cronJob",
-                "operationId": "get-object-cronJob",
+                "summary": "Get a clusterRoleBinding",
+                "operationId": "get-object-clusterRoleBinding",
                 "parameters": [
                     {
                         "type": "string",
@@ -2173,13 +2583,6 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -2193,7 +2596,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/CronJob"
+                            "$ref": "#/definitions/ClusterRoleBinding"
                         }
                     },
                     "404": {
@@ -2213,12 +2616,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a cronJob by name",
+                "description": "Delete a clusterRoleBinding by name",
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Delete a cronJob",
-                "operationId": "delete-object-cronJob",
+                "summary": "Delete a clusterRoleBinding",
+                "operationId": "delete-object-clusterRoleBinding",
                 "parameters": [
                     {
                         "type": "string",
@@ -2227,13 +2630,6 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -2260,17 +2656,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/daemonSets/{namespace}": {
+        "/api/v1/objects/{contextName}/clusterRoles": {
             "get": {
-                "description": "Get all daemonSets",
+                "description": "Get all clusterRoles",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Get all daemonSets",
-                "operationId": "get-object-daemonSets",
+                "summary": "Get all clusterRoles",
+                "operationId": "get-object-clusterRoles",
                 "parameters": [
                     {
                         "type": "string",
@@ -2278,13 +2674,6 @@ var doc = `{
                         "name": "contextName",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -2293,7 +2682,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/DaemonSet"
+                                "$ref": "#/definitions/ClusterRole"
                             }
                         }
                     },
@@ -2314,7 +2703,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a daemonSet.",
+                "description": "Update a clusterRole.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2322,10 +2711,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Update a daemonSet",
-                "operationId": "update-object-daemonSet",
+                "summary": "Update a clusterRole",
+                "operationId": "update-object-clusterRole",
                 "parameters": [
                     {
                         "type": "string",
@@ -2335,20 +2724,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "the definition of the daemonSet",
+                        "description": "the definition of the clusterRole",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/DaemonSet"
+                            "$ref": "#/definitions/ClusterRole"
                         }
                     }
                 ],
@@ -2357,7 +2739,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/DaemonSet"
+                            "$ref": "#/definitions/ClusterRole"
                         }
                     },
                     "400": {
@@ -2384,7 +2766,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a daemonSet.",
+                "description": "Create a clusterRole.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2392,10 +2774,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Create a daemonSet",
-                "operationId": "create-object-daemonSet",
+                "summary": "Create a clusterRole",
+                "operationId": "create-object-clusterRole",
                 "parameters": [
                     {
                         "type": "string",
@@ -2405,20 +2787,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "the definition of the daemonSet",
+                        "description": "the definition of the clusterRole",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/DaemonSet"
+                            "$ref": "#/definitions/ClusterRole"
                         }
                     }
                 ],
@@ -2427,7 +2802,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/DaemonSet"
+                            "$ref": "#/definitions/ClusterRole"
                         }
                     },
                     "400": {
@@ -2454,17 +2829,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/daemonSets/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/clusterRoles/{name}": {
             "get": {
-                "description": "Get a daemonSet by name",
+                "description": "Get a clusterRole by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Get a daemonSet",
-                "operationId": "get-object-daemonSet",
+                "summary": "Get a clusterRole",
+                "operationId": "get-object-clusterRole",
                 "parameters": [
                     {
                         "type": "string",
@@ -2473,13 +2848,6 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -2493,7 +2861,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/DaemonSet"
+                            "$ref": "#/definitions/ClusterRole"
                         }
                     },
                     "404": {
@@ -2513,12 +2881,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a daemonSet by name",
+                "description": "Delete a clusterRole by name",
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Delete a daemonSet",
-                "operationId": "delete-object-daemonSet",
+                "summary": "Delete a clusterRole",
+                "operationId": "delete-object-clusterRole",
                 "parameters": [
                     {
                         "type": "string",
@@ -2527,13 +2895,6 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -2560,17 +2921,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/deployments/{namespace}": {
+        "/api/v1/objects/{contextName}/configMaps/{namespace}": {
             "get": {
-                "description": "Get all deployments",
+                "description": "Get all configMaps",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get all deployments",
-                "operationId": "get-object-deployments",
+                "summary": "Get all configMaps",
+                "operationId": "get-object-configMaps",
                 "parameters": [
                     {
                         "type": "string",
@@ -2593,7 +2954,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Deployment"
+                                "$ref": "#/definitions/MaskedConfigMap"
                             }
                         }
                     },
@@ -2614,7 +2975,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a deployment.",
+                "description": "Update a configMap.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2624,8 +2985,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Update a deployment",
-                "operationId": "update-object-deployment",
+                "summary": "Update a configMap",
+                "operationId": "update-object-configMap",
                 "parameters": [
                     {
                         "type": "string",
@@ -2642,13 +3003,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the deployment",
+                        "description": "the definition of the configMap",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Deployment"
+                            "$ref": "#/definitions/ConfigMap"
                         }
                     }
                 ],
@@ -2657,7 +3018,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Deployment"
+                            "$ref": "#/definitions/MaskedConfigMap"
                         }
                     },
                     "400": {
@@ -2684,7 +3045,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a deployment.",
+                "description": "Create a configMap.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2694,8 +3055,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Create a deployment",
-                "operationId": "create-object-deployment",
+                "summary": "Create a configMap",
+                "operationId": "create-object-configMap",
                 "parameters": [
                     {
                         "type": "string",
@@ -2712,13 +3073,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the deployment",
+                        "description": "the definition of the configMap",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Deployment"
+                            "$ref": "#/definitions/ConfigMap"
                         }
                     }
                 ],
@@ -2727,7 +3088,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Deployment"
+                            "$ref": "#/definitions/MaskedConfigMap"
                         }
                     },
                     "400": {
@@ -2754,17 +3115,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/deployments/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/configMaps/{namespace}/{name}": {
             "get": {
-                "description": "Get a deployment by name",
+                "description": "Get a configMap by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get a deployment",
-                "operationId": "get-object-deployment",
+                "summary": "Get a configMap",
+                "operationId": "get-object-configMap",
                 "parameters": [
                     {
                         "type": "string",
@@ -2793,7 +3154,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Deployment"
+                            "$ref": "#/definitions/MaskedConfigMap"
                         }
                     },
                     "404": {
@@ -2813,12 +3174,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a deployment by name",
+                "description": "Delete a configMap by name",
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Delete a deployment",
-                "operationId": "delete-object-deployment",
+                "summary": "Delete a configMap",
+                "operationId": "delete-object-configMap",
                 "parameters": [
                     {
                         "type": "string",
@@ -2860,17 +3221,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/jobs/{namespace}": {
+        "/api/v1/objects/{contextName}/cronJobs/{namespace}": {
             "get": {
-                "description": "Get all jobs",
+                "description": "Get all cronJobs",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get all jobs",
-                "operationId": "get-object-jobs",
+                "summary": "Get all cronJobs",
+                "operationId": "get-object-cronJobs",
                 "parameters": [
                     {
                         "type": "string",
@@ -2893,7 +3254,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Job"
+                                "$ref": "#/definitions/CronJob"
                             }
                         }
                     },
@@ -2914,7 +3275,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a job.",
+                "description": "Update a cronJob.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2924,8 +3285,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Update a job",
-                "operationId": "update-object-job",
+                "summary": "Update a cronJob",
+                "operationId": "update-object-cronJob",
                 "parameters": [
                     {
                         "type": "string",
@@ -2942,13 +3303,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the job",
+                        "description": "the definition of the cronJob",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Job"
+                            "$ref": "#/definitions/CronJob"
                         }
                     }
                 ],
@@ -2957,7 +3318,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Job"
+                            "$ref": "#/definitions/CronJob"
                         }
                     },
                     "400": {
@@ -2984,7 +3345,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a job.",
+                "description": "Create a cronJob.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2994,8 +3355,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Create a job",
-                "operationId": "create-object-job",
+                "summary": "Create a cronJob",
+                "operationId": "create-object-cronJob",
                 "parameters": [
                     {
                         "type": "string",
@@ -3012,13 +3373,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the job",
+                        "description": "the definition of the cronJob",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Job"
+                            "$ref": "#/definitions/CronJob"
                         }
                     }
                 ],
@@ -3027,7 +3388,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Job"
+                            "$ref": "#/definitions/CronJob"
                         }
                     },
                     "400": {
@@ -3054,17 +3415,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/jobs/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/cronJobs/{namespace}/{name}": {
             "get": {
-                "description": "Get a job by name",
+                "description": "Get a cronJob by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get a job",
-                "operationId": "get-object-job",
+                "summary": "Get a cronJob",
+                "operationId": "get-object-cronJob",
                 "parameters": [
                     {
                         "type": "string",
@@ -3093,7 +3454,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Job"
+                            "$ref": "#/definitions/CronJob"
                         }
                     },
                     "404": {
@@ -3113,12 +3474,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a job by name",
+                "description": "Delete a cronJob by name",
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Delete a job",
-                "operationId": "delete-object-job",
+                "summary": "Delete a cronJob",
+                "operationId": "delete-object-cronJob",
                 "parameters": [
                     {
                         "type": "string",
@@ -3160,17 +3521,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/namespaces": {
+        "/api/v1/objects/{contextName}/daemonSets/{namespace}": {
             "get": {
-                "description": "Get all namespaces",
+                "description": "Get all daemonSets",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get all namespaces",
-                "operationId": "get-object-namespaces",
+                "summary": "Get all daemonSets",
+                "operationId": "get-object-daemonSets",
                 "parameters": [
                     {
                         "type": "string",
@@ -3178,6 +3539,13 @@ var doc = `{
                         "name": "contextName",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3186,7 +3554,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Namespace"
+                                "$ref": "#/definitions/DaemonSet"
                             }
                         }
                     },
@@ -3207,7 +3575,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a namespace.",
+                "description": "Update a daemonSet.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3215,10 +3583,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Update a namespace",
-                "operationId": "update-object-namespace",
+                "summary": "Update a daemonSet",
+                "operationId": "update-object-daemonSet",
                 "parameters": [
                     {
                         "type": "string",
@@ -3228,13 +3596,20 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the namespace",
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the daemonSet",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Namespace"
+                            "$ref": "#/definitions/DaemonSet"
                         }
                     }
                 ],
@@ -3243,7 +3618,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Namespace"
+                            "$ref": "#/definitions/DaemonSet"
                         }
                     },
                     "400": {
@@ -3270,7 +3645,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a namespace.",
+                "description": "Create a daemonSet.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3278,10 +3653,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Create a namespace",
-                "operationId": "create-object-namespace",
+                "summary": "Create a daemonSet",
+                "operationId": "create-object-daemonSet",
                 "parameters": [
                     {
                         "type": "string",
@@ -3291,13 +3666,20 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the namespace",
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the daemonSet",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Namespace"
+                            "$ref": "#/definitions/DaemonSet"
                         }
                     }
                 ],
@@ -3306,7 +3688,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Namespace"
+                            "$ref": "#/definitions/DaemonSet"
                         }
                     },
                     "400": {
@@ -3333,17 +3715,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/namespaces/{name}": {
+        "/api/v1/objects/{contextName}/daemonSets/{namespace}/{name}": {
             "get": {
-                "description": "Get a namespace by name",
+                "description": "Get a daemonSet by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get a namespace",
-                "operationId": "get-object-namespace",
+                "summary": "Get a daemonSet",
+                "operationId": "get-object-daemonSet",
                 "parameters": [
                     {
                         "type": "string",
@@ -3352,6 +3734,13 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -3365,7 +3754,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Namespace"
+                            "$ref": "#/definitions/DaemonSet"
                         }
                     },
                     "404": {
@@ -3385,12 +3774,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a namespace by name",
+                "description": "Delete a daemonSet by name",
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Delete a namespace",
-                "operationId": "delete-object-namespace",
+                "summary": "Delete a daemonSet",
+                "operationId": "delete-object-daemonSet",
                 "parameters": [
                     {
                         "type": "string",
@@ -3399,6 +3788,13 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -3425,17 +3821,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/networkPolicies/{namespace}": {
+        "/api/v1/objects/{contextName}/deployments/{namespace}": {
             "get": {
-                "description": "Get all networkPolicies",
+                "description": "Get all deployments",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get all networkPolicies",
-                "operationId": "get-object-networkPolicies",
+                "summary": "Get all deployments",
+                "operationId": "get-object-deployments",
                 "parameters": [
                     {
                         "type": "string",
@@ -3458,7 +3854,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/NetworkPolicy"
+                                "$ref": "#/definitions/Deployment"
                             }
                         }
                     },
@@ -3479,7 +3875,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a networkPolicy.",
+                "description": "Update a deployment.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3489,8 +3885,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Update a networkPolicy",
-                "operationId": "update-object-networkPolicy",
+                "summary": "Update a deployment",
+                "operationId": "update-object-deployment",
                 "parameters": [
                     {
                         "type": "string",
@@ -3507,13 +3903,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the networkPolicy",
+                        "description": "the definition of the deployment",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/NetworkPolicy"
+                            "$ref": "#/definitions/Deployment"
                         }
                     }
                 ],
@@ -3522,7 +3918,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/NetworkPolicy"
+                            "$ref": "#/definitions/Deployment"
                         }
                     },
                     "400": {
@@ -3549,7 +3945,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a networkPolicy.",
+                "description": "Create a deployment.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3559,8 +3955,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Create a networkPolicy",
-                "operationId": "create-object-networkPolicy",
+                "summary": "Create a deployment",
+                "operationId": "create-object-deployment",
                 "parameters": [
                     {
                         "type": "string",
@@ -3577,13 +3973,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the networkPolicy",
+                        "description": "the definition of the deployment",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/NetworkPolicy"
+                            "$ref": "#/definitions/Deployment"
                         }
                     }
                 ],
@@ -3592,7 +3988,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/NetworkPolicy"
+                            "$ref": "#/definitions/Deployment"
                         }
                     },
                     "400": {
@@ -3619,17 +4015,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/networkPolicies/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/deployments/{namespace}/{name}": {
             "get": {
-                "description": "Get a networkPolicy by name",
+                "description": "Get a deployment by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get a networkPolicy",
-                "operationId": "get-object-networkPolicy",
+                "summary": "Get a deployment",
+                "operationId": "get-object-deployment",
                 "parameters": [
                     {
                         "type": "string",
@@ -3658,7 +4054,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/NetworkPolicy"
+                            "$ref": "#/definitions/Deployment"
                         }
                     },
                     "404": {
@@ -3678,12 +4074,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a networkPolicy by name",
+                "description": "Delete a deployment by name",
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Delete a networkPolicy",
-                "operationId": "delete-object-networkPolicy",
+                "summary": "Delete a deployment",
+                "operationId": "delete-object-deployment",
                 "parameters": [
                     {
                         "type": "string",
@@ -3725,64 +4121,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/nodeMetricses": {
-            "get": {
-                "description": "Get all nodeMetricses",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "ObjectsClusterLevel"
-                ],
-                "summary": "Get all nodeMetricses",
-                "operationId": "get-object-nodeMetricses",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/NodeMetrics"
-                            }
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/HTTPError"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/HTTPError"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/v1/objects/{contextName}/nodeMetricses/{name}": {
+        "/api/v1/objects/{contextName}/jobs/{namespace}": {
             "get": {
-                "description": "Get a nodeMetrics by name",
+                "description": "Get all jobs",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get a nodeMetrics",
-                "operationId": "get-object-nodeMetrics",
+                "summary": "Get all jobs",
+                "operationId": "get-object-jobs",
                 "parameters": [
                     {
                         "type": "string",
@@ -3793,53 +4142,8 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "description": "the name of the object",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/NodeMetrics"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/HTTPError"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/HTTPError"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/v1/objects/{contextName}/nodes": {
-            "get": {
-                "description": "Get all nodes",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "ObjectsClusterLevel"
-                ],
-                "summary": "Get all nodes",
-                "operationId": "get-object-nodes",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "the name of the context",
-                        "name": "contextName",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
                         "in": "path",
                         "required": true
                     }
@@ -3850,7 +4154,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Node"
+                                "$ref": "#/definitions/Job"
                             }
                         }
                     },
@@ -3871,7 +4175,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a node.",
+                "description": "Update a job.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3879,10 +4183,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Update a node",
-                "operationId": "update-object-node",
+                "summary": "Update a job",
+                "operationId": "update-object-job",
                 "parameters": [
                     {
                         "type": "string",
@@ -3892,13 +4196,20 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the node",
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the job",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Node"
+                            "$ref": "#/definitions/Job"
                         }
                     }
                 ],
@@ -3907,7 +4218,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Node"
+                            "$ref": "#/definitions/Job"
                         }
                     },
                     "400": {
@@ -3934,7 +4245,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a node.",
+                "description": "Create a job.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3942,10 +4253,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Create a node",
-                "operationId": "create-object-node",
+                "summary": "Create a job",
+                "operationId": "create-object-job",
                 "parameters": [
                     {
                         "type": "string",
@@ -3955,13 +4266,20 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the node",
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the job",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Node"
+                            "$ref": "#/definitions/Job"
                         }
                     }
                 ],
@@ -3970,7 +4288,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Node"
+                            "$ref": "#/definitions/Job"
                         }
                     },
                     "400": {
@@ -3997,17 +4315,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/nodes/{name}": {
+        "/api/v1/objects/{contextName}/jobs/{namespace}/{name}": {
             "get": {
-                "description": "Get a node by name",
+                "description": "Get a job by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get a node",
-                "operationId": "get-object-node",
+                "summary": "Get a job",
+                "operationId": "get-object-job",
                 "parameters": [
                     {
                         "type": "string",
@@ -4016,6 +4334,13 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -4029,7 +4354,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Node"
+                            "$ref": "#/definitions/Job"
                         }
                     },
                     "404": {
@@ -4049,12 +4374,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a node by name",
+                "description": "Delete a job by name",
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Delete a node",
-                "operationId": "delete-object-node",
+                "summary": "Delete a job",
+                "operationId": "delete-object-job",
                 "parameters": [
                     {
                         "type": "string",
@@ -4063,6 +4388,13 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -4089,17 +4421,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/persistentVolumeClaims/{namespace}": {
+        "/api/v1/objects/{contextName}/namespaces": {
             "get": {
-                "description": "Get all persistentVolumeClaims",
+                "description": "Get all namespaces",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Get all persistentVolumeClaims",
-                "operationId": "get-object-persistentVolumeClaims",
+                "summary": "Get all namespaces",
+                "operationId": "get-object-namespaces",
                 "parameters": [
                     {
                         "type": "string",
@@ -4107,13 +4439,6 @@ var doc = `{
                         "name": "contextName",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -4122,7 +4447,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/PersistentVolumeClaim"
+                                "$ref": "#/definitions/Namespace"
                             }
                         }
                     },
@@ -4143,7 +4468,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a persistentVolumeClaim.",
+                "description": "Update a namespace.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4151,10 +4476,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Update a persistentVolumeClaim",
-                "operationId": "update-object-persistentVolumeClaim",
+                "summary": "Update a namespace",
+                "operationId": "update-object-namespace",
                 "parameters": [
                     {
                         "type": "string",
@@ -4164,20 +4489,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "the definition of the persistentVolumeClaim",
+                        "description": "the definition of the namespace",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/PersistentVolumeClaim"
+                            "$ref": "#/definitions/Namespace"
                         }
                     }
                 ],
@@ -4186,7 +4504,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/PersistentVolumeClaim"
+                            "$ref": "#/definitions/Namespace"
                         }
                     },
                     "400": {
@@ -4213,7 +4531,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a persistentVolumeClaim.",
+                "description": "Create a namespace.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4221,10 +4539,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Create a persistentVolumeClaim",
-                "operationId": "create-object-persistentVolumeClaim",
+                "summary": "Create a namespace",
+                "operationId": "create-object-namespace",
                 "parameters": [
                     {
                         "type": "string",
@@ -4234,20 +4552,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "the definition of the persistentVolumeClaim",
+                        "description": "the definition of the namespace",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/PersistentVolumeClaim"
+                            "$ref": "#/definitions/Namespace"
                         }
                     }
                 ],
@@ -4256,7 +4567,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/PersistentVolumeClaim"
+                            "$ref": "#/definitions/Namespace"
                         }
                     },
                     "400": {
@@ -4283,17 +4594,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/persistentVolumeClaims/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/namespaces/{name}": {
             "get": {
-                "description": "Get a persistentVolumeClaim by name",
+                "description": "Get a namespace by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Get a persistentVolumeClaim",
-                "operationId": "get-object-persistentVolumeClaim",
+                "summary": "Get a namespace",
+                "operationId": "get-object-namespace",
                 "parameters": [
                     {
                         "type": "string",
@@ -4302,13 +4613,6 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -4322,7 +4626,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/PersistentVolumeClaim"
+                            "$ref": "#/definitions/Namespace"
                         }
                     },
                     "404": {
@@ -4342,12 +4646,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a persistentVolumeClaim by name",
+                "description": "Delete a namespace by name",
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Delete a persistentVolumeClaim",
-                "operationId": "delete-object-persistentVolumeClaim",
+                "summary": "Delete a namespace",
+                "operationId": "delete-object-namespace",
                 "parameters": [
                     {
                         "type": "string",
@@ -4356,13 +4660,6 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -4389,17 +4686,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/persistentVolumes": {
+        "/api/v1/objects/{contextName}/networkPolicies/{namespace}": {
             "get": {
-                "description": "Get all persistentVolumes",
+                "description": "Get all networkPolicies",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get all persistentVolumes",
-                "operationId": "get-object-persistentVolumes",
+                "summary": "Get all networkPolicies",
+                "operationId": "get-object-networkPolicies",
                 "parameters": [
                     {
                         "type": "string",
@@ -4407,6 +4704,13 @@ var doc = `{
                         "name": "contextName",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -4415,7 +4719,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/PersistentVolume"
+                                "$ref": "#/definitions/NetworkPolicy"
                             }
                         }
                     },
@@ -4436,7 +4740,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a persistentVolume.",
+                "description": "Update a networkPolicy.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4444,10 +4748,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Update a persistentVolume",
-                "operationId": "update-object-persistentVolume",
+                "summary": "Update a networkPolicy",
+                "operationId": "update-object-networkPolicy",
                 "parameters": [
                     {
                         "type": "string",
@@ -4457,13 +4761,20 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the persistentVolume",
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the networkPolicy",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/PersistentVolume"
+                            "$ref": "#/definitions/NetworkPolicy"
                         }
                     }
                 ],
@@ -4472,7 +4783,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/PersistentVolume"
+                            "$ref": "#/definitions/NetworkPolicy"
                         }
                     },
                     "400": {
@@ -4499,7 +4810,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a persistentVolume.",
+                "description": "Create a networkPolicy.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4507,10 +4818,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Create a persistentVolume",
-                "operationId": "create-object-persistentVolume",
+                "summary": "Create a networkPolicy",
+                "operationId": "create-object-networkPolicy",
                 "parameters": [
                     {
                         "type": "string",
@@ -4520,13 +4831,20 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the persistentVolume",
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the networkPolicy",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/PersistentVolume"
+                            "$ref": "#/definitions/NetworkPolicy"
                         }
                     }
                 ],
@@ -4535,7 +4853,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/PersistentVolume"
+                            "$ref": "#/definitions/NetworkPolicy"
                         }
                     },
                     "400": {
@@ -4562,17 +4880,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/persistentVolumes/{name}": {
+        "/api/v1/objects/{contextName}/networkPolicies/{namespace}/{name}": {
             "get": {
-                "description": "Get a persistentVolume by name",
+                "description": "Get a networkPolicy by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get a persistentVolume",
-                "operationId": "get-object-persistentVolume",
+                "summary": "Get a networkPolicy",
+                "operationId": "get-object-networkPolicy",
                 "parameters": [
                     {
                         "type": "string",
@@ -4581,6 +4899,13 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -4594,7 +4919,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/PersistentVolume"
+                            "$ref": "#/definitions/NetworkPolicy"
                         }
                     },
                     "404": {
@@ -4614,12 +4939,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a persistentVolume by name",
+                "description": "Delete a networkPolicy by name",
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Delete a persistentVolume",
-                "operationId": "delete-object-persistentVolume",
+                "summary": "Delete a networkPolicy",
+                "operationId": "delete-object-networkPolicy",
                 "parameters": [
                     {
                         "type": "string",
@@ -4628,6 +4953,13 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -4654,17 +4986,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/podMetricses/{namespace}": {
+        "/api/v1/objects/{contextName}/nodeMetricses": {
             "get": {
-                "description": "Get all podMetricses",
+                "description": "Get all nodeMetricses",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Get all podMetricses",
-                "operationId": "get-object-podMetricses",
+                "summary": "Get all nodeMetricses",
+                "operationId": "get-object-nodeMetricses",
                 "parameters": [
                     {
                         "type": "string",
@@ -4672,13 +5004,6 @@ var doc = `{
                         "name": "contextName",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -4687,7 +5012,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/PodMetrics"
+                                "$ref": "#/definitions/NodeMetrics"
                             }
                         }
                     },
@@ -4708,17 +5033,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/podMetricses/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/nodeMetricses/{name}": {
             "get": {
-                "description": "Get a podMetrics by name",
+                "description": "Get a nodeMetrics by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Get a podMetrics",
-                "operationId": "get-object-podMetrics",
+                "summary": "Get a nodeMetrics",
+                "operationId": "get-object-nodeMetrics",
                 "parameters": [
                     {
                         "type": "string",
@@ -4727,13 +5052,6 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -4747,7 +5065,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/PodMetrics"
+                            "$ref": "#/definitions/NodeMetrics"
                         }
                     },
                     "404": {
@@ -4767,17 +5085,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/pods/{namespace}": {
+        "/api/v1/objects/{contextName}/nodes": {
             "get": {
-                "description": "Get all pods",
+                "description": "Get all nodes",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Get all pods",
-                "operationId": "get-object-pods",
+                "summary": "Get all nodes",
+                "operationId": "get-object-nodes",
                 "parameters": [
                     {
                         "type": "string",
@@ -4785,13 +5103,6 @@ var doc = `{
                         "name": "contextName",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -4800,7 +5111,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Pod"
+                                "$ref": "#/definitions/Node"
                             }
                         }
                     },
@@ -4821,7 +5132,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a pod.",
+                "description": "Update a node.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4829,10 +5140,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Update a pod",
-                "operationId": "update-object-pod",
+                "summary": "Update a node",
+                "operationId": "update-object-node",
                 "parameters": [
                     {
                         "type": "string",
@@ -4842,20 +5153,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "the definition of the pod",
+                        "description": "the definition of the node",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Pod"
+                            "$ref": "#/definitions/Node"
                         }
                     }
                 ],
@@ -4864,7 +5168,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Pod"
+                            "$ref": "#/definitions/Node"
                         }
                     },
                     "400": {
@@ -4891,7 +5195,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a pod.",
+                "description": "Create a node.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4899,10 +5203,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Create a pod",
-                "operationId": "create-object-pod",
+                "summary": "Create a node",
+                "operationId": "create-object-node",
                 "parameters": [
                     {
                         "type": "string",
@@ -4912,20 +5216,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "the definition of the pod",
+                        "description": "the definition of the node",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Pod"
+                            "$ref": "#/definitions/Node"
                         }
                     }
                 ],
@@ -4934,7 +5231,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Pod"
+                            "$ref": "#/definitions/Node"
                         }
                     },
                     "400": {
@@ -4961,17 +5258,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/pods/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/nodes/{name}": {
             "get": {
-                "description": "Get a pod by name",
+                "description": "Get a node by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Get a pod",
-                "operationId": "get-object-pod",
+                "summary": "Get a node",
+                "operationId": "get-object-node",
                 "parameters": [
                     {
                         "type": "string",
@@ -4980,13 +5277,6 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -5000,7 +5290,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Pod"
+                            "$ref": "#/definitions/Node"
                         }
                     },
                     "404": {
@@ -5020,12 +5310,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a pod by name",
+                "description": "Delete a node by name",
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Delete a pod",
-                "operationId": "delete-object-pod",
+                "summary": "Delete a node",
+                "operationId": "delete-object-node",
                 "parameters": [
                     {
                         "type": "string",
@@ -5034,13 +5324,6 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -5067,17 +5350,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/replicaSets/{namespace}": {
+        "/api/v1/objects/{contextName}/persistentVolumeClaims/{namespace}": {
             "get": {
-                "description": "Get all replicaSets",
+                "description": "Get all persistentVolumeClaims",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get all replicaSets",
-                "operationId": "get-object-replicaSets",
+                "summary": "Get all persistentVolumeClaims",
+                "operationId": "get-object-persistentVolumeClaims",
                 "parameters": [
                     {
                         "type": "string",
@@ -5100,7 +5383,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/ReplicaSet"
+                                "$ref": "#/definitions/PersistentVolumeClaim"
                             }
                         }
                     },
@@ -5121,7 +5404,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a replicaSet.",
+                "description": "Update a persistentVolumeClaim.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5131,8 +5414,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Update a replicaSet",
-                "operationId": "update-object-replicaSet",
+                "summary": "Update a persistentVolumeClaim",
+                "operationId": "update-object-persistentVolumeClaim",
                 "parameters": [
                     {
                         "type": "string",
@@ -5149,13 +5432,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the replicaSet",
+                        "description": "the definition of the persistentVolumeClaim",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ReplicaSet"
+                            "$ref": "#/definitions/PersistentVolumeClaim"
                         }
                     }
                 ],
@@ -5164,7 +5447,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ReplicaSet"
+                            "$ref": "#/definitions/PersistentVolumeClaim"
                         }
                     },
                     "400": {
@@ -5191,7 +5474,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a replicaSet.",
+                "description": "Create a persistentVolumeClaim.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5201,8 +5484,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Create a replicaSet",
-                "operationId": "create-object-replicaSet",
+                "summary": "Create a persistentVolumeClaim",
+                "operationId": "create-object-persistentVolumeClaim",
                 "parameters": [
                     {
                         "type": "string",
@@ -5219,13 +5502,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the replicaSet",
+                        "description": "the definition of the persistentVolumeClaim",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ReplicaSet"
+                            "$ref": "#/definitions/PersistentVolumeClaim"
                         }
                     }
                 ],
@@ -5234,7 +5517,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ReplicaSet"
+                            "$ref": "#/definitions/PersistentVolumeClaim"
                         }
                     },
                     "400": {
@@ -5261,17 +5544,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/replicaSets/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/persistentVolumeClaims/{namespace}/{name}": {
             "get": {
-                "description": "Get a replicaSet by name",
+                "description": "Get a persistentVolumeClaim by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get a replicaSet",
-                "operationId": "get-object-replicaSet",
+                "summary": "Get a persistentVolumeClaim",
+                "operationId": "get-object-persistentVolumeClaim",
                 "parameters": [
                     {
                         "type": "string",
@@ -5300,7 +5583,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ReplicaSet"
+                            "$ref": "#/definitions/PersistentVolumeClaim"
                         }
                     },
                     "404": {
@@ -5320,12 +5603,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a replicaSet by name",
+                "description": "Delete a persistentVolumeClaim by name",
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Delete a replicaSet",
-                "operationId": "delete-object-replicaSet",
+                "summary": "Delete a persistentVolumeClaim",
+                "operationId": "delete-object-persistentVolumeClaim",
                 "parameters": [
                     {
                         "type": "string",
@@ -5367,17 +5650,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/replicationControllers/{namespace}": {
+        "/api/v1/objects/{contextName}/persistentVolumes": {
             "get": {
-                "description": "Get all replicationControllers",
+                "description": "Get all persistentVolumes",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Get all replicationControllers",
-                "operationId": "get-object-replicationControllers",
+                "summary": "Get all persistentVolumes",
+                "operationId": "get-object-persistentVolumes",
                 "parameters": [
                     {
                         "type": "string",
@@ -5385,13 +5668,6 @@ var doc = `{
                         "name": "contextName",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -5400,7 +5676,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/ReplicationController"
+                                "$ref": "#/definitions/PersistentVolume"
                             }
                         }
                     },
@@ -5421,7 +5697,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a replicationController.",
+                "description": "Update a persistentVolume.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5429,10 +5705,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Update a replicationController",
-                "operationId": "update-object-replicationController",
+                "summary": "Update a persistentVolume",
+                "operationId": "update-object-persistentVolume",
                 "parameters": [
                     {
                         "type": "string",
@@ -5442,20 +5718,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "the definition of the replicationController",
+                        "description": "the definition of the persistentVolume",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ReplicationController"
+                            "$ref": "#/definitions/PersistentVolume"
                         }
                     }
                 ],
@@ -5464,7 +5733,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ReplicationController"
+                            "$ref": "#/definitions/PersistentVolume"
                         }
                     },
                     "400": {
@@ -5491,7 +5760,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a replicationController.",
+                "description": "Create a persistentVolume.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5499,10 +5768,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Create a replicationController",
-                "operationId": "create-object-replicationController",
+                "summary": "Create a persistentVolume",
+                "operationId": "create-object-persistentVolume",
                 "parameters": [
                     {
                         "type": "string",
@@ -5512,20 +5781,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "the definition of the replicationController",
+                        "description": "the definition of the persistentVolume",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ReplicationController"
+                            "$ref": "#/definitions/PersistentVolume"
                         }
                     }
                 ],
@@ -5534,7 +5796,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ReplicationController"
+                            "$ref": "#/definitions/PersistentVolume"
                         }
                     },
                     "400": {
@@ -5561,17 +5823,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/replicationControllers/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/persistentVolumes/{name}": {
             "get": {
-                "description": "Get a replicationController by name",
+                "description": "Get a persistentVolume by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Get a replicationController",
-                "operationId": "get-object-replicationController",
+                "summary": "Get a persistentVolume",
+                "operationId": "get-object-persistentVolume",
                 "parameters": [
                     {
                         "type": "string",
@@ -5580,13 +5842,6 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -5600,7 +5855,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ReplicationController"
+                            "$ref": "#/definitions/PersistentVolume"
                         }
                     },
                     "404": {
@@ -5620,12 +5875,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a replicationController by name",
+                "description": "Delete a persistentVolume by name",
                 "tags": [
-                    "ObjectsNamespaceLevel"
+                    "ObjectsClusterLevel"
                 ],
-                "summary": "Delete a replicationController",
-                "operationId": "delete-object-replicationController",
+                "summary": "Delete a persistentVolume",
+                "operationId": "delete-object-persistentVolume",
                 "parameters": [
                     {
                         "type": "string",
@@ -5634,13 +5889,6 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "the name of the namespace",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -5667,17 +5915,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/roleBindings/{namespace}": {
+        "/api/v1/objects/{contextName}/podMetricses/{namespace}": {
             "get": {
-                "description": "Get all roleBindings",
+                "description": "Get all podMetricses",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get all roleBindings",
-                "operationId": "get-object-roleBindings",
+                "summary": "Get all podMetricses",
+                "operationId": "get-object-podMetricses",
                 "parameters": [
                     {
                         "type": "string",
@@ -5700,7 +5948,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/RoleBinding"
+                                "$ref": "#/definitions/PodMetrics"
                             }
                         }
                     },
@@ -5719,20 +5967,19 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a roleBinding.",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/api/v1/objects/{contextName}/podMetricses/{namespace}/{name}": {
+            "get": {
+                "description": "Get a podMetrics by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Update a roleBinding",
-                "operationId": "update-object-roleBinding",
+                "summary": "Get a podMetrics",
+                "operationId": "get-object-podMetrics",
                 "parameters": [
                     {
                         "type": "string",
@@ -5749,14 +5996,11 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the roleBinding",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/RoleBinding"
-                        }
+                        "type": "string",
+                        "description": "the name of the object",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5764,14 +6008,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/RoleBinding"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/HTTPError"
+                            "$ref": "#/definitions/PodMetrics"
                         }
                     },
                     "404": {
@@ -5789,20 +6026,19 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a roleBinding.",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/api/v1/objects/{contextName}/pods/{namespace}": {
+            "get": {
+                "description": "Get all pods",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Create a roleBinding",
-                "operationId": "create-object-roleBinding",
+                "summary": "Get all pods",
+                "operationId": "get-object-pods",
                 "parameters": [
                     {
                         "type": "string",
@@ -5817,31 +6053,156 @@ var doc = `{
                         "name": "namespace",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "the definition of the roleBinding",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/RoleBinding"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/RoleBinding"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/HTTPError"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Pod"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a pod.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ObjectsNamespaceLevel"
+                ],
+                "summary": "Update a pod",
+                "operationId": "update-object-pod",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the pod",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/Pod"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/Pod"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a pod.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ObjectsNamespaceLevel"
+                ],
+                "summary": "Create a pod",
+                "operationId": "create-object-pod",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the pod",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/Pod"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/Pod"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
                         }
                     },
                     "404": {
@@ -5861,17 +6222,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/roleBindings/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/pods/{namespace}/{name}": {
             "get": {
-                "description": "Get a roleBinding by name",
+                "description": "Get a pod by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get a roleBinding",
-                "operationId": "get-object-roleBinding",
+                "summary": "Get a pod",
+                "operationId": "get-object-pod",
                 "parameters": [
                     {
                         "type": "string",
@@ -5900,7 +6261,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/RoleBinding"
+                            "$ref": "#/definitions/Pod"
                         }
                     },
                     "404": {
@@ -5920,12 +6281,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a roleBinding by name",
+                "description": "Delete a pod by name",
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Delete a roleBinding",
-                "operationId": "delete-object-roleBinding",
+                "summary": "Delete a pod",
+                "operationId": "delete-object-pod",
                 "parameters": [
                     {
                         "type": "string",
@@ -5967,17 +6328,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/roles/{namespace}": {
+        "/api/v1/objects/{contextName}/replicaSets/{namespace}": {
             "get": {
-                "description": "Get all roles",
+                "description": "Get all replicaSets",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get all roles",
-                "operationId": "get-object-roles",
+                "summary": "Get all replicaSets",
+                "operationId": "get-object-replicaSets",
                 "parameters": [
                     {
                         "type": "string",
@@ -6000,7 +6361,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Role"
+                                "$ref": "#/definitions/ReplicaSet"
                             }
                         }
                     },
@@ -6021,7 +6382,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a role.",
+                "description": "Update a replicaSet.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6031,8 +6392,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Update a role",
-                "operationId": "update-object-role",
+                "summary": "Update a replicaSet",
+                "operationId": "update-object-replicaSet",
                 "parameters": [
                     {
                         "type": "string",
@@ -6049,13 +6410,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the role",
+                        "description": "the definition of the replicaSet",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Role"
+                            "$ref": "#/definitions/ReplicaSet"
                         }
                     }
                 ],
@@ -6064,7 +6425,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Role"
+                            "$ref": "#/definitions/ReplicaSet"
                         }
                     },
                     "400": {
@@ -6091,7 +6452,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a role.",
+                "description": "Create a replicaSet.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6101,8 +6462,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Create a role",
-                "operationId": "create-object-role",
+                "summary": "Create a replicaSet",
+                "operationId": "create-object-replicaSet",
                 "parameters": [
                     {
                         "type": "string",
@@ -6119,13 +6480,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the role",
+                        "description": "the definition of the replicaSet",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Role"
+                            "$ref": "#/definitions/ReplicaSet"
                         }
                     }
                 ],
@@ -6134,7 +6495,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Role"
+                            "$ref": "#/definitions/ReplicaSet"
                         }
                     },
                     "400": {
@@ -6161,17 +6522,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/roles/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/replicaSets/{namespace}/{name}": {
             "get": {
-                "description": "Get a role by name",
+                "description": "Get a replicaSet by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get a role",
-                "operationId": "get-object-role",
+                "summary": "Get a replicaSet",
+                "operationId": "get-object-replicaSet",
                 "parameters": [
                     {
                         "type": "string",
@@ -6200,7 +6561,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Role"
+                            "$ref": "#/definitions/ReplicaSet"
                         }
                     },
                     "404": {
@@ -6220,12 +6581,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a role by name",
+                "description": "Delete a replicaSet by name",
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Delete a role",
-                "operationId": "delete-object-role",
+                "summary": "Delete a replicaSet",
+                "operationId": "delete-object-replicaSet",
                 "parameters": [
                     {
                         "type": "string",
@@ -6267,17 +6628,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/secrets/{namespace}": {
+        "/api/v1/objects/{contextName}/replicationControllers/{namespace}": {
             "get": {
-                "description": "Get all secrets",
+                "description": "Get all replicationControllers",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get all secrets",
-                "operationId": "get-object-secrets",
+                "summary": "Get all replicationControllers",
+                "operationId": "get-object-replicationControllers",
                 "parameters": [
                     {
                         "type": "string",
@@ -6300,7 +6661,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Secret"
+                                "$ref": "#/definitions/ReplicationController"
                             }
                         }
                     },
@@ -6321,7 +6682,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a secret.",
+                "description": "Update a replicationController.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6331,8 +6692,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Update a secret",
-                "operationId": "update-object-secret",
+                "summary": "Update a replicationController",
+                "operationId": "update-object-replicationController",
                 "parameters": [
                     {
                         "type": "string",
@@ -6349,13 +6710,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the secret",
+                        "description": "the definition of the replicationController",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/ReplicationController"
                         }
                     }
                 ],
@@ -6364,7 +6725,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/ReplicationController"
                         }
                     },
                     "400": {
@@ -6391,7 +6752,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a secret.",
+                "description": "Create a replicationController.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6401,8 +6762,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Create a secret",
-                "operationId": "create-object-secret",
+                "summary": "Create a replicationController",
+                "operationId": "create-object-replicationController",
                 "parameters": [
                     {
                         "type": "string",
@@ -6419,13 +6780,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the secret",
+                        "description": "the definition of the replicationController",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/ReplicationController"
                         }
                     }
                 ],
@@ -6434,7 +6795,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/ReplicationController"
                         }
                     },
                     "400": {
@@ -6461,17 +6822,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/secrets/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/replicationControllers/{namespace}/{name}": {
             "get": {
-                "description": "Get a secret by name",
+                "description": "Get a replicationController by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get a secret",
-                "operationId": "get-object-secret",
+                "summary": "Get a replicationController",
+                "operationId": "get-object-replicationController",
                 "parameters": [
                     {
                         "type": "string",
@@ -6500,7 +6861,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/ReplicationController"
                         }
                     },
                     "404": {
@@ -6520,12 +6881,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a secret by name",
+                "description": "Delete a replicationController by name",
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Delete a secret",
-                "operationId": "delete-object-secret",
+                "summary": "Delete a replicationController",
+                "operationId": "delete-object-replicationController",
                 "parameters": [
                     {
                         "type": "string",
@@ -6567,17 +6928,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/serviceAccounts/{namespace}": {
+        "/api/v1/objects/{contextName}/roleBindings/{namespace}": {
             "get": {
-                "description": "Get all serviceAccounts",
+                "description": "Get all roleBindings",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get all serviceAccounts",
-                "operationId": "get-object-serviceAccounts",
+                "summary": "Get all roleBindings",
+                "operationId": "get-object-roleBindings",
                 "parameters": [
                     {
                         "type": "string",
@@ -6600,7 +6961,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/ServiceAccount"
+                                "$ref": "#/definitions/RoleBinding"
                             }
                         }
                     },
@@ -6621,7 +6982,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a serviceAccount.",
+                "description": "Update a roleBinding.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6631,8 +6992,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Update a serviceAccount",
-                "operationId": "update-object-serviceAccount",
+                "summary": "Update a roleBinding",
+                "operationId": "update-object-roleBinding",
                 "parameters": [
                     {
                         "type": "string",
@@ -6649,13 +7010,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the serviceAccount",
+                        "description": "the definition of the roleBinding",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ServiceAccount"
+                            "$ref": "#/definitions/RoleBinding"
                         }
                     }
                 ],
@@ -6664,7 +7025,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ServiceAccount"
+                            "$ref": "#/definitions/RoleBinding"
                         }
                     },
                     "400": {
@@ -6691,7 +7052,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a serviceAccount.",
+                "description": "Create a roleBinding.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6701,8 +7062,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Create a serviceAccount",
-                "operationId": "create-object-serviceAccount",
+                "summary": "Create a roleBinding",
+                "operationId": "create-object-roleBinding",
                 "parameters": [
                     {
                         "type": "string",
@@ -6719,13 +7080,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the serviceAccount",
+                        "description": "the definition of the roleBinding",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ServiceAccount"
+                            "$ref": "#/definitions/RoleBinding"
                         }
                     }
                 ],
@@ -6734,7 +7095,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ServiceAccount"
+                            "$ref": "#/definitions/RoleBinding"
                         }
                     },
                     "400": {
@@ -6761,17 +7122,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/serviceAccounts/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/roleBindings/{namespace}/{name}": {
             "get": {
-                "description": "Get a serviceAccount by name",
+                "description": "Get a roleBinding by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get a serviceAccount",
-                "operationId": "get-object-serviceAccount",
+                "summary": "Get a roleBinding",
+                "operationId": "get-object-roleBinding",
                 "parameters": [
                     {
                         "type": "string",
@@ -6800,7 +7161,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/ServiceAccount"
+                            "$ref": "#/definitions/RoleBinding"
                         }
                     },
                     "404": {
@@ -6820,12 +7181,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a serviceAccount by name",
+                "description": "Delete a roleBinding by name",
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Delete a serviceAccount",
-                "operationId": "delete-object-serviceAccount",
+                "summary": "Delete a roleBinding",
+                "operationId": "delete-object-roleBinding",
                 "parameters": [
                     {
                         "type": "string",
@@ -6867,17 +7228,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/services/{namespace}": {
+        "/api/v1/objects/{contextName}/roles/{namespace}": {
             "get": {
-                "description": "Get all services",
+                "description": "Get all roles",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get all services",
-                "operationId": "get-object-services",
+                "summary": "Get all roles",
+                "operationId": "get-object-roles",
                 "parameters": [
                     {
                         "type": "string",
@@ -6900,7 +7261,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Service"
+                                "$ref": "#/definitions/Role"
                             }
                         }
                     },
@@ -6921,7 +7282,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a service.",
+                "description": "Update a role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6931,8 +7292,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Update a service",
-                "operationId": "update-object-service",
+                "summary": "Update a role",
+                "operationId": "update-object-role",
                 "parameters": [
                     {
                         "type": "string",
@@ -6949,13 +7310,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the service",
+                        "description": "the definition of the role",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Service"
+                            "$ref": "#/definitions/Role"
                         }
                     }
                 ],
@@ -6964,7 +7325,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Service"
+                            "$ref": "#/definitions/Role"
                         }
                     },
                     "400": {
@@ -6991,7 +7352,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a service.",
+                "description": "Create a role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7001,8 +7362,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Create a service",
-                "operationId": "create-object-service",
+                "summary": "Create a role",
+                "operationId": "create-object-role",
                 "parameters": [
                     {
                         "type": "string",
@@ -7019,13 +7380,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the service",
+                        "description": "the definition of the role",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Service"
+                            "$ref": "#/definitions/Role"
                         }
                     }
                 ],
@@ -7034,7 +7395,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Service"
+                            "$ref": "#/definitions/Role"
                         }
                     },
                     "400": {
@@ -7061,17 +7422,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/services/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/roles/{namespace}/{name}": {
             "get": {
-                "description": "Get a service by name",
+                "description": "Get a role by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get a service",
-                "operationId": "get-object-service",
+                "summary": "Get a role",
+                "operationId": "get-object-role",
                 "parameters": [
                     {
                         "type": "string",
@@ -7100,7 +7461,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/Service"
+                            "$ref": "#/definitions/Role"
                         }
                     },
                     "404": {
@@ -7120,12 +7481,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a service by name",
+                "description": "Delete a role by name",
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Delete a service",
-                "operationId": "delete-object-service",
+                "summary": "Delete a role",
+                "operationId": "delete-object-role",
                 "parameters": [
                     {
                         "type": "string",
@@ -7167,17 +7528,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/statefulSets/{namespace}": {
+        "/api/v1/objects/{contextName}/secrets/{namespace}": {
             "get": {
-                "description": "Get all statefulSets",
+                "description": "Get all secrets",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get all statefulSets",
-                "operationId": "get-object-statefulSets",
+                "summary": "Get all secrets",
+                "operationId": "get-object-secrets",
                 "parameters": [
                     {
                         "type": "string",
@@ -7200,7 +7561,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/StatefulSet"
+                                "$ref": "#/definitions/MaskedSecret"
                             }
                         }
                     },
@@ -7221,7 +7582,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a statefulSet.",
+                "description": "Update a secret.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7231,8 +7592,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Update a statefulSet",
-                "operationId": "update-object-statefulSet",
+                "summary": "Update a secret",
+                "operationId": "update-object-secret",
                 "parameters": [
                     {
                         "type": "string",
@@ -7249,13 +7610,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the statefulSet",
+                        "description": "the definition of the secret",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/StatefulSet"
+                            "$ref": "#/definitions/Secret"
                         }
                     }
                 ],
@@ -7264,7 +7625,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/StatefulSet"
+                            "$ref": "#/definitions/MaskedSecret"
                         }
                     },
                     "400": {
@@ -7291,7 +7652,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a statefulSet.",
+                "description": "Create a secret.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7301,8 +7662,8 @@ var doc = `{
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Create a statefulSet",
-                "operationId": "create-object-statefulSet",
+                "summary": "Create a secret",
+                "operationId": "create-object-secret",
                 "parameters": [
                     {
                         "type": "string",
@@ -7319,13 +7680,13 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the statefulSet",
+                        "description": "the definition of the secret",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/StatefulSet"
+                            "$ref": "#/definitions/Secret"
                         }
                     }
                 ],
@@ -7334,7 +7695,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/StatefulSet"
+                            "$ref": "#/definitions/MaskedSecret"
                         }
                     },
                     "400": {
@@ -7361,17 +7722,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/statefulSets/{namespace}/{name}": {
+        "/api/v1/objects/{contextName}/secrets/{namespace}/{name}": {
             "get": {
-                "description": "Get a statefulSet by name",
+                "description": "Get a secret by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get a statefulSet",
-                "operationId": "get-object-statefulSet",
+                "summary": "Get a secret",
+                "operationId": "get-object-secret",
                 "parameters": [
                     {
                         "type": "string",
@@ -7400,7 +7761,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/StatefulSet"
+                            "$ref": "#/definitions/MaskedSecret"
                         }
                     },
                     "404": {
@@ -7420,12 +7781,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a statefulSet by name",
+                "description": "Delete a secret by name",
                 "tags": [
                     "ObjectsNamespaceLevel"
                 ],
-                "summary": "Delete a statefulSet",
-                "operationId": "delete-object-statefulSet",
+                "summary": "Delete a secret",
+                "operationId": "delete-object-secret",
                 "parameters": [
                     {
                         "type": "string",
@@ -7467,17 +7828,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/storageClasses": {
+        "/api/v1/objects/{contextName}/serviceAccounts/{namespace}": {
             "get": {
-                "description": "Get all storageClasses",
+                "description": "Get all serviceAccounts",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get all storageClasses",
-                "operationId": "get-object-storageClasses",
+                "summary": "Get all serviceAccounts",
+                "operationId": "get-object-serviceAccounts",
                 "parameters": [
                     {
                         "type": "string",
@@ -7485,6 +7846,13 @@ var doc = `{
                         "name": "contextName",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -7493,7 +7861,7 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/StorageClass"
+                                "$ref": "#/definitions/ServiceAccount"
                             }
                         }
                     },
@@ -7514,7 +7882,7 @@ var doc = `{
                 }
             },
             "put": {
-                "description": "Update a storageClass.",
+                "description": "Update a serviceAccount.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7522,10 +7890,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Update a storageClass",
-                "operationId": "update-object-storageClass",
+                "summary": "Update a serviceAccount",
+                "operationId": "update-object-serviceAccount",
                 "parameters": [
                     {
                         "type": "string",
@@ -7535,13 +7903,20 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the storageClass",
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the serviceAccount",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/StorageClass"
+                            "$ref": "#/definitions/ServiceAccount"
                         }
                     }
                 ],
@@ -7550,7 +7925,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/StorageClass"
+                            "$ref": "#/definitions/ServiceAccount"
                         }
                     },
                     "400": {
@@ -7577,7 +7952,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create a storageClass.",
+                "description": "Create a serviceAccount.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7585,10 +7960,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Create a storageClass",
-                "operationId": "create-object-storageClass",
+                "summary": "Create a serviceAccount",
+                "operationId": "create-object-serviceAccount",
                 "parameters": [
                     {
                         "type": "string",
@@ -7598,13 +7973,20 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the definition of the storageClass",
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the serviceAccount",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/StorageClass"
+                            "$ref": "#/definitions/ServiceAccount"
                         }
                     }
                 ],
@@ -7613,7 +7995,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/StorageClass"
+                            "$ref": "#/definitions/ServiceAccount"
                         }
                     },
                     "400": {
@@ -7640,17 +8022,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/objects/{contextName}/storageClasses/{name}": {
+        "/api/v1/objects/{contextName}/serviceAccounts/{namespace}/{name}": {
             "get": {
-                "description": "Get a storageClass by name",
+                "description": "Get a serviceAccount by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Get a storageClass",
-                "operationId": "get-object-storageClass",
+                "summary": "Get a serviceAccount",
+                "operationId": "get-object-serviceAccount",
                 "parameters": [
                     {
                         "type": "string",
@@ -7659,6 +8041,13 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -7672,7 +8061,7 @@ var doc = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/StorageClass"
+                            "$ref": "#/definitions/ServiceAccount"
                         }
                     },
                     "404": {
@@ -7692,12 +8081,12 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete a storageClass by name",
+                "description": "Delete a serviceAccount by name",
                 "tags": [
-                    "ObjectsClusterLevel"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Delete a storageClass",
-                "operationId": "delete-object-storageClass",
+                "summary": "Delete a serviceAccount",
+                "operationId": "delete-object-serviceAccount",
                 "parameters": [
                     {
                         "type": "string",
@@ -7706,6 +8095,13 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "description": "the name of the object",
@@ -7732,20 +8128,17 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/search/{contextName}": {
-            "post": {
-                "description": "Search the context for all kind objects. All the parameters (except the object types) can be given as regexp.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/objects/{contextName}/services/{namespace}": {
+            "get": {
+                "description": "Get all services",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Search"
+                    "ObjectsNamespaceLevel"
                 ],
-                "summary": "Search objects",
-                "operationId": "post-search",
+                "summary": "Get all services",
+                "operationId": "get-object-services",
                 "parameters": [
                     {
                         "type": "string",
@@ -7755,8 +8148,1022 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "the parameters of the search",
-                        "name": "body",
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Service"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a service.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ObjectsNamespaceLevel"
+                ],
+                "summary": "Update a service",
+                "operationId": "update-object-service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the service",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/Service"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/Service"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a service.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ObjectsNamespaceLevel"
+                ],
+                "summary": "Create a service",
+                "operationId": "create-object-service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the service",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/Service"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/Service"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/objects/{contextName}/services/{namespace}/{name}": {
+            "get": {
+                "description": "Get a service by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ObjectsNamespaceLevel"
+                ],
+                "summary": "Get a service",
+                "operationId": "get-object-service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the object",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/Service"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a service by name",
+                "tags": [
+                    "ObjectsNamespaceLevel"
+                ],
+                "summary": "Delete a service",
+                "operationId": "delete-object-service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the object",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/objects/{contextName}/statefulSets/{namespace}": {
+            "get": {
+                "description": "Get all statefulSets",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ObjectsNamespaceLevel"
+                ],
+                "summary": "Get all statefulSets",
+                "operationId": "get-object-statefulSets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/StatefulSet"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a statefulSet.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ObjectsNamespaceLevel"
+                ],
+                "summary": "Update a statefulSet",
+                "operationId": "update-object-statefulSet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the statefulSet",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/StatefulSet"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/StatefulSet"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a statefulSet.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ObjectsNamespaceLevel"
+                ],
+                "summary": "Create a statefulSet",
+                "operationId": "create-object-statefulSet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the statefulSet",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/StatefulSet"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/StatefulSet"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/objects/{contextName}/statefulSets/{namespace}/{name}": {
+            "get": {
+                "description": "Get a statefulSet by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ObjectsNamespaceLevel"
+                ],
+                "summary": "Get a statefulSet",
+                "operationId": "get-object-statefulSet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the object",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/StatefulSet"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a statefulSet by name",
+                "tags": [
+                    "ObjectsNamespaceLevel"
+                ],
+                "summary": "Delete a statefulSet",
+                "operationId": "delete-object-statefulSet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the object",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/objects/{contextName}/storageClasses": {
+            "get": {
+                "description": "Get all storageClasses",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ObjectsClusterLevel"
+                ],
+                "summary": "Get all storageClasses",
+                "operationId": "get-object-storageClasses",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/StorageClass"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a storageClass.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ObjectsClusterLevel"
+                ],
+                "summary": "Update a storageClass",
+                "operationId": "update-object-storageClass",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the storageClass",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/StorageClass"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/StorageClass"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a storageClass.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ObjectsClusterLevel"
+                ],
+                "summary": "Create a storageClass",
+                "operationId": "create-object-storageClass",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the definition of the storageClass",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/StorageClass"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/StorageClass"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/objects/{contextName}/storageClasses/{name}": {
+            "get": {
+                "description": "Get a storageClass by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ObjectsClusterLevel"
+                ],
+                "summary": "Get a storageClass",
+                "operationId": "get-object-storageClass",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the object",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/StorageClass"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a storageClass by name",
+                "tags": [
+                    "ObjectsClusterLevel"
+                ],
+                "summary": "Delete a storageClass",
+                "operationId": "delete-object-storageClass",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the object",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/reveal/{contextName}/configMaps/{namespace}/{name}/{key}": {
+            "get": {
+                "description": "Reveal the value of a single key of a config map. The caller must be explicitly granted the verb reveal by the authorization policy. The value is given as text if possible, otherwise encoded in base64.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reveal"
+                ],
+                "summary": "Reveal a value of a config map",
+                "operationId": "get-reveal-config-map",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the config map",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the key of the value",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/RevealedValue"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/reveal/{contextName}/secrets/{namespace}/{name}/{key}": {
+            "get": {
+                "description": "Reveal the value of a single key of a secret. The caller must be explicitly granted the verb reveal by the authorization policy. The value is given as text if possible, otherwise encoded in base64.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reveal"
+                ],
+                "summary": "Reveal a value of a secret",
+                "operationId": "get-reveal-secret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the namespace",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the secret",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the key of the value",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/RevealedValue"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/search/{contextName}": {
+            "post": {
+                "description": "Search the context for all kind objects. All the parameters (except the object types) can be given as regexp. The objects that the caller is not allowed to list are not returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Search objects",
+                "operationId": "post-search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the context",
+                        "name": "contextName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the parameters of the search",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
@@ -7775,6 +9182,13 @@ var doc = `{
                             }
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/HTTPError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -7794,7 +9208,7 @@ var doc = `{
         },
         "/api/v1/summary/{contextName}": {
             "get": {
-                "description": "get the summary of a configuration",
+                "description": "get the summary of a configuration. The objects that the caller is not allowed to list are not returned.",
                 "produces": [
                     "application/json"
                 ],
@@ -10667,246 +12081,560 @@ var doc = `{
                         "type": "string",
                     }
                 },
-                "clusterName": {
+                "clusterName": {
+                    "type": "string"
+                },
+                "managedFields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/metav1.ManagedFieldsEntry",
+                    }
+                }
+            }
+        },
+        "metav1.OwnerReference": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "uid": {
+                    "type": "string"
+                },
+                "controller": {
+                    "type": "boolean"
+                },
+                "blockOwnerDeletion": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "metav1.Status": {
+            "type": "object",
+            "properties": {
+                "metadata": {
+                    "type": "object",
+                    "$ref": "#/definitions/metav1.ListMeta",
+                },
+                "status": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "details": {
+                    "$ref": "#/definitions/metav1.StatusDetails",
+                },
+                "code": {
+                    "type": "integer"
+                }
+            }
+        },
+        "metav1.StatusCause": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "field": {
+                    "type": "string"
+                }
+            }
+        },
+        "metav1.StatusDetails": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "uid": {
+                    "type": "string"
+                },
+                "causes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/metav1.StatusCause",
+                    }
+                },
+                "retryAfterSeconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "networkingv1.IPBlock": {
+            "type": "object",
+            "properties": {
+                "cidr": {
+                    "type": "string"
+                },
+                "except": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                    }
+                }
+            }
+        },
+        "networkingv1.NetworkPolicySpec": {
+            "type": "object",
+            "properties": {
+                "podSelector": {
+                    "type": "object",
+                    "$ref": "#/definitions/metav1.LabelSelector",
+                },
+                "ingress": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/networkingv1.NetworkPolicyIngressRule",
+                    }
+                },
+                "egress": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/networkingv1.NetworkPolicyEgressRule",
+                    }
+                },
+                "policyTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                    }
+                }
+            }
+        },
+        "networkingv1.NetworkPolicyEgressRule": {
+            "type": "object",
+            "properties": {
+                "ports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/networkingv1.NetworkPolicyPort",
+                    }
+                },
+                "to": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/networkingv1.NetworkPolicyPeer",
+                    }
+                }
+            }
+        },
+        "networkingv1.NetworkPolicyIngressRule": {
+            "type": "object",
+            "properties": {
+                "ports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/networkingv1.NetworkPolicyPort",
+                    }
+                },
+                "from": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/networkingv1.NetworkPolicyPeer",
+                    }
+                }
+            }
+        },
+        "networkingv1.NetworkPolicyPeer": {
+            "type": "object",
+            "properties": {
+                "podSelector": {
+                    "$ref": "#/definitions/metav1.LabelSelector",
+                },
+                "namespaceSelector": {
+                    "$ref": "#/definitions/metav1.LabelSelector",
+                },
+                "ipBlock": {
+                    "$ref": "#/definitions/networkingv1.IPBlock",
+                }
+            }
+        },
+        "networkingv1.NetworkPolicyPort": {
+            "type": "object",
+            "properties": {
+                "protocol": {
+                    "type": "string"
+                },
+                "port": {
+                    "$ref": "#/definitions/intstr.IntOrString",
+                }
+            }
+        },
+        "rbacv1.PolicyRule": {
+            "type": "object",
+            "properties": {
+                "verbs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                    }
+                },
+                "apiGroups": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                    }
+                },
+                "resources": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                    }
+                },
+                "resourceNames": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                    }
+                },
+                "nonResourceURLs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                    }
+                }
+            }
+        },
+        "rbacv1.RoleRef": {
+            "type": "object",
+            "properties": {
+                "apiGroup": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "rbacv1.Subject": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string"
+                },
+                "apiGroup": {
                     "type": "string"
                 },
-                "managedFields": {
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                }
+            }
+        },
+        "rbacv1.AggregationRule": {
+            "type": "object",
+            "properties": {
+                "clusterRoleSelectors": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/metav1.ManagedFieldsEntry",
+                        "$ref": "#/definitions/metav1.LabelSelector",
                     }
                 }
             }
         },
-        "metav1.OwnerReference": {
+        "resource.Quantity": {
             "type": "object",
             "properties": {
-                "apiVersion": {
+            }
+        },
+        "MapOfStrings": {
+            "type": "object",
+            "additionalProperties": {
+                "type": "array",
+                "items": {
                     "type": "string"
-                },
-                "kind": {
+                }
+            }
+        },
+        "HTTPError": {
+            "type": "object",
+            "properties": {
+                "message": "string"
+            }
+        },
+        "RevealedValue": {
+            "type": "object",
+            "properties": {
+                "key": {
                     "type": "string"
                 },
-                "name": {
+                "value": {
                     "type": "string"
                 },
-                "uid": {
+                "encoding": {
                     "type": "string"
-                },
-                "controller": {
+                }
+            }
+        },
+        "MaskedValue": {
+            "type": "object",
+            "description": "A masked value, described by its length and its HMAC-SHA256 keyed with a secret of the server",
+            "properties": {
+                "masked": {
                     "type": "boolean"
                 },
-                "blockOwnerDeletion": {
-                    "type": "boolean"
+                "length": {
+                    "type": "integer"
+                },
+                "hmacSha256": {
+                    "type": "string"
                 }
             }
         },
-        "metav1.Status": {
+        "MaskedSecret": {
             "type": "object",
+            "description": "A Secret whose values are masked when the masking is enabled, or returned as they are otherwise",
             "properties": {
                 "metadata": {
                     "type": "object",
-                    "$ref": "#/definitions/metav1.ListMeta",
+                    "$ref": "#/definitions/metav1.ObjectMeta",
                 },
-                "status": {
-                    "type": "string"
+                "data": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/MaskedValue",
+                    }
                 },
-                "message": {
-                    "type": "string"
+                "stringData": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/MaskedValue",
+                    }
                 },
-                "reason": {
+                "type": {
                     "type": "string"
                 },
-                "details": {
-                    "$ref": "#/definitions/metav1.StatusDetails",
-                },
-                "code": {
-                    "type": "integer"
-                }
             }
         },
-        "metav1.StatusCause": {
+        "MaskedConfigMap": {
             "type": "object",
+            "description": "A ConfigMap whose values are masked when the masking is enabled, or returned as they are otherwise",
             "properties": {
-                "reason": {
-                    "type": "string"
+                "metadata": {
+                    "type": "object",
+                    "$ref": "#/definitions/metav1.ObjectMeta",
                 },
-                "message": {
-                    "type": "string"
+                "data": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/MaskedValue",
+                    }
+                },
+                "binaryData": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/MaskedValue",
+                    }
                 },
-                "field": {
-                    "type": "string"
-                }
             }
         },
-        "metav1.StatusDetails": {
+        "audit.Entry": {
             "type": "object",
             "properties": {
-                "name": {
+                "body": {
                     "type": "string"
                 },
-                "group": {
-                    "type": "string"
-                },
-                "kind": {
+                "context": {
                     "type": "string"
                 },
-                "uid": {
+                "error": {
                     "type": "string"
                 },
-                "causes": {
+                "groups": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/metav1.StatusCause",
+                        "type": "string"
                     }
                 },
-                "retryAfterSeconds": {
+                "method": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "objectType": {
+                    "type": "string"
+                },
+                "outcome": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "status": {
                     "type": "integer"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "user": {
+                    "type": "string"
+                },
+                "verb": {
+                    "type": "string"
                 }
             }
         },
-        "networkingv1.IPBlock": {
+        "context.AuthProviderConfig": {
             "type": "object",
             "properties": {
-                "cidr": {
-                    "type": "string"
+                "config": {
+                    "type": "object"
                 },
-                "except": {
-                    "type": "array",
-                    "items": {
-                        "type": "string",
-                    }
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "networkingv1.NetworkPolicySpec": {
+        "context.CertificateInfo": {
             "type": "object",
             "properties": {
-                "podSelector": {
-                    "type": "object",
-                    "$ref": "#/definitions/metav1.LabelSelector",
+                "daysRemaining": {
+                    "type": "integer"
                 },
-                "ingress": {
+                "dnsNames": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/networkingv1.NetworkPolicyIngressRule",
+                        "type": "string"
                     }
                 },
-                "egress": {
+                "emailAddresses": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/networkingv1.NetworkPolicyEgressRule",
+                        "type": "string"
                     }
                 },
-                "policyTypes": {
-                    "type": "array",
-                    "items": {
-                        "type": "string",
-                    }
-                }
-            }
-        },
-        "networkingv1.NetworkPolicyEgressRule": {
-            "type": "object",
-            "properties": {
-                "ports": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/networkingv1.NetworkPolicyPort",
-                    }
+                "error": {
+                    "type": "string"
                 },
-                "to": {
+                "expired": {
+                    "type": "boolean"
+                },
+                "ipAddresses": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/networkingv1.NetworkPolicyPeer",
+                        "type": "string"
                     }
+                },
+                "isCA": {
+                    "type": "boolean"
+                },
+                "issuer": {
+                    "type": "string"
+                },
+                "notAfter": {
+                    "type": "string"
+                },
+                "notBefore": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
                 }
             }
         },
-        "networkingv1.NetworkPolicyIngressRule": {
+        "context.ContextHealth": {
             "type": "object",
             "properties": {
-                "ports": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/networkingv1.NetworkPolicyPort",
-                    }
+                "checkedAt": {
+                    "type": "string"
                 },
-                "from": {
+                "contextName": {
+                    "type": "string"
+                },
+                "credentialsValid": {
+                    "type": "boolean"
+                },
+                "errors": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/networkingv1.NetworkPolicyPeer",
+                        "type": "string"
                     }
-                }
-            }
-        },
-        "networkingv1.NetworkPolicyPeer": {
-            "type": "object",
-            "properties": {
-                "podSelector": {
-                    "$ref": "#/definitions/metav1.LabelSelector",
                 },
-                "namespaceSelector": {
-                    "$ref": "#/definitions/metav1.LabelSelector",
+                "latencyMs": {
+                    "type": "integer"
                 },
-                "ipBlock": {
-                    "$ref": "#/definitions/networkingv1.IPBlock",
-                }
-            }
-        },
-        "networkingv1.NetworkPolicyPort": {
-            "type": "object",
-            "properties": {
-                "protocol": {
+                "metricsAvailable": {
+                    "type": "boolean"
+                },
+                "permissions": {
+                    "type": "object",
+                    "$ref": "#/definitions/context.ContextPermissions"
+                },
+                "reachable": {
+                    "type": "boolean"
+                },
+                "server": {
                     "type": "string"
                 },
-                "port": {
-                    "$ref": "#/definitions/intstr.IntOrString",
+                "serverVersion": {
+                    "type": "string"
+                },
+                "tlsValid": {
+                    "type": "boolean"
                 }
             }
         },
-        "rbacv1.PolicyRule": {
+        "context.ContextPermissions": {
             "type": "object",
             "properties": {
-                "verbs": {
-                    "type": "array",
-                    "items": {
-                        "type": "string",
-                    }
-                },
-                "apiGroups": {
-                    "type": "array",
-                    "items": {
-                        "type": "string",
-                    }
+                "incomplete": {
+                    "type": "boolean"
                 },
-                "resources": {
-                    "type": "array",
-                    "items": {
-                        "type": "string",
-                    }
+                "namespace": {
+                    "type": "string"
                 },
-                "resourceNames": {
+                "nonResourceRules": {
                     "type": "array",
                     "items": {
-                        "type": "string",
+                        "$ref": "#/definitions/context.NonResourceRule"
                     }
                 },
-                "nonResourceURLs": {
+                "resourceRules": {
                     "type": "array",
                     "items": {
-                        "type": "string",
+                        "$ref": "#/definitions/context.ResourceRule"
                     }
                 }
             }
         },
-        "rbacv1.RoleRef": {
+        "context.ContextState": {
             "type": "object",
             "properties": {
-                "apiGroup": {
+                "connectionState": {
                     "type": "string"
                 },
-                "kind": {
+                "error": {
                     "type": "string"
                 },
                 "name": {
@@ -10914,54 +12642,26 @@ var doc = `{
                 }
             }
         },
-        "rbacv1.Subject": {
+        "context.DefinitionClientSettings": {
             "type": "object",
             "properties": {
-                "kind": {
-                    "type": "string"
+                "burst": {
+                    "type": "integer"
                 },
-                "apiGroup": {
+                "proxy-url": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "qps": {
+                    "type": "number"
                 },
-                "namespace": {
+                "timeout": {
                     "type": "string"
-                }
-            }
-        },
-        "rbacv1.AggregationRule": {
-            "type": "object",
-            "properties": {
-                "clusterRoleSelectors": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/metav1.LabelSelector",
-                    }
-                }
-            }
-        },
-        "resource.Quantity": {
-            "type": "object",
-            "properties": {
-            }
-        },
-        "MapOfStrings": {
-            "type": "object",
-            "additionalProperties": {
-                "type": "array",
-                "items": {
+                },
+                "user-agent-suffix": {
                     "type": "string"
                 }
             }
         },
-        "HTTPError": {
-            "type": "object",
-            "properties": {
-                "message": "string"
-            }
-        },
         "context.DefinitionCluster": {
             "type": "object",
             "properties": {
@@ -10996,6 +12696,10 @@ var doc = `{
         "context.DefinitionUser": {
             "type": "object",
             "properties": {
+                "auth-provider": {
+                    "type": "object",
+                    "$ref": "#/definitions/context.AuthProviderConfig"
+                },
                 "client-certificate": {
                     "type": "string"
                 },
@@ -11008,14 +12712,107 @@ var doc = `{
                 "client-key-data": {
                     "type": "string"
                 },
+                "exec": {
+                    "type": "object",
+                    "$ref": "#/definitions/context.ExecConfig"
+                },
                 "password": {
                     "type": "string"
                 },
+                "token": {
+                    "type": "string"
+                },
+                "tokenFile": {
+                    "type": "string"
+                },
                 "username": {
                     "type": "string"
                 }
             }
         },
+        "context.ExecConfig": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "args": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "command": {
+                    "type": "string"
+                },
+                "env": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/context.ExecEnvVar"
+                    }
+                }
+            }
+        },
+        "context.ExecEnvVar": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "context.ExpiringCertificate": {
+            "type": "object",
+            "properties": {
+                "certificate": {
+                    "type": "object",
+                    "$ref": "#/definitions/context.CertificateInfo"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner": {
+                    "type": "string"
+                }
+            }
+        },
+        "context.ImportChange": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "newName": {
+                    "type": "string"
+                },
+                "objectType": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "context.ImportReport": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/context.ImportChange"
+                    }
+                },
+                "dryRun": {
+                    "type": "boolean"
+                }
+            }
+        },
         "context.KubeConfig": {
             "type": "object",
             "properties": {
@@ -11040,6 +12837,12 @@ var doc = `{
                 "kind": {
                     "type": "string"
                 },
+                "kuboxy-client-settings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/context.NamedClientSettings"
+                    }
+                },
                 "preferences": {
                     "type": "object"
                 },
@@ -11051,9 +12854,27 @@ var doc = `{
                 }
             }
         },
+        "context.NamedClientSettings": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "settings": {
+                    "type": "object",
+                    "$ref": "#/definitions/context.DefinitionClientSettings"
+                }
+            }
+        },
         "context.NamedCluster": {
             "type": "object",
             "properties": {
+                "certificates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/context.CertificateInfo"
+                    }
+                },
                 "cluster": {
                     "type": "object",
                     "$ref": "#/definitions/context.DefinitionCluster"
@@ -11078,6 +12899,12 @@ var doc = `{
         "context.NamedUser": {
             "type": "object",
             "properties": {
+                "certificates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/context.CertificateInfo"
+                    }
+                },
                 "name": {
                     "type": "string"
                 },
@@ -11087,6 +12914,43 @@ var doc = `{
                 }
             }
         },
+        "context.NonResourceRule": {
+            "type": "object",
+            "properties": {
+                "nonResourceURLs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "verbs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "context.ParamClientSettings": {
+            "type": "object",
+            "properties": {
+                "burst": {
+                    "type": "integer"
+                },
+                "proxyURL": {
+                    "type": "string"
+                },
+                "qps": {
+                    "type": "number"
+                },
+                "timeout": {
+                    "type": "string"
+                },
+                "userAgentSuffix": {
+                    "type": "string"
+                }
+            }
+        },
         "context.ParamClusterCertificateEmbedded": {
             "type": "object",
             "properties": {
@@ -11131,6 +12995,17 @@ var doc = `{
                 }
             }
         },
+        "context.ParamCredentialsAuthProvider": {
+            "type": "object",
+            "properties": {
+                "config": {
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
         "context.ParamCredentialsCertificateEmbedded": {
             "type": "object",
             "properties": {
@@ -11153,6 +13028,45 @@ var doc = `{
                 }
             }
         },
+        "context.ParamCredentialsExec": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "args": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "command": {
+                    "type": "string"
+                },
+                "env": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/context.ExecEnvVar"
+                    }
+                }
+            }
+        },
+        "context.ParamCredentialsToken": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "context.ParamCredentialsTokenFile": {
+            "type": "object",
+            "properties": {
+                "tokenFile": {
+                    "type": "string"
+                }
+            }
+        },
         "context.ParamCredentialsUserNamePassword": {
             "type": "object",
             "properties": {
@@ -11164,6 +13078,35 @@ var doc = `{
                 }
             }
         },
+        "context.ResourceRule": {
+            "type": "object",
+            "properties": {
+                "apiGroups": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "resourceNames": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "resources": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "verbs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "report.ClusterStateReport": {
             "type": "object",
             "properties": {
@@ -11551,6 +13494,16 @@ var doc = `{
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        },
+        "BasicAuth": {
+            "type": "basic"
+        }
     }
 }`
 
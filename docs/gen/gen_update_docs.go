@@ -272,6 +272,10 @@ func generateAdditionalDocumentation() []string {
 	// Add the hard coded objects
 	results = addMapOfStrings(results)
 	results = addHTTPError(results)
+	results = addRevealedValue(results)
+	results = addMaskedValue(results)
+	results = addMaskedObject(results, "MaskedSecret", "Secret", []string{"data", "stringData"}, "type")
+	results = addMaskedObject(results, "MaskedConfigMap", "ConfigMap", []string{"data", "binaryData"}, "")
 
 	missingNames := make([]string, 0, len(missings))
 	for k := range missings {
@@ -340,6 +344,78 @@ func addHTTPError(lines []string) []string {
 	return lines
 }
 
+func addRevealedValue(lines []string) []string {
+
+	lines = append(lines, fmt.Sprintf("\"RevealedValue\": {\n"))
+	lines = append(lines, fmt.Sprintf("    \"type\": \"object\",\n"))
+	lines = append(lines, fmt.Sprintf("    \"properties\": {\n"))
+	lines = append(lines, fmt.Sprintf("        \"key\": {\n"))
+	lines = append(lines, fmt.Sprintf("            \"type\": \"string\"\n"))
+	lines = append(lines, fmt.Sprintf("        },\n"))
+	lines = append(lines, fmt.Sprintf("        \"value\": {\n"))
+	lines = append(lines, fmt.Sprintf("            \"type\": \"string\"\n"))
+	lines = append(lines, fmt.Sprintf("        },\n"))
+	lines = append(lines, fmt.Sprintf("        \"encoding\": {\n"))
+	lines = append(lines, fmt.Sprintf("            \"type\": \"string\"\n"))
+	lines = append(lines, fmt.Sprintf("        }\n"))
+	lines = append(lines, fmt.Sprintf("    }\n"))
+	lines = append(lines, fmt.Sprintf("},\n"))
+
+	return lines
+}
+
+func addMaskedValue(lines []string) []string {
+
+	lines = append(lines, fmt.Sprintf("\"MaskedValue\": {\n"))
+	lines = append(lines, fmt.Sprintf("    \"type\": \"object\",\n"))
+	lines = append(lines, fmt.Sprintf("    \"description\": \"A masked value, described by its length and its HMAC-SHA256 keyed with a secret of the server\",\n"))
+	lines = append(lines, fmt.Sprintf("    \"properties\": {\n"))
+	lines = append(lines, fmt.Sprintf("        \"masked\": {\n"))
+	lines = append(lines, fmt.Sprintf("            \"type\": \"boolean\"\n"))
+	lines = append(lines, fmt.Sprintf("        },\n"))
+	lines = append(lines, fmt.Sprintf("        \"length\": {\n"))
+	lines = append(lines, fmt.Sprintf("            \"type\": \"integer\"\n"))
+	lines = append(lines, fmt.Sprintf("        },\n"))
+	lines = append(lines, fmt.Sprintf("        \"hmacSha256\": {\n"))
+	lines = append(lines, fmt.Sprintf("            \"type\": \"string\"\n"))
+	lines = append(lines, fmt.Sprintf("        }\n"))
+	lines = append(lines, fmt.Sprintf("    }\n"))
+	lines = append(lines, fmt.Sprintf("},\n"))
+
+	return lines
+}
+
+// addMaskedObject adds a Secret or a ConfigMap as returned when it is masked, its values being replaced by a
+// MaskedValue. When the masking is disabled, the values are returned as they are
+func addMaskedObject(lines []string, name string, objectType string, maskedFields []string, stringField string) []string {
+
+	lines = append(lines, fmt.Sprintf("\"%s\": {\n", name))
+	lines = append(lines, fmt.Sprintf("    \"type\": \"object\",\n"))
+	lines = append(lines, fmt.Sprintf("    \"description\": \"A %s whose values are masked when the masking is enabled, or returned as they are otherwise\",\n", objectType))
+	lines = append(lines, fmt.Sprintf("    \"properties\": {\n"))
+	lines = append(lines, fmt.Sprintf("        \"metadata\": {\n"))
+	lines = append(lines, fmt.Sprintf("            \"type\": \"object\",\n"))
+	lines = append(lines, fmt.Sprintf("            \"$ref\": \"#/definitions/metav1.ObjectMeta\",\n"))
+	lines = append(lines, fmt.Sprintf("        },\n"))
+	for _, field := range maskedFields {
+		lines = append(lines, fmt.Sprintf("        \"%s\": {\n", field))
+		lines = append(lines, fmt.Sprintf("            \"type\": \"object\",\n"))
+		lines = append(lines, fmt.Sprintf("            \"additionalProperties\": {\n"))
+		lines = append(lines, fmt.Sprintf("                \"$ref\": \"#/definitions/MaskedValue\",\n"))
+		lines = append(lines, fmt.Sprintf("            }\n"))
+		lines = append(lines, fmt.Sprintf("        },\n"))
+	}
+	if len(stringField) > 0 {
+		lines = append(lines, fmt.Sprintf("        \"%s\": {\n", stringField))
+		lines = append(lines, fmt.Sprintf("            \"type\": \"string\"\n"))
+		lines = append(lines, fmt.Sprintf("        },\n"))
+	}
+	lines = append(lines, fmt.Sprintf("    }\n"))
+	lines = append(lines, fmt.Sprintf("},\n"))
+
+	return lines
+}
+
 func iterateFields(v interface{}, prefix string, headObject bool) ([]string, []string) {
 
 	results := make([]string, 0, 50)
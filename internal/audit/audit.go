@@ -0,0 +1,92 @@
+// Package audit keeps the trail of the requests modifying the clusters or the configuration of the application
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// The outcomes of the requests
+const (
+	// Success is the outcome of the requests having succeeded
+	Success = "success"
+	// Failure is the outcome of the requests having failed or having been refused
+	Failure = "failure"
+)
+
+// Entry is the record of a request
+type Entry struct {
+	Timestamp  time.Time       `json:"timestamp"`
+	User       string          `json:"user,omitempty"`
+	Groups     []string        `json:"groups,omitempty"`
+	Method     string          `json:"method"`
+	Path       string          `json:"path"`
+	Context    string          `json:"context,omitempty"`
+	Namespace  string          `json:"namespace,omitempty"`
+	ObjectType string          `json:"objectType,omitempty"`
+	Name       string          `json:"name,omitempty"`
+	Verb       string          `json:"verb,omitempty"`
+	Status     int             `json:"status"`
+	Outcome    string          `json:"outcome"`
+	Error      string          `json:"error,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+}
+
+// Sink receives the entries of the audit
+type Sink interface {
+	// Write records an entry
+	Write(entry Entry) error
+	// Close releases the resources of the sink
+	Close() error
+}
+
+// The sinks receiving the entries
+var sinks = struct {
+	lock  sync.Mutex
+	sinks []Sink
+}{}
+
+// AddSink adds a sink receiving all the entries recorded from now on
+func AddSink(sink Sink) {
+
+	sinks.lock.Lock()
+	defer sinks.lock.Unlock()
+
+	sinks.sinks = append(sinks.sinks, sink)
+}
+
+// Record writes an entry to all the sinks. All the sinks are written, even if some of them fail, the first error
+// being returned
+func Record(entry Entry) error {
+
+	sinks.lock.Lock()
+	defer sinks.lock.Unlock()
+
+	var firstError error
+	for _, sink := range sinks.sinks {
+		if err := sink.Write(entry); err != nil && firstError == nil {
+			firstError = fmt.Errorf("unable to write the audit entry due to: %v", err.Error())
+		}
+	}
+
+	return firstError
+}
+
+// CloseSinks closes and removes all the sinks. The first error is returned
+func CloseSinks() error {
+
+	sinks.lock.Lock()
+	defer sinks.lock.Unlock()
+
+	var firstError error
+	for _, sink := range sinks.sinks {
+		if err := sink.Close(); err != nil && firstError == nil {
+			firstError = err
+		}
+	}
+	sinks.sinks = nil
+
+	return firstError
+}
@@ -0,0 +1,108 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// FileSink writes the entries to a file, one JSON object by line. When the file reaches its maximum size, it is
+// renamed with the suffix .1, the previous backups being shifted (.1 becomes .2, etc.) and the oldest one removed
+type FileSink struct {
+	fileName    string
+	maxSize     int64
+	maxBackups  int
+	file        *os.File
+	currentSize int64
+}
+
+// NewFileSink opens, or creates, the file receiving the entries. A maxSize of 0 disables the rotation
+func NewFileSink(fileName string, maxSize int64, maxBackups int) (*FileSink, error) {
+
+	sink := &FileSink{
+		fileName:   fileName,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+
+	if err := sink.open(); err != nil {
+		return nil, err
+	}
+
+	return sink, nil
+}
+
+// Write appends an entry to the file, rotating the file first if the entry would exceed its maximum size
+func (sink *FileSink) Write(entry Entry) error {
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	if sink.maxSize > 0 && sink.currentSize > 0 && sink.currentSize+int64(len(line)) > sink.maxSize {
+		if err = sink.rotate(); err != nil {
+			return err
+		}
+	}
+
+	written, err := sink.file.Write(line)
+	sink.currentSize += int64(written)
+
+	return err
+}
+
+// Close closes the file
+func (sink *FileSink) Close() error {
+	return sink.file.Close()
+}
+
+// open opens the file in append mode
+func (sink *FileSink) open() error {
+
+	file, err := os.OpenFile(sink.fileName, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to open the audit file due to: %v", err.Error())
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("unable to read the size of the audit file due to: %v", err.Error())
+	}
+
+	sink.file = file
+	sink.currentSize = info.Size()
+
+	return nil
+}
+
+// rotate renames the current file as the first backup and opens a new one
+func (sink *FileSink) rotate() error {
+
+	if err := sink.file.Close(); err != nil {
+		return err
+	}
+
+	if sink.maxBackups > 0 {
+		// Shift the backups, the oldest one being overwritten
+		for i := sink.maxBackups - 1; i > 0; i-- {
+			if err := os.Rename(sink.backupName(i), sink.backupName(i+1)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+		if err := os.Rename(sink.fileName, sink.backupName(1)); err != nil {
+			return err
+		}
+	} else if err := os.Remove(sink.fileName); err != nil {
+		return err
+	}
+
+	return sink.open()
+}
+
+// backupName returns the name of a backup of the file
+func (sink *FileSink) backupName(index int) string {
+	return fmt.Sprintf("%s.%d", sink.fileName, index)
+}
@@ -0,0 +1,148 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// readPaths returns the paths of the entries written in an audit file, nil if the file does not exist
+func readPaths(t *testing.T, fileName string) []string {
+
+	file, err := os.Open(fileName)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	paths := make([]string, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry Entry
+		if err = json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("the line %s is not an entry: %v", scanner.Text(), err)
+		}
+		paths = append(paths, entry.Path)
+	}
+
+	return paths
+}
+
+func TestFileSinkRotation(t *testing.T) {
+
+	// The size of a line of the audit with a path of a single character
+	line, _ := json.Marshal(Entry{Path: "a"})
+	lineSize := int64(len(line) + 1)
+
+	tests := []struct {
+		name       string
+		maxSize    int64
+		maxBackups int
+		paths      []string
+		expected   map[string][]string
+	}{
+		{
+			name:     "no rotation",
+			maxSize:  0,
+			paths:    []string{"a", "b", "c"},
+			expected: map[string][]string{"audit.log": {"a", "b", "c"}, "audit.log.1": nil},
+		},
+		{
+			name:       "rotation with backups",
+			maxSize:    2 * lineSize,
+			maxBackups: 2,
+			paths:      []string{"a", "b", "c", "d", "e", "f", "g"},
+			expected:   map[string][]string{"audit.log": {"g"}, "audit.log.1": {"e", "f"}, "audit.log.2": {"c", "d"}, "audit.log.3": nil},
+		},
+		{
+			name:       "rotation without backup",
+			maxSize:    2 * lineSize,
+			maxBackups: 0,
+			paths:      []string{"a", "b", "c"},
+			expected:   map[string][]string{"audit.log": {"c"}, "audit.log.1": nil},
+		},
+		{
+			name:       "entry larger than the maximum size",
+			maxSize:    1,
+			maxBackups: 1,
+			paths:      []string{"a", "b"},
+			expected:   map[string][]string{"audit.log": {"b"}, "audit.log.1": {"a"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			directory, err := ioutil.TempDir("", "kuboxy-audit")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(directory)
+
+			sink, err := NewFileSink(filepath.Join(directory, "audit.log"), test.maxSize, test.maxBackups)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, path := range test.paths {
+				if err = sink.Write(Entry{Path: path}); err != nil {
+					t.Fatalf("unable to write: %v", err)
+				}
+			}
+			if err = sink.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			for fileName, expected := range test.expected {
+				actual := readPaths(t, filepath.Join(directory, fileName))
+				if strings.Join(actual, ",") != strings.Join(expected, ",") || (actual == nil) != (expected == nil) {
+					t.Errorf("expected the entries %v in %s, got %v", expected, fileName, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestFileSinkAppends(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "kuboxy-audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	fileName := filepath.Join(directory, "audit.log")
+
+	// The entries of the previous runs are kept, and counted in the size of the file
+	for _, path := range []string{"a", "b"} {
+		sink, err := NewFileSink(fileName, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = sink.Write(Entry{Path: path}); err != nil {
+			t.Fatal(err)
+		}
+		if err = sink.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if paths := readPaths(t, fileName); strings.Join(paths, ",") != "a,b" {
+		t.Errorf("expected the entries a,b, got %v", paths)
+	}
+
+	info, err := os.Stat(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected the permissions 0600, got %v", info.Mode().Perm())
+	}
+}
@@ -0,0 +1,97 @@
+package audit
+
+import (
+	"sync"
+	"time"
+)
+
+// Filter selects some entries. The empty fields select everything
+type Filter struct {
+	User       string
+	Context    string
+	Namespace  string
+	ObjectType string
+	Verb       string
+	Outcome    string
+	Since      time.Time
+	Limit      int
+}
+
+// MemorySink keeps the most recent entries in memory, the oldest entries being dropped when it is full
+type MemorySink struct {
+	lock    sync.RWMutex
+	entries []Entry
+	next    int
+	full    bool
+}
+
+// NewMemorySink creates a sink keeping the given number of entries
+func NewMemorySink(size int) *MemorySink {
+	return &MemorySink{
+		entries: make([]Entry, size),
+	}
+}
+
+// Write keeps an entry, replacing the oldest one if the sink is full
+func (sink *MemorySink) Write(entry Entry) error {
+
+	sink.lock.Lock()
+	defer sink.lock.Unlock()
+
+	if len(sink.entries) == 0 {
+		return nil
+	}
+
+	sink.entries[sink.next] = entry
+	sink.next = (sink.next + 1) % len(sink.entries)
+	if sink.next == 0 {
+		sink.full = true
+	}
+
+	return nil
+}
+
+// Close does nothing as the sink has no resource
+func (sink *MemorySink) Close() error {
+	return nil
+}
+
+// GetEntries returns the entries selected by the filter, the most recent first. If the filter has a limit, only
+// the most recent entries are returned
+func (sink *MemorySink) GetEntries(filter Filter) []Entry {
+
+	sink.lock.RLock()
+	defer sink.lock.RUnlock()
+
+	count := sink.next
+	if sink.full {
+		count = len(sink.entries)
+	}
+
+	result := make([]Entry, 0)
+	for i := 1; i <= count; i++ {
+
+		entry := sink.entries[(sink.next-i+len(sink.entries))%len(sink.entries)]
+		if !filter.matches(entry) {
+			continue
+		}
+
+		result = append(result, entry)
+		if filter.Limit > 0 && len(result) >= filter.Limit {
+			break
+		}
+	}
+
+	return result
+}
+
+// matches checks if an entry is selected by the filter
+func (filter Filter) matches(entry Entry) bool {
+	return (len(filter.User) == 0 || filter.User == entry.User) &&
+		(len(filter.Context) == 0 || filter.Context == entry.Context) &&
+		(len(filter.Namespace) == 0 || filter.Namespace == entry.Namespace) &&
+		(len(filter.ObjectType) == 0 || filter.ObjectType == entry.ObjectType) &&
+		(len(filter.Verb) == 0 || filter.Verb == entry.Verb) &&
+		(len(filter.Outcome) == 0 || filter.Outcome == entry.Outcome) &&
+		(filter.Since.IsZero() || !entry.Timestamp.Before(filter.Since))
+}
@@ -0,0 +1,82 @@
+package audit
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// getPaths returns the paths of the entries
+func getPaths(entries []Entry) string {
+
+	paths := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		paths = append(paths, entry.Path)
+	}
+
+	return strings.Join(paths, ",")
+}
+
+func TestMemorySink(t *testing.T) {
+
+	start := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	sink := NewMemorySink(4)
+	for i, entry := range []Entry{
+		{Path: "a", User: "alice", Verb: "create", Outcome: "success"},
+		{Path: "b", User: "bob", Verb: "delete", Outcome: "failure"},
+		{Path: "c", User: "alice", Verb: "update", Outcome: "success", Context: "dev"},
+		{Path: "d", User: "alice", Verb: "delete", Outcome: "success", Context: "dev"},
+		{Path: "e", User: "bob", Verb: "create", Outcome: "denied", Context: "production"},
+		{Path: "f", User: "alice", Verb: "create", Outcome: "success", Context: "production"},
+	} {
+		entry.Timestamp = start.Add(time.Duration(i) * time.Minute)
+		if err := sink.Write(entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		filter   Filter
+		expected string
+	}{
+		{name: "most recent first, oldest dropped", filter: Filter{}, expected: "f,e,d,c"},
+		{name: "by user", filter: Filter{User: "alice"}, expected: "f,d,c"},
+		{name: "by context and verb", filter: Filter{Context: "production", Verb: "create"}, expected: "f,e"},
+		{name: "by outcome", filter: Filter{Outcome: "denied"}, expected: "e"},
+		{name: "since", filter: Filter{Since: start.Add(4 * time.Minute)}, expected: "f,e"},
+		{name: "limit", filter: Filter{User: "alice", Limit: 2}, expected: "f,d"},
+		{name: "nothing selected", filter: Filter{User: "mallory"}, expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := getPaths(sink.GetEntries(test.filter)); actual != test.expected {
+				t.Errorf("expected the entries %s, got %s", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMemorySinkNotFull(t *testing.T) {
+
+	sink := NewMemorySink(3)
+	for _, path := range []string{"a", "b"} {
+		if err := sink.Write(Entry{Path: path}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if actual := getPaths(sink.GetEntries(Filter{})); actual != "b,a" {
+		t.Errorf("expected the entries b,a, got %s", actual)
+	}
+
+	empty := NewMemorySink(0)
+	if err := empty.Write(Entry{Path: "a"}); err != nil {
+		t.Fatal(err)
+	}
+	if actual := getPaths(empty.GetEntries(Filter{})); actual != "" {
+		t.Errorf("expected no entries, got %s", actual)
+	}
+}
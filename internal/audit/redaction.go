@@ -0,0 +1,154 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// RedactedValue is the value replacing the secrets in the bodies of the requests
+const RedactedValue = "REDACTED"
+
+// unrecordedBody replaces the bodies that can't be redacted
+var unrecordedBody = json.RawMessage(`{"unrecorded":"body neither a JSON nor a YAML object"}`)
+
+// The keys holding credentials, in lower case and without separator, whatever the object in which they are
+var secretKeys = map[string]bool{
+	"password":              true,
+	"token":                 true,
+	"clientkeydata":         true,
+	"clientcertificatedata": true,
+	"clientsecret":          true,
+	"idtoken":               true,
+	"refreshtoken":          true,
+	"accesstoken":           true,
+}
+
+// RedactBody returns a copy of a JSON or YAML body in which the secrets are masked: the credentials of the
+// configuration, the values of the environment of the exec credentials and the data of the Kubernetes Secrets. The
+// redacted body is always in JSON. As the other bodies can't be redacted, they are replaced by an explicit marker
+func RedactBody(body []byte, isSecret bool) json.RawMessage {
+
+	value, err := parseBody(body)
+	if err != nil {
+		return unrecordedBody
+	}
+
+	if object, ok := value.(map[string]interface{}); ok && (isSecret || object["kind"] == "Secret") {
+		redactSecretData(object)
+	}
+
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return unrecordedBody
+	}
+
+	return redacted
+}
+
+// parseBody reads a body in JSON or in YAML, the YAML values being converted to the types used by JSON. Only the
+// objects and the lists are accepted, as the other values can't be redacted
+func parseBody(body []byte) (interface{}, error) {
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		if err = yaml.Unmarshal(body, &value); err != nil {
+			return nil, err
+		}
+		value = convertYAMLValue(value)
+	}
+
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return value, nil
+	default:
+		return nil, fmt.Errorf("the body is not an object or a list")
+	}
+}
+
+// convertYAMLValue converts the objects read from YAML, whose keys can be of any type, to JSON objects
+func convertYAMLValue(value interface{}) interface{} {
+
+	switch typedValue := value.(type) {
+
+	case map[interface{}]interface{}:
+		object := make(map[string]interface{}, len(typedValue))
+		for key, child := range typedValue {
+			object[fmt.Sprint(key)] = convertYAMLValue(child)
+		}
+		return object
+
+	case []interface{}:
+		for i, child := range typedValue {
+			typedValue[i] = convertYAMLValue(child)
+		}
+	}
+
+	return value
+}
+
+// redactValue masks the secrets of a JSON value
+func redactValue(value interface{}) interface{} {
+
+	switch typedValue := value.(type) {
+
+	case map[string]interface{}:
+		for key, child := range typedValue {
+			if isSecretKey(key) {
+				typedValue[key] = RedactedValue
+			} else if key == "env" {
+				typedValue[key] = redactEnvironment(child)
+			} else {
+				typedValue[key] = redactValue(child)
+			}
+		}
+
+	case []interface{}:
+		for i, child := range typedValue {
+			if object, ok := child.(map[string]interface{}); ok && object["kind"] == "Secret" {
+				redactSecretData(object)
+			}
+			typedValue[i] = redactValue(child)
+		}
+	}
+
+	return value
+}
+
+// redactEnvironment masks the values of the environment variables, given as a list of name and value
+func redactEnvironment(value interface{}) interface{} {
+
+	variables, ok := value.([]interface{})
+	if !ok {
+		return redactValue(value)
+	}
+
+	for _, variable := range variables {
+		if object, ok := variable.(map[string]interface{}); ok {
+			if _, hasValue := object["value"]; hasValue {
+				object["value"] = RedactedValue
+			}
+		}
+	}
+
+	return variables
+}
+
+// redactSecretData masks the values of the data of a Kubernetes Secret, keeping the keys
+func redactSecretData(secret map[string]interface{}) {
+	for _, field := range []string{"data", "stringData"} {
+		if data, ok := secret[field].(map[string]interface{}); ok {
+			for key := range data {
+				data[key] = RedactedValue
+			}
+		}
+	}
+}
+
+// isSecretKey checks if a key holds credentials
+func isSecretKey(key string) bool {
+	normalizedKey := strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(key))
+	return secretKeys[normalizedKey]
+}
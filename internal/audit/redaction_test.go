@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		body     string
+		isSecret bool
+		expected string
+	}{
+		{
+			name:     "neither JSON nor YAML",
+			body:     "password=secret",
+			expected: `{"unrecorded":"body neither a JSON nor a YAML object"}`,
+		},
+		{
+			name:     "JSON scalar",
+			body:     `"secret"`,
+			expected: `{"unrecorded":"body neither a JSON nor a YAML object"}`,
+		},
+		{
+			name: "YAML import of the configuration",
+			body: `apiVersion: v1
+kind: Config
+users:
+- name: admin
+  user:
+    token: secret
+    client-key-data: key
+- name: aws
+  user:
+    exec:
+      command: aws
+      env:
+      - name: AWS_PROFILE
+        value: production
+clusters:
+- name: cluster
+  cluster:
+    server: https://cluster.example.com
+    insecure-skip-tls-verify: true
+`,
+			expected: `{"apiVersion":"v1","kind":"Config",` +
+				`"users":[{"name":"admin","user":{"token":"REDACTED","client-key-data":"REDACTED"}},` +
+				`{"name":"aws","user":{"exec":{"command":"aws","env":[{"name":"AWS_PROFILE","value":"REDACTED"}]}}}],` +
+				`"clusters":[{"name":"cluster","cluster":{"server":"https://cluster.example.com","insecure-skip-tls-verify":true}}]}`,
+		},
+		{
+			name:     "YAML Kubernetes Secret",
+			body:     "kind: Secret\nmetadata:\n  name: db\nstringData:\n  password: secret\n",
+			expected: `{"kind":"Secret","metadata":{"name":"db"},"stringData":{"password":"REDACTED"}}`,
+		},
+		{
+			name:     "credentials of the configuration",
+			body:     `{"username":"admin","password":"secret","client-key-data":"key","certificate-authority-data":"ca"}`,
+			expected: `{"username":"admin","password":"REDACTED","client-key-data":"REDACTED","certificate-authority-data":"ca"}`,
+		},
+		{
+			name:     "keys compared without case and separators",
+			body:     `{"Token":"a","refresh_token":"b","clientSecret":"c","access-token":"d","tokenFile":"/var/token"}`,
+			expected: `{"Token":"REDACTED","refresh_token":"REDACTED","clientSecret":"REDACTED","access-token":"REDACTED","tokenFile":"/var/token"}`,
+		},
+		{
+			name:     "nested auth-provider",
+			body:     `{"auth-provider":{"name":"oidc","config":{"id-token":"a","client-id":"kuboxy"}}}`,
+			expected: `{"auth-provider":{"name":"oidc","config":{"id-token":"REDACTED","client-id":"kuboxy"}}}`,
+		},
+		{
+			name:     "environment of the exec credentials",
+			body:     `{"exec":{"command":"aws","env":[{"name":"AWS_PROFILE","value":"production"}]}}`,
+			expected: `{"exec":{"command":"aws","env":[{"name":"AWS_PROFILE","value":"REDACTED"}]}}`,
+		},
+		{
+			name:     "environment from a reference",
+			body:     `{"env":[{"name":"PASSWORD","valueFrom":{"secretKeyRef":{"name":"db","key":"password"}}}]}`,
+			expected: `{"env":[{"name":"PASSWORD","valueFrom":{"secretKeyRef":{"name":"db","key":"password"}}}]}`,
+		},
+		{
+			name:     "Kubernetes Secret by kind",
+			body:     `{"kind":"Secret","metadata":{"name":"db"},"data":{"user":"YWRtaW4="},"stringData":{"password":"secret"}}`,
+			expected: `{"kind":"Secret","metadata":{"name":"db"},"data":{"user":"REDACTED"},"stringData":{"password":"REDACTED"}}`,
+		},
+		{
+			name:     "Kubernetes Secret by endpoint",
+			body:     `{"metadata":{"name":"db"},"data":{"user":"YWRtaW4="}}`,
+			isSecret: true,
+			expected: `{"metadata":{"name":"db"},"data":{"user":"REDACTED"}}`,
+		},
+		{
+			name:     "Kubernetes Secrets in a list",
+			body:     `[{"kind":"Secret","data":{"user":"YWRtaW4="}},{"kind":"ConfigMap","data":{"user":"admin"}}]`,
+			expected: `[{"kind":"Secret","data":{"user":"REDACTED"}},{"kind":"ConfigMap","data":{"user":"admin"}}]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			redacted := RedactBody([]byte(test.body), test.isSecret)
+
+			var actual, expected interface{}
+			if err := json.Unmarshal(redacted, &actual); err != nil {
+				t.Fatalf("the redacted body is not valid: %v", err)
+			}
+			if err := json.Unmarshal([]byte(test.expected), &expected); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(actual, expected) {
+				t.Errorf("expected %s, got %s", test.expected, redacted)
+			}
+		})
+	}
+}
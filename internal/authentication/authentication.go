@@ -0,0 +1,60 @@
+package authentication
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// The methods used for authenticating the callers
+const (
+	// TokenMethod is a static API token, given as a bearer token
+	TokenMethod = "token"
+	// BasicMethod is a user name and a password checked against an htpasswd file
+	BasicMethod = "basic"
+	// CertificateMethod is a client certificate, verified during the TLS handshake
+	CertificateMethod = "certificate"
+)
+
+// Identity is the identity of an authenticated caller
+type Identity struct {
+	Name   string   `json:"name"`
+	Groups []string `json:"groups,omitempty"`
+	Method string   `json:"method"`
+}
+
+// Authenticator checks the credentials of a request
+type Authenticator interface {
+	// Authenticate returns the identity of the caller. If the request does not hold credentials for this
+	// authenticator, nil is returned without error. If the credentials are not valid, an error is returned
+	Authenticate(request *http.Request) (*Identity, error)
+	// Challenge returns the value of the WWW-Authenticate header sent to the callers not authenticated, empty if none
+	Challenge() string
+}
+
+// InvalidCredentialsError is the error returned when the credentials of a request are not valid
+type InvalidCredentialsError struct {
+	method string
+	reason string
+}
+
+// Error returns the description of the error
+func (err *InvalidCredentialsError) Error() string {
+	return fmt.Sprintf("invalid credentials (%s): %s", err.method, err.reason)
+}
+
+// Authenticate returns the identity of the caller of a request, given by the first authenticator recognizing its
+// credentials. If no authenticator recognizes the credentials, nil is returned without error
+func Authenticate(authenticators []Authenticator, request *http.Request) (*Identity, error) {
+
+	for _, authenticator := range authenticators {
+		identity, err := authenticator.Authenticate(request)
+		if err != nil {
+			return nil, err
+		}
+		if identity != nil {
+			return identity, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,107 @@
+package authentication
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// basicAuthenticator authenticates the requests having a user name and a password, checked against the bcrypt hashes
+// of an htpasswd file
+type basicAuthenticator struct {
+	passwordHashes map[string][]byte
+	groupsByUser   map[string][]string
+	// A hash compared for the unknown users, so that they take as long to be rejected as the known ones
+	dummyHash []byte
+}
+
+// newBasicAuthenticator creates an authenticator for the users of an htpasswd file. Only the bcrypt hashes
+// (htpasswd -B) are accepted
+func newBasicAuthenticator(htpasswdFile string, groupsByUser map[string][]string) (Authenticator, error) {
+
+	content, err := ioutil.ReadFile(htpasswdFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the htpasswd file due to: %v", err.Error())
+	}
+
+	passwordHashes := make(map[string][]byte)
+	maximumCost := bcrypt.DefaultCost
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		separatorIndex := strings.Index(line, ":")
+		if separatorIndex <= 0 {
+			return nil, fmt.Errorf("the line %d of the htpasswd file is not valid", lineNumber)
+		}
+
+		userName := line[:separatorIndex]
+		passwordHash := []byte(line[separatorIndex+1:])
+
+		cost, err := bcrypt.Cost(passwordHash)
+		if err != nil {
+			return nil, fmt.Errorf("the password of the user %s in the htpasswd file is not hashed with bcrypt", userName)
+		}
+		if cost > maximumCost {
+			maximumCost = cost
+		}
+
+		passwordHashes[userName] = passwordHash
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read the htpasswd file due to: %v", err.Error())
+	}
+
+	dummyHash, err := bcrypt.GenerateFromPassword([]byte("kuboxy"), maximumCost)
+	if err != nil {
+		return nil, fmt.Errorf("unable to hash the dummy password due to: %v", err.Error())
+	}
+
+	return &basicAuthenticator{
+		passwordHashes: passwordHashes,
+		groupsByUser:   groupsByUser,
+		dummyHash:      dummyHash,
+	}, nil
+}
+
+// Authenticate returns the user of the request if its password is valid
+func (authenticator *basicAuthenticator) Authenticate(request *http.Request) (*Identity, error) {
+
+	userName, password, ok := request.BasicAuth()
+	if !ok {
+		return nil, nil
+	}
+
+	// The unknown users are checked against a dummy hash and get the same error as a wrong password, so that the
+	// existing users can't be guessed
+	passwordHash, known := authenticator.passwordHashes[userName]
+	if !known {
+		passwordHash = authenticator.dummyHash
+	}
+
+	if err := bcrypt.CompareHashAndPassword(passwordHash, []byte(password)); err != nil || !known {
+		return nil, &InvalidCredentialsError{BasicMethod, "invalid user name or password"}
+	}
+
+	return &Identity{
+		Name:   userName,
+		Groups: authenticator.groupsByUser[userName],
+		Method: BasicMethod,
+	}, nil
+}
+
+// Challenge returns the value of the WWW-Authenticate header
+func (authenticator *basicAuthenticator) Challenge() string {
+	return "Basic realm=\"kuboxy\""
+}
@@ -0,0 +1,130 @@
+package authentication
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// writeHtpasswdFile writes an htpasswd file with the given passwords, hashed with bcrypt
+func writeHtpasswdFile(t *testing.T, directory string, passwords map[string]string) string {
+
+	content := "# users of kuboxy\n"
+	for userName, password := range passwords {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+		if err != nil {
+			t.Fatal(err)
+		}
+		content += userName + ":" + string(hash) + "\n"
+	}
+
+	fileName := filepath.Join(directory, "htpasswd")
+	if err := ioutil.WriteFile(fileName, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return fileName
+}
+
+func TestBasicAuthenticator(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "kuboxy-authentication")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	htpasswdFile := writeHtpasswdFile(t, directory, map[string]string{"alice": "alice-password"})
+
+	authenticator, err := newBasicAuthenticator(htpasswdFile, map[string][]string{"alice": {"dev"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		userName       string
+		password       string
+		noCredentials  bool
+		expectedGroups []string
+		expectedError  string
+	}{
+		{name: "no credentials", noCredentials: true},
+		{name: "valid password", userName: "alice", password: "alice-password", expectedGroups: []string{"dev"}},
+		{name: "wrong password", userName: "alice", password: "wrong", expectedError: "invalid credentials (basic): invalid user name or password"},
+		{name: "unknown user", userName: "mallory", password: "alice-password", expectedError: "invalid credentials (basic): invalid user name or password"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			request := httptest.NewRequest(http.MethodGet, "/api/v1/contexts", nil)
+			if !test.noCredentials {
+				request.SetBasicAuth(test.userName, test.password)
+			}
+
+			identity, err := authenticator.Authenticate(request)
+
+			if len(test.expectedError) > 0 {
+				if err == nil || err.Error() != test.expectedError {
+					t.Fatalf("expected the error \"%s\", got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if test.noCredentials {
+				if identity != nil {
+					t.Errorf("unexpected identity %v", identity)
+				}
+				return
+			}
+
+			if identity == nil || identity.Name != test.userName || identity.Method != BasicMethod {
+				t.Fatalf("unexpected identity %v", identity)
+			}
+			if len(identity.Groups) != len(test.expectedGroups) {
+				t.Errorf("expected the groups %v, got %v", test.expectedGroups, identity.Groups)
+			}
+		})
+	}
+}
+
+func TestNewBasicAuthenticatorErrors(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "kuboxy-authentication")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "line without separator", content: "alice\n"},
+		{name: "line without user", content: ":$2y$10$abcdefghijklmnopqrstuv\n"},
+		{name: "password not hashed with bcrypt", content: "alice:{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			fileName := filepath.Join(directory, "htpasswd")
+			if err := ioutil.WriteFile(fileName, []byte(test.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := newBasicAuthenticator(fileName, nil); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package authentication
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// certificateAuthenticator authenticates the requests having a client certificate verified during the TLS handshake.
+// The common name of the certificate is mapped to a user
+type certificateAuthenticator struct {
+	identitiesByCommonName map[string]Identity
+}
+
+// newCertificateAuthenticator creates an authenticator for the common names of the users. If no user has a common
+// name, nil is returned
+func newCertificateAuthenticator(users []UserDefinition) (Authenticator, error) {
+
+	identitiesByCommonName := make(map[string]Identity)
+	for _, user := range users {
+		for _, commonName := range user.CertificateCommonNames {
+
+			if existingIdentity, ok := identitiesByCommonName[commonName]; ok {
+				return nil, fmt.Errorf("the certificate common name %s is given to both users %s and %s", commonName, existingIdentity.Name, user.Name)
+			}
+
+			identitiesByCommonName[commonName] = Identity{
+				Name:   user.Name,
+				Groups: user.Groups,
+				Method: CertificateMethod,
+			}
+		}
+	}
+
+	if len(identitiesByCommonName) == 0 {
+		return nil, nil
+	}
+
+	return &certificateAuthenticator{identitiesByCommonName: identitiesByCommonName}, nil
+}
+
+// Authenticate returns the user mapped to the common name of the client certificate. Only the certificates verified
+// against the client certificate authority of the application are considered
+func (authenticator *certificateAuthenticator) Authenticate(request *http.Request) (*Identity, error) {
+
+	if request.TLS == nil || len(request.TLS.VerifiedChains) == 0 || len(request.TLS.VerifiedChains[0]) == 0 {
+		return nil, nil
+	}
+
+	commonName := request.TLS.VerifiedChains[0][0].Subject.CommonName
+
+	identity, ok := authenticator.identitiesByCommonName[commonName]
+	if !ok {
+		return nil, &InvalidCredentialsError{CertificateMethod, fmt.Sprintf("the common name %s is not mapped to a user", commonName)}
+	}
+
+	return &identity, nil
+}
+
+// Challenge returns the value of the WWW-Authenticate header, none as the certificate is given during the handshake
+func (authenticator *certificateAuthenticator) Challenge() string {
+	return ""
+}
@@ -0,0 +1,95 @@
+package authentication
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCertificateAuthenticator(t *testing.T) {
+
+	authenticator, err := newCertificateAuthenticator([]UserDefinition{
+		{Name: "alice", Groups: []string{"dev"}, CertificateCommonNames: []string{"alice.example.com"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// newVerifiedState returns the state of a connection whose client certificate was verified
+	newVerifiedState := func(commonName string) *tls.ConnectionState {
+		certificate := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+		return &tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{certificate},
+			VerifiedChains:   [][]*x509.Certificate{{certificate}},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		state         *tls.ConnectionState
+		expectedUser  string
+		expectedError bool
+	}{
+		{
+			name:         "no TLS",
+			state:        nil,
+			expectedUser: "",
+		},
+		{
+			name:         "certificate not verified",
+			state:        &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "alice.example.com"}}}},
+			expectedUser: "",
+		},
+		{
+			name:         "known common name",
+			state:        newVerifiedState("alice.example.com"),
+			expectedUser: "alice",
+		},
+		{
+			name:          "unknown common name",
+			state:         newVerifiedState("mallory.example.com"),
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			request := httptest.NewRequest(http.MethodGet, "/api/v1/contexts", nil)
+			request.TLS = test.state
+
+			identity, err := authenticator.Authenticate(request)
+			if test.expectedError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			userName := ""
+			if identity != nil {
+				userName = identity.Name
+			}
+			if userName != test.expectedUser {
+				t.Errorf("expected the user \"%s\", got \"%s\"", test.expectedUser, userName)
+			}
+		})
+	}
+}
+
+func TestNewCertificateAuthenticatorDuplicate(t *testing.T) {
+
+	_, err := newCertificateAuthenticator([]UserDefinition{
+		{Name: "alice", CertificateCommonNames: []string{"shared.example.com"}},
+		{Name: "bob", CertificateCommonNames: []string{"shared.example.com"}},
+	})
+	if err == nil {
+		t.Error("expected an error for a common name given to two users")
+	}
+}
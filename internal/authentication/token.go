@@ -0,0 +1,137 @@
+package authentication
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// The prefix of the tokens given by their hash in the users file
+const hashedTokenPrefix = "sha256:"
+
+// The query parameter holding the token, as the browsers can't set the headers of a WebSocket handshake
+const tokenQueryParameter = "access_token"
+
+// The value replacing the token of the query in the logs
+const redactedToken = "REDACTED"
+
+// tokenAuthenticator authenticates the requests having a static token, given as a bearer token
+type tokenAuthenticator struct {
+	users []tokenUser
+}
+
+// tokenUser is a user along with the hash of one of its tokens
+type tokenUser struct {
+	identity  Identity
+	tokenHash []byte
+}
+
+// newTokenAuthenticator creates an authenticator for the tokens of the users. If no user has a token, nil is returned
+func newTokenAuthenticator(users []UserDefinition) (Authenticator, error) {
+
+	tokenUsers := make([]tokenUser, 0)
+	for _, user := range users {
+		for _, token := range user.Tokens {
+
+			tokenHash, err := hashToken(token)
+			if err != nil {
+				return nil, fmt.Errorf("a token of the user %s is not valid: %v", user.Name, err.Error())
+			}
+
+			tokenUsers = append(tokenUsers, tokenUser{
+				identity: Identity{
+					Name:   user.Name,
+					Groups: user.Groups,
+					Method: TokenMethod,
+				},
+				tokenHash: tokenHash,
+			})
+		}
+	}
+
+	if len(tokenUsers) == 0 {
+		return nil, nil
+	}
+
+	return &tokenAuthenticator{users: tokenUsers}, nil
+}
+
+// Authenticate returns the user owning the bearer token of the request. For the WebSocket handshakes, the token can
+// also be given by the access_token query parameter
+func (authenticator *tokenAuthenticator) Authenticate(request *http.Request) (*Identity, error) {
+
+	token := ""
+	if authorization := request.Header.Get("Authorization"); len(authorization) > 0 {
+		if !strings.HasPrefix(authorization, "Bearer ") {
+			return nil, nil
+		}
+		token = strings.TrimSpace(strings.TrimPrefix(authorization, "Bearer "))
+	} else if isWebSocketHandshake(request) {
+		token = request.URL.Query().Get(tokenQueryParameter)
+	}
+
+	if len(token) == 0 {
+		return nil, nil
+	}
+
+	// Compare the hashes, so that the comparison is done in constant time whatever the length of the token
+	hash := sha256.Sum256([]byte(token))
+	for _, user := range authenticator.users {
+		if subtle.ConstantTimeCompare(hash[:], user.tokenHash) == 1 {
+			identity := user.identity
+			return &identity, nil
+		}
+	}
+
+	return nil, &InvalidCredentialsError{TokenMethod, "unknown token"}
+}
+
+// Challenge returns the value of the WWW-Authenticate header
+func (authenticator *tokenAuthenticator) Challenge() string {
+	return "Bearer realm=\"kuboxy\""
+}
+
+// hashToken returns the hash of a token of the users file, either given in clear text or already hashed
+func hashToken(token string) ([]byte, error) {
+
+	if strings.HasPrefix(token, hashedTokenPrefix) {
+		tokenHash, err := hex.DecodeString(strings.TrimPrefix(token, hashedTokenPrefix))
+		if err != nil {
+			return nil, err
+		}
+		if len(tokenHash) != sha256.Size {
+			return nil, fmt.Errorf("the hash must have %d bytes", sha256.Size)
+		}
+		return tokenHash, nil
+	}
+
+	if len(token) == 0 {
+		return nil, fmt.Errorf("the token is empty")
+	}
+
+	hash := sha256.Sum256([]byte(token))
+	return hash[:], nil
+}
+
+// RedactQueryToken hides the token given by the query parameter access_token in the RequestURI of a request, which is
+// the one written in the logs. The token is still available in the URL of the request for authenticating the caller
+func RedactQueryToken(request *http.Request) {
+
+	query := request.URL.Query()
+	if _, ok := query[tokenQueryParameter]; !ok {
+		return
+	}
+	query.Set(tokenQueryParameter, redactedToken)
+
+	redactedURL := *request.URL
+	redactedURL.RawQuery = query.Encode()
+	request.RequestURI = redactedURL.RequestURI()
+}
+
+// isWebSocketHandshake checks if a request is the handshake of a WebSocket
+func isWebSocketHandshake(request *http.Request) bool {
+	return strings.EqualFold(request.Header.Get("Upgrade"), "websocket")
+}
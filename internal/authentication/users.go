@@ -0,0 +1,89 @@
+package authentication
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// UsersFile is the definition of the users allowed to call the application
+type UsersFile struct {
+	HtpasswdFile string           `yaml:"htpasswdFile,omitempty"`
+	Users        []UserDefinition `yaml:"users,omitempty"`
+}
+
+// UserDefinition is a user allowed to call the application, along with the credentials identifying it. The tokens are
+// given either in clear text or hashed, as sha256:<hex digest>
+type UserDefinition struct {
+	Name                   string   `yaml:"name"`
+	Groups                 []string `yaml:"groups,omitempty"`
+	Tokens                 []string `yaml:"tokens,omitempty"`
+	CertificateCommonNames []string `yaml:"certificateCommonNames,omitempty"`
+}
+
+// LoadAuthenticators reads the users file and creates the authenticators for all the methods it defines: static
+// tokens, basic authentication with an htpasswd file (relative paths are relative to the users file) and client
+// certificates
+func LoadAuthenticators(fileName string) ([]Authenticator, error) {
+
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the users file due to: %v", err.Error())
+	}
+
+	usersFile := UsersFile{}
+	if err = yaml.UnmarshalStrict(content, &usersFile); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal the users file due to: %v", err.Error())
+	}
+
+	// Keep the groups of each user, so that they are also given to the users of the htpasswd file
+	groupsByUser := make(map[string][]string, len(usersFile.Users))
+	for _, user := range usersFile.Users {
+		if len(user.Name) == 0 {
+			return nil, fmt.Errorf("a user of the users file has no name")
+		}
+		if _, ok := groupsByUser[user.Name]; ok {
+			return nil, fmt.Errorf("the user %s is defined twice in the users file", user.Name)
+		}
+		groupsByUser[user.Name] = user.Groups
+	}
+
+	authenticators := make([]Authenticator, 0, 3)
+
+	tokenAuthenticator, err := newTokenAuthenticator(usersFile.Users)
+	if err != nil {
+		return nil, err
+	}
+	if tokenAuthenticator != nil {
+		authenticators = append(authenticators, tokenAuthenticator)
+	}
+
+	if len(usersFile.HtpasswdFile) > 0 {
+		htpasswdFile := usersFile.HtpasswdFile
+		if !filepath.IsAbs(htpasswdFile) {
+			htpasswdFile = filepath.Join(filepath.Dir(fileName), htpasswdFile)
+		}
+
+		basicAuthenticator, err := newBasicAuthenticator(htpasswdFile, groupsByUser)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, basicAuthenticator)
+	}
+
+	certificateAuthenticator, err := newCertificateAuthenticator(usersFile.Users)
+	if err != nil {
+		return nil, err
+	}
+	if certificateAuthenticator != nil {
+		authenticators = append(authenticators, certificateAuthenticator)
+	}
+
+	if len(authenticators) == 0 {
+		return nil, fmt.Errorf("the users file %s defines neither tokens, nor htpasswd file, nor certificates", fileName)
+	}
+
+	return authenticators, nil
+}
@@ -0,0 +1,169 @@
+package authentication
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadAuthenticators(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "kuboxy-authentication")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	writeHtpasswdFile(t, directory, map[string]string{"bob": "bob-password"})
+
+	tests := []struct {
+		name            string
+		content         string
+		expectedMethods []string
+		expectError     bool
+	}{
+		{
+			name:            "tokens only",
+			content:         "users:\n- name: alice\n  tokens: [alice-token]\n",
+			expectedMethods: []string{TokenMethod},
+		},
+		{
+			name:            "all methods, relative htpasswd file",
+			content:         "htpasswdFile: htpasswd\nusers:\n- name: alice\n  tokens: [alice-token]\n  certificateCommonNames: [alice.example.com]\n",
+			expectedMethods: []string{TokenMethod, BasicMethod, CertificateMethod},
+		},
+		{
+			name:        "no method",
+			content:     "users:\n- name: alice\n",
+			expectError: true,
+		},
+		{
+			name:        "user without name",
+			content:     "users:\n- tokens: [token]\n",
+			expectError: true,
+		},
+		{
+			name:        "user defined twice",
+			content:     "users:\n- name: alice\n  tokens: [a]\n- name: alice\n  tokens: [b]\n",
+			expectError: true,
+		},
+		{
+			name:        "unknown key",
+			content:     "users:\n- name: alice\n  token: alice-token\n",
+			expectError: true,
+		},
+		{
+			name:        "missing htpasswd file",
+			content:     "htpasswdFile: missing\nusers:\n- name: alice\n  tokens: [alice-token]\n",
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			fileName := filepath.Join(directory, "users.yaml")
+			if err := ioutil.WriteFile(fileName, []byte(test.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			authenticators, err := LoadAuthenticators(fileName)
+			if test.expectError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(authenticators) != len(test.expectedMethods) {
+				t.Fatalf("expected %d authenticators, got %d", len(test.expectedMethods), len(authenticators))
+			}
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "kuboxy-authentication")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	writeHtpasswdFile(t, directory, map[string]string{"bob": "bob-password"})
+
+	fileName := filepath.Join(directory, "users.yaml")
+	content := "htpasswdFile: htpasswd\nusers:\n- name: alice\n  tokens: [alice-token]\n- name: bob\n  groups: [ops]\n"
+	if err = ioutil.WriteFile(fileName, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	authenticators, err := LoadAuthenticators(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		prepare        func(request *http.Request)
+		expectedUser   string
+		expectedGroups int
+		expectError    bool
+	}{
+		{
+			name:    "anonymous",
+			prepare: func(request *http.Request) {},
+		},
+		{
+			name:         "token",
+			prepare:      func(request *http.Request) { request.Header.Set("Authorization", "Bearer alice-token") },
+			expectedUser: "alice",
+		},
+		{
+			name:           "basic, with the groups of the users file",
+			prepare:        func(request *http.Request) { request.SetBasicAuth("bob", "bob-password") },
+			expectedUser:   "bob",
+			expectedGroups: 1,
+		},
+		{
+			name:        "invalid token stops the authentication",
+			prepare:     func(request *http.Request) { request.Header.Set("Authorization", "Bearer wrong") },
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			request := httptest.NewRequest(http.MethodGet, "/api/v1/contexts", nil)
+			test.prepare(request)
+
+			identity, err := Authenticate(authenticators, request)
+			if test.expectError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			userName := ""
+			groups := 0
+			if identity != nil {
+				userName = identity.Name
+				groups = len(identity.Groups)
+			}
+			if userName != test.expectedUser || groups != test.expectedGroups {
+				t.Errorf("expected the user \"%s\" with %d groups, got %v", test.expectedUser, test.expectedGroups, identity)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package authorization
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/twuillemin/kuboxy/internal/authentication"
+	"github.com/twuillemin/kuboxy/pkg/types"
+	"gopkg.in/yaml.v2"
+)
+
+// The verbs of the requests
+const (
+	// VerbGet reads a single object
+	VerbGet = "get"
+	// VerbList reads all the objects of a type
+	VerbList = "list"
+	// VerbWatch follows the events of the objects of a type through the WebSocket
+	VerbWatch = "watch"
+	// VerbCreate creates an object
+	VerbCreate = "create"
+	// VerbUpdate updates an object
+	VerbUpdate = "update"
+	// VerbDelete deletes an object
+	VerbDelete = "delete"
+	// VerbReveal reads the value of a masked Secret or ConfigMap, or the credentials of the configuration in clear
+	// text. It is only granted by the allow rules listing it explicitly
+	VerbReveal = "reveal"
+)
+
+// The types of the objects managed by the application that are not Kubernetes objects
+const (
+	// Configuration is the configuration of the users, clusters and contexts
+	Configuration types.ObjectType = "Configuration"
+	// Summary is the summary of a context
+	Summary types.ObjectType = "Summary"
+	// Search is the search of the objects of a context
+	Search types.ObjectType = "Search"
+	// Labels are the labels used by the objects of a namespace
+	Labels types.ObjectType = "Labels"
+	// Audit is the audit of the modifications
+	Audit types.ObjectType = "Audit"
+)
+
+// The effects of the rules
+const (
+	// Allow grants the requests matched by the rule
+	Allow = "allow"
+	// Deny refuses the requests matched by the rule, whatever the other rules
+	Deny = "deny"
+)
+
+// The prefix of the subjects designating a group rather than a user
+const groupPrefix = "group:"
+
+// Policy is the set of rules defining what the callers are allowed to do. A request is allowed if it is matched by
+// at least one allow rule and by no deny rule
+type Policy struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// Rule grants or refuses some requests. The subjects are user names, group names prefixed by "group:" or "*" for
+// everybody. The contexts and the namespaces are globs, the objects at the cluster level having no namespace. Any
+// list omitted matches everything, except for the verb reveal that must be explicitly listed by the allow rules
+type Rule struct {
+	Subjects    []string `yaml:"subjects,omitempty"`
+	Contexts    []string `yaml:"contexts,omitempty"`
+	Namespaces  []string `yaml:"namespaces,omitempty"`
+	ObjectTypes []string `yaml:"objectTypes,omitempty"`
+	Verbs       []string `yaml:"verbs,omitempty"`
+	Effect      string   `yaml:"effect,omitempty"`
+}
+
+// Request is a request to be authorized
+type Request struct {
+	Identity   *authentication.Identity
+	Context    string
+	Namespace  string
+	ObjectType types.ObjectType
+	Verb       string
+}
+
+// LoadPolicy reads and checks a policy file
+func LoadPolicy(fileName string) (*Policy, error) {
+
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the authorization file due to: %v", err.Error())
+	}
+
+	policy := &Policy{}
+	if err = yaml.UnmarshalStrict(content, policy); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal the authorization file due to: %v", err.Error())
+	}
+
+	for i, rule := range policy.Rules {
+		if err = rule.validate(); err != nil {
+			return nil, fmt.Errorf("the rule %d of the authorization file is not valid: %v", i+1, err.Error())
+		}
+	}
+
+	return policy, nil
+}
+
+// IsAllowed checks if a request is allowed by the policy
+func (policy *Policy) IsAllowed(request Request) bool {
+
+	allowed := false
+	for _, rule := range policy.Rules {
+		if rule.matches(request) {
+			if rule.Effect == Deny {
+				return false
+			}
+			allowed = true
+		}
+	}
+
+	return allowed
+}
+
+// validate checks that the globs and the effect of a rule are valid
+func (rule Rule) validate() error {
+
+	if len(rule.Effect) > 0 && rule.Effect != Allow && rule.Effect != Deny {
+		return fmt.Errorf("the effect %s is unknown, it must be %s or %s", rule.Effect, Allow, Deny)
+	}
+
+	for _, pattern := range append(append([]string{}, rule.Contexts...), rule.Namespaces...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("the glob %s is not valid", pattern)
+		}
+	}
+
+	return nil
+}
+
+// matches checks if a request is matched by the rule
+func (rule Rule) matches(request Request) bool {
+
+	// Revealing a value is only granted explicitly
+	if request.Verb == VerbReveal && rule.Effect != Deny && !listsValue(rule.Verbs, VerbReveal) {
+		return false
+	}
+
+	return matchesSubject(rule.Subjects, request.Identity) &&
+		matchesGlob(rule.Contexts, request.Context) &&
+		matchesGlob(rule.Namespaces, request.Namespace) &&
+		matchesValue(rule.ObjectTypes, string(request.ObjectType)) &&
+		matchesValue(rule.Verbs, request.Verb)
+}
+
+// matchesSubject checks if the caller is one of the subjects
+func matchesSubject(subjects []string, identity *authentication.Identity) bool {
+
+	if len(subjects) == 0 {
+		return true
+	}
+
+	for _, subject := range subjects {
+		if subject == "*" {
+			return true
+		}
+		if identity == nil {
+			continue
+		}
+		if strings.HasPrefix(subject, groupPrefix) {
+			for _, group := range identity.Groups {
+				if group == strings.TrimPrefix(subject, groupPrefix) {
+					return true
+				}
+			}
+		} else if subject == identity.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesGlob checks if a value is matched by one of the globs
+func matchesGlob(patterns []string, value string) bool {
+
+	if len(patterns) == 0 {
+		return true
+	}
+
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, value); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesValue checks if a value is one of the given values, or if "*" is given
+func matchesValue(values []string, value string) bool {
+
+	if len(values) == 0 {
+		return true
+	}
+
+	for _, existingValue := range values {
+		if existingValue == "*" || strings.EqualFold(existingValue, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// listsValue checks if a value is explicitly one of the given values
+func listsValue(values []string, value string) bool {
+
+	for _, existingValue := range values {
+		if strings.EqualFold(existingValue, value) {
+			return true
+		}
+	}
+
+	return false
+}
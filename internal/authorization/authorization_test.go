@@ -0,0 +1,179 @@
+package authorization
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/twuillemin/kuboxy/internal/authentication"
+	"github.com/twuillemin/kuboxy/pkg/types"
+)
+
+func TestIsAllowed(t *testing.T) {
+
+	policy := &Policy{
+		Rules: []Rule{
+			{Subjects: []string{"group:admins"}, Effect: Allow},
+			{Subjects: []string{"group:admins"}, Verbs: []string{VerbReveal}, ObjectTypes: []string{string(types.Secret)}},
+			{Subjects: []string{"alice"}, Contexts: []string{"dev-*"}, Namespaces: []string{"team-a", ""}, Verbs: []string{VerbGet, VerbList, VerbCreate}},
+			{Subjects: []string{"*"}, ObjectTypes: []string{"*"}, Verbs: []string{VerbGet, VerbList, VerbWatch}},
+			{Contexts: []string{"production"}, Verbs: []string{VerbDelete}, Effect: Deny},
+			{Subjects: []string{"mallory"}, Effect: Deny},
+		},
+	}
+
+	alice := &authentication.Identity{Name: "alice"}
+	admin := &authentication.Identity{Name: "bob", Groups: []string{"admins"}}
+	mallory := &authentication.Identity{Name: "mallory"}
+
+	tests := []struct {
+		name     string
+		request  Request
+		expected bool
+	}{
+		{
+			name:     "read granted to everybody",
+			request:  Request{Context: "production", Namespace: "default", ObjectType: types.Pod, Verb: VerbList},
+			expected: true,
+		},
+		{
+			name:     "anonymous write refused",
+			request:  Request{Context: "dev-1", Namespace: "team-a", ObjectType: types.Pod, Verb: VerbCreate},
+			expected: false,
+		},
+		{
+			name:     "write granted by context and namespace globs",
+			request:  Request{Identity: alice, Context: "dev-1", Namespace: "team-a", ObjectType: types.Pod, Verb: VerbCreate},
+			expected: true,
+		},
+		{
+			name:     "write at the cluster level, without namespace",
+			request:  Request{Identity: alice, Context: "dev-1", ObjectType: types.Node, Verb: VerbCreate},
+			expected: true,
+		},
+		{
+			name:     "write outside the namespace",
+			request:  Request{Identity: alice, Context: "dev-1", Namespace: "team-b", ObjectType: types.Pod, Verb: VerbCreate},
+			expected: false,
+		},
+		{
+			name:     "write outside the contexts",
+			request:  Request{Identity: alice, Context: "production", Namespace: "team-a", ObjectType: types.Pod, Verb: VerbCreate},
+			expected: false,
+		},
+		{
+			name:     "verb not listed",
+			request:  Request{Identity: alice, Context: "dev-1", Namespace: "team-a", ObjectType: types.Pod, Verb: VerbDelete},
+			expected: false,
+		},
+		{
+			name:     "everything granted to a group",
+			request:  Request{Identity: admin, Context: "staging", Namespace: "default", ObjectType: types.Deployment, Verb: VerbDelete},
+			expected: true,
+		},
+		{
+			name:     "deny rule wins over allow rules",
+			request:  Request{Identity: admin, Context: "production", Namespace: "default", ObjectType: types.Deployment, Verb: VerbDelete},
+			expected: false,
+		},
+		{
+			name:     "denied user",
+			request:  Request{Identity: mallory, Context: "staging", Namespace: "default", ObjectType: types.Pod, Verb: VerbGet},
+			expected: false,
+		},
+		{
+			name:     "reveal not granted by the rules without verbs",
+			request:  Request{Identity: admin, Context: "staging", Namespace: "default", ObjectType: types.ConfigMap, Verb: VerbReveal},
+			expected: false,
+		},
+		{
+			name:     "reveal granted explicitly",
+			request:  Request{Identity: admin, Context: "staging", Namespace: "default", ObjectType: types.Secret, Verb: VerbReveal},
+			expected: true,
+		},
+		{
+			name:     "object type compared without case",
+			request:  Request{Identity: admin, Context: "staging", ObjectType: "secret", Verb: VerbReveal},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if allowed := policy.IsAllowed(test.request); allowed != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, allowed)
+			}
+		})
+	}
+}
+
+func TestIsAllowedEmptyPolicy(t *testing.T) {
+
+	policy := &Policy{}
+
+	if policy.IsAllowed(Request{Context: "dev", ObjectType: types.Pod, Verb: VerbGet}) {
+		t.Error("a policy without rules must refuse all the requests")
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "kuboxy-authorization")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	tests := []struct {
+		name          string
+		content       string
+		expectedRules int
+		expectError   bool
+	}{
+		{
+			name:          "valid policy",
+			content:       "rules:\n- subjects: [\"group:admins\"]\n- contexts: [\"dev-*\"]\n  verbs: [get, list]\n  effect: deny\n",
+			expectedRules: 2,
+		},
+		{
+			name:        "unknown effect",
+			content:     "rules:\n- subjects: [alice]\n  effect: maybe\n",
+			expectError: true,
+		},
+		{
+			name:        "invalid glob",
+			content:     "rules:\n- contexts: [\"dev-[\"]\n",
+			expectError: true,
+		},
+		{
+			name:        "unknown key",
+			content:     "rules:\n- subject: [alice]\n",
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			fileName := filepath.Join(directory, "authorization.yaml")
+			if err := ioutil.WriteFile(fileName, []byte(test.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			policy, err := LoadPolicy(fileName)
+			if test.expectError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(policy.Rules) != test.expectedRules {
+				t.Errorf("expected %d rules, got %d", test.expectedRules, len(policy.Rules))
+			}
+		})
+	}
+}
@@ -7,20 +7,57 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
 )
 
 // ApplicationConfiguration is the configuration of the application, ie its global parameters
 type ApplicationConfiguration struct {
-	Address                      string `json:"address,omitempty" yaml:"address,omitempty"`
-	RestPort                     int    `json:"restPort,omitempty" yaml:"restPort,omitempty"`
-	WebSocketPort                int    `json:"webSocketPort,omitempty" yaml:"webSocketPort,omitempty"`
-	CertificateFileName          string `json:"certificateFileName,omitempty" yaml:"certificateFileName,omitempty"`
-	PrivateKeyFileName           string `json:"privateKeyFileName,omitempty" yaml:"privateKeyFileName,omitempty"`
-	KubeContextConfigurationFile string `json:"kubeContextConfigurationFile,omitempty" yaml:"kubeContextConfigurationFile,omitempty"`
+	Address                      string        `json:"address,omitempty" yaml:"address,omitempty"`
+	RestPort                     int           `json:"restPort,omitempty" yaml:"restPort,omitempty"`
+	WebSocketPort                int           `json:"webSocketPort,omitempty" yaml:"webSocketPort,omitempty"`
+	SinglePort                   bool          `json:"singlePort,omitempty" yaml:"singlePort,omitempty"`
+	ShutdownTimeout              time.Duration `json:"shutdownTimeout,omitempty" yaml:"shutdownTimeout,omitempty"`
+	CertificateFileName          string        `json:"certificateFileName,omitempty" yaml:"certificateFileName,omitempty"`
+	PrivateKeyFileName           string        `json:"privateKeyFileName,omitempty" yaml:"privateKeyFileName,omitempty"`
+	ClientCAFileName             string        `json:"clientCAFileName,omitempty" yaml:"clientCAFileName,omitempty"`
+	AuthenticationFile           string        `json:"authenticationFile,omitempty" yaml:"authenticationFile,omitempty"`
+	AuthorizationFile            string        `json:"authorizationFile,omitempty" yaml:"authorizationFile,omitempty"`
+	ReadOnly                     bool          `json:"readOnly,omitempty" yaml:"readOnly,omitempty"`
+	ReadOnlyContexts             []string      `json:"readOnlyContexts,omitempty" yaml:"readOnlyContexts,omitempty"`
+	ReadWriteContexts            []string      `json:"readWriteContexts,omitempty" yaml:"readWriteContexts,omitempty"`
+	MaskSecrets                  bool          `json:"maskSecrets,omitempty" yaml:"maskSecrets,omitempty"`
+	MaskConfigMaps               bool          `json:"maskConfigMaps,omitempty" yaml:"maskConfigMaps,omitempty"`
+	ImpersonateCallers           bool          `json:"impersonateCallers,omitempty" yaml:"impersonateCallers,omitempty"`
+	ImpersonationCacheSize       int           `json:"impersonationCacheSize,omitempty" yaml:"impersonationCacheSize,omitempty"`
+	AuditFile                    string        `json:"auditFile,omitempty" yaml:"auditFile,omitempty"`
+	AuditFileMaxSize             int           `json:"auditFileMaxSize,omitempty" yaml:"auditFileMaxSize,omitempty"`
+	AuditFileMaxBackups          int           `json:"auditFileMaxBackups,omitempty" yaml:"auditFileMaxBackups,omitempty"`
+	AuditMemorySize              int           `json:"auditMemorySize,omitempty" yaml:"auditMemorySize,omitempty"`
+	AuditRequestBodies           bool          `json:"auditRequestBodies,omitempty" yaml:"auditRequestBodies,omitempty"`
+	KubeContextConfigurationFile string        `json:"kubeContextConfigurationFile,omitempty" yaml:"kubeContextConfigurationFile,omitempty"`
+	SeedKubeConfig               bool          `json:"seedKubeConfig,omitempty" yaml:"seedKubeConfig,omitempty"`
+	DisableCredentialsReveal     bool          `json:"disableCredentialsReveal,omitempty" yaml:"disableCredentialsReveal,omitempty"`
+	InlineCertificateDirectories []string      `json:"inlineCertificateDirectories,omitempty" yaml:"inlineCertificateDirectories,omitempty"`
+	InClusterContextName         string        `json:"inClusterContextName,omitempty" yaml:"inClusterContextName,omitempty"`
+	CredentialsKeyFile           string        `json:"credentialsKeyFile,omitempty" yaml:"credentialsKeyFile,omitempty"`
+	ContextStore                 string        `json:"contextStore,omitempty" yaml:"contextStore,omitempty"`
+	ContextStoreSecretNamespace  string        `json:"contextStoreSecretNamespace,omitempty" yaml:"contextStoreSecretNamespace,omitempty"`
+	ContextStoreSecretName       string        `json:"contextStoreSecretName,omitempty" yaml:"contextStoreSecretName,omitempty"`
+
+	// The source of each value (default, file, environment or command line), by path of the parameter
+	sources map[string]string
+
+	// The problems found in the values given by the sources
+	problems ValidationErrors
 }
 
 var currentConfiguration *ApplicationConfiguration
 
+// If the configuration is only to be checked, as requested on the command line
+var checkRequested bool
+
 // GetConfiguration returns the the current configuration of the application
 func GetConfiguration() (ApplicationConfiguration, error) {
 
@@ -35,6 +72,12 @@ func GetConfiguration() (ApplicationConfiguration, error) {
 	return *currentConfiguration, nil
 }
 
+// IsCheckRequested indicates if the configuration is only to be checked, with the option -check-config. The
+// configuration must have been read before
+func IsCheckRequested() bool {
+	return checkRequested
+}
+
 // readConfiguration read the configuration from all the possible sources
 func readConfiguration() (ApplicationConfiguration, error) {
 
@@ -46,104 +89,250 @@ func readConfiguration() (ApplicationConfiguration, error) {
 		CertificateFileName:          "",
 		PrivateKeyFileName:           "",
 		KubeContextConfigurationFile: filepath.Join(homeDir(), ".kuboxy", "kube.config"),
+		InClusterContextName:         "in-cluster",
+		ContextStore:                 "file",
+		ShutdownTimeout:              30 * time.Second,
+		ImpersonationCacheSize:       100,
+		AuditFileMaxSize:             100,
+		AuditFileMaxBackups:          5,
+		AuditMemorySize:              1000,
 	}
 
-	// Read values from flag on the command line
-	configurationFilePtr := flag.String("configurationFile", "", "The file having the configuration for the server Properties of this file can be overwritten by passing directly parameters to the application")
+	// The source of each value, by default the default values
+	config.sources = make(map[string]string)
+	visitParameters(reflect.ValueOf(&config).Elem(), "", func(path string, value reflect.Value) {
+		config.sources[path] = "default"
+	})
+
+	// Read values from flag on the command line. The values are only available once the flags are parsed
+	configurationFile := flag.String("configurationFile", "", "The file having the configuration for the server Properties of this file can be overwritten by passing directly parameters to the application")
 
 	// Get command line configuration
-	commandLineConfiguration := ApplicationConfiguration{
-		Address:                      *flag.String("address", "", "The IP address for listening incoming connections"),
-		RestPort:                     *flag.Int("restPort", -1, "The port for the REST services"),
-		WebSocketPort:                *flag.Int("webSocketPort", -1, "The port for the WebSocket for events"),
-		CertificateFileName:          *flag.String("certificateFileName", "", "The  name of the public certificate file"),
-		PrivateKeyFileName:           *flag.String("privateKeyFileName", "", "The  name of the private key file"),
-		KubeContextConfigurationFile: *flag.String("kubeContextConfigurationFile", "", "The  name of the file keeping the configuration of the context/cluster to connect to"),
-	}
+	commandLineConfiguration := ApplicationConfiguration{}
+	flag.StringVar(&commandLineConfiguration.Address, "address", "", "The IP address for listening incoming connections")
+	flag.IntVar(&commandLineConfiguration.RestPort, "restPort", 0, "The port for the REST services")
+	flag.IntVar(&commandLineConfiguration.WebSocketPort, "webSocketPort", 0, "The port for the WebSocket for events")
+	flag.BoolVar(&commandLineConfiguration.SinglePort, "singlePort", false, "Serve the WebSocket for events along the REST services, on /api/v1/events, instead of on its own port")
+	flag.DurationVar(&commandLineConfiguration.ShutdownTimeout, "shutdownTimeout", 0, "The maximum duration for closing the connections and finishing the requests in progress when stopping")
+	flag.StringVar(&commandLineConfiguration.CertificateFileName, "certificateFileName", "", "The  name of the public certificate file")
+	flag.StringVar(&commandLineConfiguration.PrivateKeyFileName, "privateKeyFileName", "", "The  name of the private key file")
+	flag.StringVar(&commandLineConfiguration.ClientCAFileName, "clientCAFileName", "", "The certificate authority verifying the client certificates, for authenticating the callers with their certificate")
+	flag.StringVar(&commandLineConfiguration.AuthenticationFile, "authenticationFile", "", "The file defining the users allowed to call the application. If not given, the authentication is disabled")
+	flag.StringVar(&commandLineConfiguration.AuthorizationFile, "authorizationFile", "", "The file defining the policies authorizing the requests of the users. If not given, all the requests are allowed")
+	flag.BoolVar(&commandLineConfiguration.ReadOnly, "readOnly", false, "Refuse all the modifications of the objects of the clusters and of the configuration")
+	flag.Var((*stringList)(&commandLineConfiguration.ReadOnlyContexts), "readOnlyContexts", "The contexts (comma separated globs) whose objects can't be modified, even if the application is not read-only")
+	flag.Var((*stringList)(&commandLineConfiguration.ReadWriteContexts), "readWriteContexts", "The contexts (comma separated globs) whose objects can be modified, even if the application is read-only")
+	flag.BoolVar(&commandLineConfiguration.MaskSecrets, "maskSecrets", false, "Replace the values of the secrets by their length and their hash in all the responses")
+	flag.BoolVar(&commandLineConfiguration.MaskConfigMaps, "maskConfigMaps", false, "Replace the values of the config maps by their length and their hash in all the responses")
+	flag.BoolVar(&commandLineConfiguration.ImpersonateCallers, "impersonateCallers", false, "Send the requests to the clusters on behalf of the authenticated callers, using the Kubernetes impersonation")
+	flag.IntVar(&commandLineConfiguration.ImpersonationCacheSize, "impersonationCacheSize", 0, "The number of callers for which the connections impersonating them are kept, by context")
+	flag.StringVar(&commandLineConfiguration.AuditFile, "auditFile", "", "The file receiving the audit of the modifications, one JSON object by line. If not given, the audit is only kept in memory")
+	flag.IntVar(&commandLineConfiguration.AuditFileMaxSize, "auditFileMaxSize", 0, "The maximum size of the audit file in megabytes, before it is rotated")
+	flag.IntVar(&commandLineConfiguration.AuditFileMaxBackups, "auditFileMaxBackups", 0, "The number of rotated audit files kept")
+	flag.IntVar(&commandLineConfiguration.AuditMemorySize, "auditMemorySize", 0, "The number of entries of the audit kept in memory and returned by /api/v1/audit")
+	flag.BoolVar(&commandLineConfiguration.AuditRequestBodies, "auditRequestBodies", false, "Record the bodies of the requests in the audit, their secrets being masked")
+	flag.StringVar(&commandLineConfiguration.KubeContextConfigurationFile, "kubeContextConfigurationFile", "", "The  name of the file keeping the configuration of the context/cluster to connect to")
+	flag.BoolVar(&commandLineConfiguration.SeedKubeConfig, "seedKubeConfig", false, "Import at startup the clusters, users and contexts of the kubectl configuration ($KUBECONFIG or ~/.kube/config)")
+	flag.BoolVar(&commandLineConfiguration.DisableCredentialsReveal, "disableCredentialsReveal", false, "Never return the credentials of the users in clear text through the REST API")
+	flag.Var((*stringList)(&commandLineConfiguration.InlineCertificateDirectories), "inlineCertificateDirectories", "The directories (comma separated) whose certificates can be embedded when exporting a kubeconfig, in addition to the ones referenced by the configuration at startup")
+	flag.StringVar(&commandLineConfiguration.InClusterContextName, "inClusterContextName", "", "The name of the context giving access to the cluster hosting the application, when running in a pod")
+	flag.StringVar(&commandLineConfiguration.CredentialsKeyFile, "credentialsKeyFile", "", "The file holding the key for encrypting the credentials in the context configuration file")
+	flag.StringVar(&commandLineConfiguration.ContextStore, "contextStore", "", "The backend storing the context configuration: file, memory or secret (a Secret of the hosting cluster)")
+	flag.StringVar(&commandLineConfiguration.ContextStoreSecretNamespace, "contextStoreSecretNamespace", "", "The namespace of the Secret storing the context configuration, by default the namespace of the application")
+	flag.StringVar(&commandLineConfiguration.ContextStoreSecretName, "contextStoreSecretName", "", "The name of the Secret storing the context configuration, by default kuboxy-contexts")
+
+	flag.BoolVar(&checkRequested, "check-config", false, "Print the effective configuration and all its problems, then exit")
 
 	// Parse the flags
 	flag.Parse()
 
+	// Only the flags given on the command line are defined, whatever their value
+	commandLineDefined := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		commandLineDefined[f.Name] = true
+	})
+
 	// Get home configuration
-	homeConfiguration, err := getHomeConfigurationFile()
+	homeConfigurationFileName := filepath.Join(homeDir(), ".kuboxy", "application.config")
+	homeConfiguration, homeDefined, err := getHomeConfigurationFile(homeConfigurationFileName)
 	if err != nil {
 		return config, err
 	}
-	updateConfiguration(&config, homeConfiguration)
+	config.apply(homeConfiguration, homeDefined, func(path string) string {
+		return "file " + homeConfigurationFileName
+	})
 
-	// Get specific configuration for overwriting home if asked
-	if len(*configurationFilePtr) > 0 {
-		specificConfiguration, e := getSpecificConfigurationFile(*configurationFilePtr)
+	// Get specific configuration for overwriting home if asked, either on the command line or by the environment
+	specificConfigurationFileName := *configurationFile
+	if len(specificConfigurationFileName) == 0 {
+		specificConfigurationFileName = os.Getenv(ConfigurationFileEnvironmentVariable)
+	}
+	if len(specificConfigurationFileName) > 0 {
+		specificConfiguration, specificDefined, e := getSpecificConfigurationFile(specificConfigurationFileName)
 		if e != nil {
 			return config, e
 		}
-		updateConfiguration(&config, specificConfiguration)
+		config.apply(specificConfiguration, specificDefined, func(path string) string {
+			return "file " + specificConfigurationFileName
+		})
+	}
+
+	// Get the environment configuration
+	environmentConfiguration, environmentDefined, err := getEnvironmentConfiguration()
+	if err != nil {
+		return config, err
 	}
+	config.apply(environmentConfiguration, environmentDefined, func(path string) string {
+		return "environment " + EnvironmentVariableName(path)
+	})
 
-	updateConfiguration(&config, commandLineConfiguration)
+	config.apply(commandLineConfiguration, commandLineDefined, func(path string) string {
+		return "command line -" + path
+	})
 
 	return config, nil
 }
 
-// Print prints the configuration in the standard output
-func (conf ApplicationConfiguration) Print() {
-	fmt.Printf("Configuration:\n")
-	fmt.Printf("\taddress:                       %v\n", conf.Address)
-	fmt.Printf("\trestPort:                      %v\n", conf.RestPort)
-	fmt.Printf("\twebSocketPort:                 %v\n", conf.WebSocketPort)
-	fmt.Printf("\tcertificateFileName:           %v\n", conf.CertificateFileName)
-	fmt.Printf("\tprivateKeyFileName:            %v\n", conf.PrivateKeyFileName)
-	fmt.Printf("\tkubeContextConfigurationFile:  %v\n", conf.KubeContextConfigurationFile)
-}
+// apply updates the configuration with the values defined by another one, given by the paths of their parameters,
+// and keeps the source of the values taken
+func (conf *ApplicationConfiguration) apply(source ApplicationConfiguration, defined map[string]bool, describeSource func(path string) string) {
 
-// getHomeConfigurationFile read the configuration file from the current user directory. If the file is missing, no
-// error is raised
-func getHomeConfigurationFile() (ApplicationConfiguration, error) {
+	conf.problems = append(conf.problems, validateSource(source, defined, describeSource)...)
 
-	configFileName := filepath.Join(homeDir(), ".kuboxy", "application.config")
+	updateConfiguration(conf, source, defined)
 
-	applicationConfiguration := ApplicationConfiguration{}
+	// A value comes from the source if it is defined by the source and was taken
+	sourceValue := reflect.ValueOf(source)
+	visitParameters(reflect.ValueOf(conf).Elem(), "", func(path string, value reflect.Value) {
+		if defined[path] && reflect.DeepEqual(findParameter(sourceValue, path).Interface(), value.Interface()) {
+			conf.sources[path] = describeSource(path)
+		}
+	})
+}
 
-	// If the file does not exist or is not readable, just return empty structure without error
-	if _, err := os.Stat(configFileName); err != nil {
-		return applicationConfiguration, nil
-	}
+// stringList is a flag holding a list of values, given separated by commas
+type stringList []string
 
-	// Read the file
-	yamlFile, err := ioutil.ReadFile(configFileName)
-	if err != nil {
-		return applicationConfiguration, fmt.Errorf("unable to read configuration file due to %v", err.Error())
+// String returns the values separated by commas
+func (list *stringList) String() string {
+	if list == nil {
+		return ""
 	}
-	err = yaml.Unmarshal(yamlFile, &applicationConfiguration)
-	if err != nil {
-		return applicationConfiguration, fmt.Errorf("unable to unmarshall configuration file due to %v", err.Error())
+	return strings.Join(*list, ",")
+}
+
+// Set reads the values separated by commas
+func (list *stringList) Set(text string) error {
+	*list = make([]string, 0)
+	for _, item := range strings.Split(text, ",") {
+		*list = append(*list, strings.TrimSpace(item))
 	}
+	return nil
+}
+
+// findParameter returns the parameter of a configuration, given by its path
+func findParameter(value reflect.Value, path string) reflect.Value {
+
+	var parameter reflect.Value
+	visitParameters(value, "", func(parameterPath string, parameterValue reflect.Value) {
+		if parameterPath == path {
+			parameter = parameterValue
+		}
+	})
 
-	return applicationConfiguration, nil
+	return parameter
 }
 
-// getSpecificConfigurationFile read the configuration file in a specific path. If the file is missing an error is
-// raised
-func getSpecificConfigurationFile(configurationFileName string) (ApplicationConfiguration, error) {
+// Print prints the configuration in the standard output, along with the source of each value
+func (conf ApplicationConfiguration) Print() {
+	fmt.Printf("Configuration:\n")
+	visitParameters(reflect.ValueOf(conf), "", func(path string, value reflect.Value) {
+		source, ok := conf.sources[path]
+		if !ok {
+			source = "default"
+		}
+		fmt.Printf("\t%-31s%v (%s)\n", path+":", value.Interface(), source)
+	})
+}
 
-	applicationConfiguration := ApplicationConfiguration{}
+// getHomeConfigurationFile read the configuration file from the current user directory, along with the paths of the
+// parameters it defines. If the file is missing, no error is raised
+func getHomeConfigurationFile(configFileName string) (ApplicationConfiguration, map[string]bool, error) {
+
+	// If the file does not exist or is not readable, just return empty structure without error
+	if _, err := os.Stat(configFileName); err != nil {
+		return ApplicationConfiguration{}, make(map[string]bool), nil
+	}
+
+	return readConfigurationFile(configFileName)
+}
+
+// getSpecificConfigurationFile read the configuration file in a specific path, along with the paths of the parameters
+// it defines. If the file is missing an error is raised
+func getSpecificConfigurationFile(configurationFileName string) (ApplicationConfiguration, map[string]bool, error) {
 
 	// If the file does not exist or is not readable, return err
 	if _, err := os.Stat(configurationFileName); err != nil {
-		return applicationConfiguration, err
+		return ApplicationConfiguration{}, nil, err
 	}
 
+	return readConfigurationFile(configurationFileName)
+}
+
+// readConfigurationFile reads a configuration file, along with the paths of the parameters it defines. A parameter
+// is defined by the file if its key is present with a value, even a zero one (false, 0, "")
+func readConfigurationFile(configurationFileName string) (ApplicationConfiguration, map[string]bool, error) {
+
+	applicationConfiguration := ApplicationConfiguration{}
+
 	// Read the file
 	yamlFile, err := ioutil.ReadFile(configurationFileName)
 	if err != nil {
-		return applicationConfiguration, fmt.Errorf("unable to read configuration file due to %v", err.Error())
+		return applicationConfiguration, nil, fmt.Errorf("unable to read configuration file due to %v", err.Error())
 	}
 	err = yaml.Unmarshal(yamlFile, &applicationConfiguration)
 	if err != nil {
-		return applicationConfiguration, fmt.Errorf("unable to unmarshall configuration file due to %v", err.Error())
+		return applicationConfiguration, nil, fmt.Errorf("unable to unmarshall configuration file due to %v", err.Error())
+	}
+
+	// Read the file a second time, for knowing its keys
+	var content map[string]interface{}
+	if err = yaml.Unmarshal(yamlFile, &content); err != nil {
+		return applicationConfiguration, nil, fmt.Errorf("unable to unmarshall configuration file due to %v", err.Error())
+	}
+
+	defined := make(map[string]bool)
+	addDefinedPaths(defined, "", content)
+
+	return applicationConfiguration, defined, nil
+}
+
+// addDefinedPaths adds the paths of all the keys having a value in the content of a configuration file. The keys of
+// the nested sections are added recursively
+func addDefinedPaths(defined map[string]bool, prefix string, content interface{}) {
+
+	addKey := func(key string, value interface{}) {
+		if value == nil {
+			return
+		}
+		path := key
+		if len(prefix) > 0 {
+			path = prefix + "." + key
+		}
+		defined[path] = true
+		addDefinedPaths(defined, path, value)
 	}
 
-	return applicationConfiguration, nil
+	switch typedContent := content.(type) {
+	case map[string]interface{}:
+		for key, value := range typedContent {
+			addKey(key, value)
+		}
+	case map[interface{}]interface{}:
+		for key, value := range typedContent {
+			addKey(fmt.Sprint(key), value)
+		}
+	}
 }
 
 // homeDir returns the home directory of the user depending on the OS
@@ -154,23 +343,101 @@ func homeDir() string {
 	return os.Getenv("USERPROFILE") // windows
 }
 
-// updateConfiguration updates a configuration with all valid parameters from another one. The result is always
-// valid
-func updateConfiguration(toUpdate *ApplicationConfiguration, source ApplicationConfiguration) {
-	if len(source.Address) > 0 {
+// updateConfiguration updates a configuration with all valid parameters defined by another one, given by the paths of
+// their parameters. A defined parameter is taken whatever its value, so that a source can set back a parameter to
+// false or to zero. The result is always valid
+func updateConfiguration(toUpdate *ApplicationConfiguration, source ApplicationConfiguration, defined map[string]bool) {
+	if defined["address"] {
 		toUpdate.Address = source.Address
 	}
-	if source.RestPort > 0 && source.RestPort < 65535 {
+	if defined["restPort"] && source.RestPort > 0 && source.RestPort <= maxPort {
 		toUpdate.RestPort = source.RestPort
 	}
-	if source.WebSocketPort > 0 && source.WebSocketPort < 65535 {
+	if defined["webSocketPort"] && source.WebSocketPort > 0 && source.WebSocketPort <= maxPort {
 		toUpdate.WebSocketPort = source.WebSocketPort
 	}
-	if len(source.CertificateFileName) != 0 && len(source.PrivateKeyFileName) != 0 {
+	if defined["singlePort"] {
+		toUpdate.SinglePort = source.SinglePort
+	}
+	if defined["shutdownTimeout"] && source.ShutdownTimeout >= 0 {
+		toUpdate.ShutdownTimeout = source.ShutdownTimeout
+	}
+	// The certificate and its private key are taken together, both empty disabling HTTPS
+	if (defined["certificateFileName"] || defined["privateKeyFileName"]) &&
+		(len(source.CertificateFileName) == 0) == (len(source.PrivateKeyFileName) == 0) {
 		toUpdate.CertificateFileName = source.CertificateFileName
 		toUpdate.PrivateKeyFileName = source.PrivateKeyFileName
 	}
-	if len(source.KubeContextConfigurationFile) > 0 {
+	if defined["clientCAFileName"] {
+		toUpdate.ClientCAFileName = source.ClientCAFileName
+	}
+	if defined["authenticationFile"] {
+		toUpdate.AuthenticationFile = source.AuthenticationFile
+	}
+	if defined["authorizationFile"] {
+		toUpdate.AuthorizationFile = source.AuthorizationFile
+	}
+	if defined["readOnly"] {
+		toUpdate.ReadOnly = source.ReadOnly
+	}
+	if defined["readOnlyContexts"] {
+		toUpdate.ReadOnlyContexts = source.ReadOnlyContexts
+	}
+	if defined["readWriteContexts"] {
+		toUpdate.ReadWriteContexts = source.ReadWriteContexts
+	}
+	if defined["maskSecrets"] {
+		toUpdate.MaskSecrets = source.MaskSecrets
+	}
+	if defined["maskConfigMaps"] {
+		toUpdate.MaskConfigMaps = source.MaskConfigMaps
+	}
+	if defined["impersonateCallers"] {
+		toUpdate.ImpersonateCallers = source.ImpersonateCallers
+	}
+	if defined["impersonationCacheSize"] && source.ImpersonationCacheSize > 0 {
+		toUpdate.ImpersonationCacheSize = source.ImpersonationCacheSize
+	}
+	if defined["auditFile"] {
+		toUpdate.AuditFile = source.AuditFile
+	}
+	if defined["auditFileMaxSize"] && source.AuditFileMaxSize >= 0 {
+		toUpdate.AuditFileMaxSize = source.AuditFileMaxSize
+	}
+	if defined["auditFileMaxBackups"] && source.AuditFileMaxBackups >= 0 {
+		toUpdate.AuditFileMaxBackups = source.AuditFileMaxBackups
+	}
+	if defined["auditMemorySize"] && source.AuditMemorySize >= 0 {
+		toUpdate.AuditMemorySize = source.AuditMemorySize
+	}
+	if defined["auditRequestBodies"] {
+		toUpdate.AuditRequestBodies = source.AuditRequestBodies
+	}
+	if defined["kubeContextConfigurationFile"] && len(source.KubeContextConfigurationFile) > 0 {
 		toUpdate.KubeContextConfigurationFile = source.KubeContextConfigurationFile
 	}
+	if defined["seedKubeConfig"] {
+		toUpdate.SeedKubeConfig = source.SeedKubeConfig
+	}
+	if defined["disableCredentialsReveal"] {
+		toUpdate.DisableCredentialsReveal = source.DisableCredentialsReveal
+	}
+	if defined["inlineCertificateDirectories"] {
+		toUpdate.InlineCertificateDirectories = source.InlineCertificateDirectories
+	}
+	if defined["inClusterContextName"] {
+		toUpdate.InClusterContextName = source.InClusterContextName
+	}
+	if defined["credentialsKeyFile"] {
+		toUpdate.CredentialsKeyFile = source.CredentialsKeyFile
+	}
+	if defined["contextStore"] && len(source.ContextStore) > 0 {
+		toUpdate.ContextStore = source.ContextStore
+	}
+	if defined["contextStoreSecretNamespace"] {
+		toUpdate.ContextStoreSecretNamespace = source.ContextStoreSecretNamespace
+	}
+	if defined["contextStoreSecretName"] {
+		toUpdate.ContextStoreSecretName = source.ContextStoreSecretName
+	}
 }
@@ -0,0 +1,315 @@
+package configuration
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newDefaultConfiguration returns a configuration as created before reading the sources
+func newDefaultConfiguration() ApplicationConfiguration {
+
+	config := ApplicationConfiguration{
+		Address:                      "localhost",
+		RestPort:                     8080,
+		WebSocketPort:                8081,
+		KubeContextConfigurationFile: "/home/kuboxy/.kuboxy/kube.config",
+		ContextStore:                 "file",
+		ShutdownTimeout:              30 * time.Second,
+		ImpersonationCacheSize:       100,
+		AuditFileMaxSize:             100,
+		AuditMemorySize:              1000,
+	}
+
+	config.sources = make(map[string]string)
+	visitParameters(reflect.ValueOf(&config).Elem(), "", func(path string, value reflect.Value) {
+		config.sources[path] = "default"
+	})
+
+	return config
+}
+
+// describeAs returns a function describing all the values as coming from the given source
+func describeAs(source string) func(path string) string {
+	return func(path string) string {
+		return source
+	}
+}
+
+func TestApplyPrecedence(t *testing.T) {
+
+	type layer struct {
+		name    string
+		values  ApplicationConfiguration
+		defined []string
+	}
+
+	tests := []struct {
+		name           string
+		layers         []layer
+		check          func(config ApplicationConfiguration) bool
+		path           string
+		expectedSource string
+		expectProblem  bool
+	}{
+		{
+			name:           "default kept when not defined",
+			layers:         []layer{{name: "file", values: ApplicationConfiguration{RestPort: 9000}}},
+			check:          func(config ApplicationConfiguration) bool { return config.RestPort == 8080 },
+			path:           "restPort",
+			expectedSource: "default",
+		},
+		{
+			name:           "last layer wins",
+			layers:         []layer{{name: "file", values: ApplicationConfiguration{RestPort: 9000}, defined: []string{"restPort"}}, {name: "environment", values: ApplicationConfiguration{RestPort: 9001}, defined: []string{"restPort"}}},
+			check:          func(config ApplicationConfiguration) bool { return config.RestPort == 9001 },
+			path:           "restPort",
+			expectedSource: "environment",
+		},
+		{
+			name:           "false overrides true",
+			layers:         []layer{{name: "file", values: ApplicationConfiguration{ReadOnly: true}, defined: []string{"readOnly"}}, {name: "command line", values: ApplicationConfiguration{ReadOnly: false}, defined: []string{"readOnly"}}},
+			check:          func(config ApplicationConfiguration) bool { return !config.ReadOnly },
+			path:           "readOnly",
+			expectedSource: "command line",
+		},
+		{
+			name:           "zero overrides a size",
+			layers:         []layer{{name: "environment", values: ApplicationConfiguration{AuditMemorySize: 0}, defined: []string{"auditMemorySize"}}},
+			check:          func(config ApplicationConfiguration) bool { return config.AuditMemorySize == 0 },
+			path:           "auditMemorySize",
+			expectedSource: "environment",
+		},
+		{
+			name:           "empty string overrides a value",
+			layers:         []layer{{name: "file", values: ApplicationConfiguration{AuthenticationFile: "users.yaml"}, defined: []string{"authenticationFile"}}, {name: "environment", values: ApplicationConfiguration{}, defined: []string{"authenticationFile"}}},
+			check:          func(config ApplicationConfiguration) bool { return len(config.AuthenticationFile) == 0 },
+			path:           "authenticationFile",
+			expectedSource: "environment",
+		},
+		{
+			name:           "undefined value does not override",
+			layers:         []layer{{name: "file", values: ApplicationConfiguration{MaskSecrets: true}, defined: []string{"maskSecrets"}}, {name: "command line", values: ApplicationConfiguration{}}},
+			check:          func(config ApplicationConfiguration) bool { return config.MaskSecrets },
+			path:           "maskSecrets",
+			expectedSource: "file",
+		},
+		{
+			name:           "invalid port ignored",
+			layers:         []layer{{name: "file", values: ApplicationConfiguration{RestPort: 0}, defined: []string{"restPort"}}},
+			check:          func(config ApplicationConfiguration) bool { return config.RestPort == 8080 },
+			path:           "restPort",
+			expectedSource: "default",
+			expectProblem:  true,
+		},
+		{
+			name:           "empty context store ignored",
+			layers:         []layer{{name: "environment", values: ApplicationConfiguration{}, defined: []string{"contextStore"}}},
+			check:          func(config ApplicationConfiguration) bool { return config.ContextStore == "file" },
+			path:           "contextStore",
+			expectedSource: "default",
+			expectProblem:  true,
+		},
+		{
+			name:           "certificate without key ignored",
+			layers:         []layer{{name: "file", values: ApplicationConfiguration{CertificateFileName: "server.crt"}, defined: []string{"certificateFileName"}}},
+			check:          func(config ApplicationConfiguration) bool { return len(config.CertificateFileName) == 0 },
+			path:           "certificateFileName",
+			expectedSource: "default",
+			expectProblem:  true,
+		},
+		{
+			name:   "certificate disabled",
+			layers: []layer{{name: "file", values: ApplicationConfiguration{CertificateFileName: "server.crt", PrivateKeyFileName: "server.key"}, defined: []string{"certificateFileName", "privateKeyFileName"}}, {name: "command line", values: ApplicationConfiguration{}, defined: []string{"certificateFileName", "privateKeyFileName"}}},
+			check: func(config ApplicationConfiguration) bool {
+				return len(config.CertificateFileName) == 0 && len(config.PrivateKeyFileName) == 0
+			},
+			path:           "privateKeyFileName",
+			expectedSource: "command line",
+		},
+		{
+			name:           "list replaced by an empty list",
+			layers:         []layer{{name: "file", values: ApplicationConfiguration{ReadOnlyContexts: []string{"prod-*"}}, defined: []string{"readOnlyContexts"}}, {name: "environment", values: ApplicationConfiguration{ReadOnlyContexts: []string{}}, defined: []string{"readOnlyContexts"}}},
+			check:          func(config ApplicationConfiguration) bool { return len(config.ReadOnlyContexts) == 0 },
+			path:           "readOnlyContexts",
+			expectedSource: "environment",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			config := newDefaultConfiguration()
+			for _, layer := range test.layers {
+				defined := make(map[string]bool)
+				for _, path := range layer.defined {
+					defined[path] = true
+				}
+				config.apply(layer.values, defined, describeAs(layer.name))
+			}
+
+			if !test.check(config) {
+				t.Errorf("unexpected configuration %+v", config)
+			}
+			if source := config.sources[test.path]; source != test.expectedSource {
+				t.Errorf("expected the source %s for %s, got %s", test.expectedSource, test.path, source)
+			}
+			if hasProblem := len(config.problems) > 0; hasProblem != test.expectProblem {
+				t.Errorf("expected a problem: %v, got %v", test.expectProblem, config.problems)
+			}
+		})
+	}
+}
+
+func TestReadConfigurationFile(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "kuboxy-configuration")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	tests := []struct {
+		name            string
+		content         string
+		expectedDefined []string
+	}{
+		{
+			name:            "empty file",
+			content:         "",
+			expectedDefined: []string{},
+		},
+		{
+			name:            "zero values",
+			content:         "readOnly: false\nauditMemorySize: 0\naddress: \"\"\n",
+			expectedDefined: []string{"readOnly", "auditMemorySize", "address"},
+		},
+		{
+			name:            "key without value",
+			content:         "readOnly:\nrestPort: 9000\n",
+			expectedDefined: []string{"restPort"},
+		},
+		{
+			name:            "list",
+			content:         "readOnlyContexts: [\"prod-*\"]\n",
+			expectedDefined: []string{"readOnlyContexts"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			fileName := filepath.Join(directory, "application.config")
+			if err := ioutil.WriteFile(fileName, []byte(test.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			_, defined, err := readConfigurationFile(fileName)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			expected := make(map[string]bool)
+			for _, path := range test.expectedDefined {
+				expected[path] = true
+			}
+			if !reflect.DeepEqual(defined, expected) {
+				t.Errorf("expected the parameters %v, got %v", expected, defined)
+			}
+		})
+	}
+}
+
+func TestGetEnvironmentConfiguration(t *testing.T) {
+
+	tests := []struct {
+		name            string
+		variables       map[string]string
+		expected        ApplicationConfiguration
+		expectedDefined []string
+		expectError     bool
+	}{
+		{
+			name:            "no variable",
+			expectedDefined: []string{},
+		},
+		{
+			name:            "values",
+			variables:       map[string]string{"KUBOXY_REST_PORT": "9000", "KUBOXY_READ_ONLY": "false", "KUBOXY_SHUTDOWN_TIMEOUT": "1m", "KUBOXY_READ_ONLY_CONTEXTS": "prod-*, staging"},
+			expected:        ApplicationConfiguration{RestPort: 9000, ShutdownTimeout: time.Minute, ReadOnlyContexts: []string{"prod-*", "staging"}},
+			expectedDefined: []string{"restPort", "readOnly", "shutdownTimeout", "readOnlyContexts"},
+		},
+		{
+			name:            "empty variable ignored",
+			variables:       map[string]string{"KUBOXY_ADDRESS": ""},
+			expectedDefined: []string{},
+		},
+		{
+			name:        "invalid value",
+			variables:   map[string]string{"KUBOXY_REST_PORT": "port"},
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			for name, value := range test.variables {
+				os.Setenv(name, value)
+			}
+			defer func() {
+				for name := range test.variables {
+					os.Unsetenv(name)
+				}
+			}()
+
+			config, defined, err := getEnvironmentConfiguration()
+			if test.expectError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(config, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, config)
+			}
+
+			expected := make(map[string]bool)
+			for _, path := range test.expectedDefined {
+				expected[path] = true
+			}
+			if !reflect.DeepEqual(defined, expected) {
+				t.Errorf("expected the parameters %v, got %v", expected, defined)
+			}
+		})
+	}
+}
+
+func TestEnvironmentVariableName(t *testing.T) {
+
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"address", "KUBOXY_ADDRESS"},
+		{"restPort", "KUBOXY_REST_PORT"},
+		{"clientCAFileName", "KUBOXY_CLIENT_CA_FILE_NAME"},
+		{"kubeContextConfigurationFile", "KUBOXY_KUBE_CONTEXT_CONFIGURATION_FILE"},
+		{"section.someValue", "KUBOXY_SECTION_SOME_VALUE"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			if name := EnvironmentVariableName(test.path); name != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, name)
+			}
+		})
+	}
+}
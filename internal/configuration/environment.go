@@ -0,0 +1,182 @@
+package configuration
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// EnvironmentVariablePrefix is the prefix of the environment variables defining the configuration. The name of a
+// variable is the prefix followed by the path of the parameter in upper snake case, for example KUBOXY_REST_PORT for
+// restPort or KUBOXY_SECTION_SOME_VALUE for the parameter someValue of a nested section
+const EnvironmentVariablePrefix = "KUBOXY_"
+
+// ConfigurationFileEnvironmentVariable is the environment variable giving the specific configuration file, when the
+// option configurationFile is not given on the command line
+const ConfigurationFileEnvironmentVariable = EnvironmentVariablePrefix + "CONFIGURATION_FILE"
+
+// The type of the durations, which are given as text (1m30s) and not as a number of nanoseconds
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// getEnvironmentConfiguration reads the configuration from the environment variables, along with the paths of the
+// parameters they define. All the parameters, including the ones of the nested sections, can be defined. The empty
+// variables are ignored
+func getEnvironmentConfiguration() (ApplicationConfiguration, map[string]bool, error) {
+
+	applicationConfiguration := ApplicationConfiguration{}
+	defined := make(map[string]bool)
+
+	var err error
+	visitParameters(reflect.ValueOf(&applicationConfiguration).Elem(), "", func(path string, value reflect.Value) {
+
+		if err != nil {
+			return
+		}
+
+		variableName := EnvironmentVariableName(path)
+		text, ok := os.LookupEnv(variableName)
+		if !ok || len(text) == 0 {
+			return
+		}
+
+		if parseErr := setParameter(value, text); parseErr != nil {
+			err = fmt.Errorf("the value of the environment variable %s is not valid: %v", variableName, parseErr.Error())
+			return
+		}
+		defined[path] = true
+	})
+
+	return applicationConfiguration, defined, err
+}
+
+// EnvironmentVariableName returns the name of the environment variable defining a parameter, given by its path
+// (section.parameterName)
+func EnvironmentVariableName(path string) string {
+
+	var builder strings.Builder
+	builder.WriteString(EnvironmentVariablePrefix)
+
+	for sectionIndex, section := range strings.Split(path, ".") {
+		if sectionIndex > 0 {
+			builder.WriteRune('_')
+		}
+
+		runes := []rune(section)
+		for i, r := range runes {
+			// Start a new word before an upper case letter following a lower case letter, or before the last letter
+			// of an acronym (certificateCA, privateCAFile)
+			if i > 0 && unicode.IsUpper(r) {
+				previous := runes[i-1]
+				nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+				if !unicode.IsUpper(previous) || nextIsLower {
+					builder.WriteRune('_')
+				}
+			}
+			builder.WriteRune(unicode.ToUpper(r))
+		}
+	}
+
+	return builder.String()
+}
+
+// visitParameters calls the visitor for each parameter of a configuration, with its path built from the YAML names
+// of the parameter and of its sections. The nested sections are visited recursively
+func visitParameters(value reflect.Value, prefix string, visitor func(path string, value reflect.Value)) {
+
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+
+		field := valueType.Field(i)
+
+		// Skip the private fields
+		if len(field.PkgPath) > 0 {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		path := name
+		if len(prefix) > 0 {
+			path = prefix + "." + name
+		}
+
+		if field.Type.Kind() == reflect.Struct {
+			visitParameters(value.Field(i), path, visitor)
+			continue
+		}
+
+		visitor(path, value.Field(i))
+	}
+}
+
+// setParameter converts a text to the type of a parameter and sets it. The lists are given as comma separated values
+func setParameter(value reflect.Value, text string) error {
+
+	if value.Type() == durationType {
+		duration, err := time.ParseDuration(text)
+		if err != nil {
+			return err
+		}
+		value.SetInt(int64(duration))
+		return nil
+	}
+
+	switch value.Kind() {
+
+	case reflect.String:
+		value.SetString(text)
+
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		value.SetBool(parsed)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(text, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetInt(parsed)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(text, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetUint(parsed)
+
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(text, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetFloat(parsed)
+
+	case reflect.Slice:
+		items := strings.Split(text, ",")
+		slice := reflect.MakeSlice(value.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := setParameter(slice.Index(i), strings.TrimSpace(item)); err != nil {
+				return err
+			}
+		}
+		value.Set(slice)
+
+	default:
+		return fmt.Errorf("the parameters of type %v can not be defined by an environment variable", value.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,287 @@
+package configuration
+
+import (
+	"crypto/tls"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// The highest valid port
+const maxPort = 65535
+
+// The backends available for storing the context configuration, as defined by the context package
+var contextStores = []string{"file", "memory", "secret"}
+
+// ValidationError is a problem found in the configuration, with the parameter and the source of its value
+type ValidationError struct {
+	Parameter string `json:"parameter"`
+	Source    string `json:"source,omitempty"`
+	Message   string `json:"message"`
+}
+
+// Error returns the description of the problem
+func (err ValidationError) Error() string {
+	if len(err.Source) == 0 {
+		return fmt.Sprintf("%s: %s", err.Parameter, err.Message)
+	}
+	return fmt.Sprintf("%s (%s): %s", err.Parameter, err.Source, err.Message)
+}
+
+// ValidationErrors are all the problems found in the configuration
+type ValidationErrors []ValidationError
+
+// Error returns the description of all the problems
+func (errs ValidationErrors) Error() string {
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Validate checks the effective configuration and returns all the problems found, including the values given by
+// the files, the environment or the command line that were ignored because they were not valid. If the configuration
+// is valid, nil is returned
+func (conf ApplicationConfiguration) Validate() ValidationErrors {
+
+	errs := make(ValidationErrors, 0, len(conf.problems))
+	errs = append(errs, conf.problems...)
+
+	if !conf.SinglePort && conf.RestPort == conf.WebSocketPort {
+		errs = append(errs, conf.newValidationError("webSocketPort", fmt.Sprintf("the port %d is already used by the REST services (restPort)", conf.WebSocketPort)))
+	}
+
+	if len(conf.CertificateFileName) > 0 {
+		errs = append(errs, conf.validateCertificate()...)
+	}
+
+	if len(conf.ClientCAFileName) > 0 {
+		if len(conf.CertificateFileName) == 0 {
+			errs = append(errs, conf.newValidationError("clientCAFileName", "the client certificates can only be verified when HTTPS is used (certificateFileName)"))
+		}
+		if _, err := os.Stat(conf.ClientCAFileName); err != nil {
+			errs = append(errs, conf.newValidationError("clientCAFileName", fmt.Sprintf("the client certificate authority is not readable: %v", err.Error())))
+		}
+	}
+
+	if len(conf.AuthenticationFile) > 0 {
+		if _, err := os.Stat(conf.AuthenticationFile); err != nil {
+			errs = append(errs, conf.newValidationError("authenticationFile", fmt.Sprintf("the users file is not readable: %v", err.Error())))
+		}
+	}
+
+	if len(conf.AuthorizationFile) > 0 {
+		if len(conf.AuthenticationFile) == 0 {
+			errs = append(errs, conf.newValidationError("authorizationFile", "the requests can only be authorized when the callers are authenticated (authenticationFile)"))
+		}
+		if _, err := os.Stat(conf.AuthorizationFile); err != nil {
+			errs = append(errs, conf.newValidationError("authorizationFile", fmt.Sprintf("the authorization file is not readable: %v", err.Error())))
+		}
+	}
+
+	for _, pattern := range conf.ReadOnlyContexts {
+		if _, err := path.Match(pattern, ""); err != nil {
+			errs = append(errs, conf.newValidationError("readOnlyContexts", fmt.Sprintf("the glob %s is not valid", pattern)))
+		}
+	}
+
+	for _, pattern := range conf.ReadWriteContexts {
+		if _, err := path.Match(pattern, ""); err != nil {
+			errs = append(errs, conf.newValidationError("readWriteContexts", fmt.Sprintf("the glob %s is not valid", pattern)))
+		}
+	}
+
+	if conf.ImpersonateCallers && len(conf.AuthenticationFile) == 0 {
+		errs = append(errs, conf.newValidationError("impersonateCallers", "the callers can only be impersonated when they are authenticated (authenticationFile)"))
+	}
+
+	if len(conf.AuditFile) > 0 {
+		if info, err := os.Stat(conf.AuditFile); err == nil && info.IsDir() {
+			errs = append(errs, conf.newValidationError("auditFile", "the audit file is a directory"))
+		} else if _, err = os.Stat(filepath.Dir(conf.AuditFile)); err != nil {
+			errs = append(errs, conf.newValidationError("auditFile", fmt.Sprintf("the directory of the audit file is not readable: %v", err.Error())))
+		}
+	}
+
+	if len(conf.ContextStore) > 0 && !containsString(contextStores, conf.ContextStore) {
+		errs = append(errs, conf.newValidationError("contextStore", fmt.Sprintf("the context store %s is unknown, it must be one of: %s", conf.ContextStore, strings.Join(contextStores, ", "))))
+	}
+
+	if len(conf.ContextStore) == 0 || conf.ContextStore == "file" {
+		if err := conf.validateContextConfigurationFile(); err != nil {
+			errs = append(errs, *err)
+		}
+	}
+
+	for _, directory := range conf.InlineCertificateDirectories {
+		if info, err := os.Stat(directory); err != nil {
+			errs = append(errs, conf.newValidationError("inlineCertificateDirectories", fmt.Sprintf("the directory %s is not readable: %v", directory, err.Error())))
+		} else if !info.IsDir() {
+			errs = append(errs, conf.newValidationError("inlineCertificateDirectories", fmt.Sprintf("%s is not a directory", directory)))
+		}
+	}
+
+	if len(conf.CredentialsKeyFile) > 0 {
+		if _, err := os.Stat(conf.CredentialsKeyFile); err != nil {
+			errs = append(errs, conf.newValidationError("credentialsKeyFile", fmt.Sprintf("the key file is not readable: %v", err.Error())))
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+// validateCertificate checks that the certificate and its private key exist and can be loaded
+func (conf ApplicationConfiguration) validateCertificate() ValidationErrors {
+
+	errs := make(ValidationErrors, 0)
+
+	if _, err := os.Stat(conf.CertificateFileName); err != nil {
+		errs = append(errs, conf.newValidationError("certificateFileName", fmt.Sprintf("the certificate file is not readable: %v", err.Error())))
+	}
+
+	if _, err := os.Stat(conf.PrivateKeyFileName); err != nil {
+		errs = append(errs, conf.newValidationError("privateKeyFileName", fmt.Sprintf("the private key file is not readable: %v", err.Error())))
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	if _, err := tls.LoadX509KeyPair(conf.CertificateFileName, conf.PrivateKeyFileName); err != nil {
+		errs = append(errs, conf.newValidationError("certificateFileName", fmt.Sprintf("the certificate and its private key can not be loaded: %v", err.Error())))
+	}
+
+	return errs
+}
+
+// validateContextConfigurationFile checks that the context configuration file is not a directory and that its
+// directory exists. The default directory is not checked, as it is created at the first start
+func (conf ApplicationConfiguration) validateContextConfigurationFile() *ValidationError {
+
+	info, err := os.Stat(conf.KubeContextConfigurationFile)
+	if err == nil {
+		if info.IsDir() {
+			validationError := conf.newValidationError("kubeContextConfigurationFile", "the context configuration file is a directory")
+			return &validationError
+		}
+		return nil
+	}
+
+	if conf.sources["kubeContextConfigurationFile"] == "default" {
+		return nil
+	}
+
+	directory := filepath.Dir(conf.KubeContextConfigurationFile)
+	if _, err = os.Stat(directory); err != nil {
+		validationError := conf.newValidationError("kubeContextConfigurationFile", fmt.Sprintf("the directory of the context configuration file is not accessible: %v", err.Error()))
+		return &validationError
+	}
+
+	return nil
+}
+
+// newValidationError creates a problem for a parameter of the effective configuration
+func (conf ApplicationConfiguration) newValidationError(parameter string, message string) ValidationError {
+	return ValidationError{
+		Parameter: parameter,
+		Source:    conf.sources[parameter],
+		Message:   message,
+	}
+}
+
+// validateSource checks the values defined by a source (file, environment or command line), given by the paths of
+// their parameters, that would otherwise be silently ignored when updating the configuration
+func validateSource(source ApplicationConfiguration, defined map[string]bool, describeSource func(path string) string) ValidationErrors {
+
+	errs := make(ValidationErrors, 0)
+
+	if defined["restPort"] && (source.RestPort < 1 || source.RestPort > maxPort) {
+		errs = append(errs, ValidationError{
+			Parameter: "restPort",
+			Source:    describeSource("restPort"),
+			Message:   fmt.Sprintf("the port %d is not between 1 and %d", source.RestPort, maxPort),
+		})
+	}
+
+	if defined["webSocketPort"] && (source.WebSocketPort < 1 || source.WebSocketPort > maxPort) {
+		errs = append(errs, ValidationError{
+			Parameter: "webSocketPort",
+			Source:    describeSource("webSocketPort"),
+			Message:   fmt.Sprintf("the port %d is not between 1 and %d", source.WebSocketPort, maxPort),
+		})
+	}
+
+	if source.ShutdownTimeout < 0 {
+		errs = append(errs, ValidationError{
+			Parameter: "shutdownTimeout",
+			Source:    describeSource("shutdownTimeout"),
+			Message:   fmt.Sprintf("the duration %v is negative", source.ShutdownTimeout),
+		})
+	}
+
+	sizes := []struct {
+		path    string
+		value   int
+		minimum int
+	}{
+		{"impersonationCacheSize", source.ImpersonationCacheSize, 1},
+		{"auditFileMaxSize", source.AuditFileMaxSize, 0},
+		{"auditFileMaxBackups", source.AuditFileMaxBackups, 0},
+		{"auditMemorySize", source.AuditMemorySize, 0},
+	}
+	for _, size := range sizes {
+		if defined[size.path] && size.value < size.minimum {
+			errs = append(errs, ValidationError{
+				Parameter: size.path,
+				Source:    describeSource(size.path),
+				Message:   fmt.Sprintf("the value %d is lower than %d", size.value, size.minimum),
+			})
+		}
+	}
+
+	for _, path := range []string{"kubeContextConfigurationFile", "contextStore"} {
+		if defined[path] && len(findParameter(reflect.ValueOf(source), path).String()) == 0 {
+			errs = append(errs, ValidationError{
+				Parameter: path,
+				Source:    describeSource(path),
+				Message:   "the value can not be empty",
+			})
+		}
+	}
+
+	if len(source.CertificateFileName) > 0 && len(source.PrivateKeyFileName) == 0 {
+		errs = append(errs, ValidationError{
+			Parameter: "privateKeyFileName",
+			Source:    describeSource("privateKeyFileName"),
+			Message:   "a certificate is given without its private key",
+		})
+	}
+
+	if len(source.CertificateFileName) == 0 && len(source.PrivateKeyFileName) > 0 {
+		errs = append(errs, ValidationError{
+			Parameter: "certificateFileName",
+			Source:    describeSource("certificateFileName"),
+			Message:   "a private key is given without its certificate",
+		})
+	}
+
+	return errs
+}
+
+// containsString checks if a value is in a list
+func containsString(values []string, value string) bool {
+	for _, existingValue := range values {
+		if existingValue == value {
+			return true
+		}
+	}
+	return false
+}
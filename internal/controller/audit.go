@@ -0,0 +1,228 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/audit"
+	"github.com/twuillemin/kuboxy/internal/authorization"
+	"github.com/twuillemin/kuboxy/pkg/types"
+)
+
+// The verbs of the requests modifying something, by HTTP method
+var auditedVerbs = map[string]string{
+	http.MethodPost:   authorization.VerbCreate,
+	http.MethodPut:    authorization.VerbUpdate,
+	http.MethodDelete: authorization.VerbDelete,
+}
+
+// The sink keeping the most recent entries of the audit in memory, nil if disabled
+var auditMemorySink *audit.MemorySink
+
+// SetAuditMemorySink sets the sink whose entries are returned by the audit endpoint
+func SetAuditMemorySink(sink *audit.MemorySink) {
+	auditMemorySink = sink
+}
+
+func registerAuditController(e *echo.Echo) {
+
+	e.GET("api/v1/audit", getAudit, authorize(authorization.Audit, authorization.VerbList))
+}
+
+// AuditMiddleware records an entry in the audit for each request creating, updating or deleting an object or the
+// configuration, and for each value of a Secret or of a ConfigMap revealed. If recordBodies is true, the bodies of the
+// requests are recorded, their secrets being masked
+func AuditMiddleware(recordBodies bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+
+			// The path of the route, the objects, the configuration and the revealed values being the only parts audited
+			route := strings.Split(strings.TrimPrefix(c.Path(), "/"), "/")
+			verb, isAudited := getAuditedVerb(c.Request().Method, route)
+			if !isAudited {
+				return next(c)
+			}
+
+			// Read the body, keeping it for the handler
+			var body []byte
+			if c.Request().Body != nil {
+				var err error
+				body, err = ioutil.ReadAll(c.Request().Body)
+				if err != nil {
+					return echo.NewHTTPError(http.StatusBadRequest, err)
+				}
+				c.Request().Body = ioutil.NopCloser(bytes.NewReader(body))
+			}
+
+			entry := newAuditEntry(c, route, verb, body)
+			if recordBodies && len(body) > 0 {
+				entry.Body = audit.RedactBody(body, entry.ObjectType == string(types.Secret))
+			}
+
+			err := next(c)
+
+			entry.Status, entry.Error = getAuditStatus(c, err)
+			entry.Outcome = audit.Success
+			if entry.Status >= http.StatusBadRequest {
+				entry.Outcome = audit.Failure
+			}
+
+			if auditErr := audit.Record(entry); auditErr != nil {
+				c.Logger().Error(auditErr)
+			}
+
+			return err
+		}
+	}
+}
+
+// getAuditedVerb returns the verb of a request if it must be audited
+func getAuditedVerb(method string, route []string) (string, bool) {
+
+	if len(route) < 3 || route[0] != "api" || route[1] != "v1" {
+		return "", false
+	}
+
+	switch route[2] {
+	case "reveal":
+		return authorization.VerbReveal, method == http.MethodGet
+	case "objects", "configuration":
+		verb, isAudited := auditedVerbs[method]
+		return verb, isAudited
+	}
+
+	return "", false
+}
+
+// newAuditEntry creates the entry of a request, before it is processed
+func newAuditEntry(c echo.Context, route []string, verb string, body []byte) audit.Entry {
+
+	entry := audit.Entry{
+		Timestamp: time.Now().UTC(),
+		Method:    c.Request().Method,
+		Path:      c.Request().URL.Path,
+		Verb:      verb,
+	}
+
+	if identity := getIdentity(c); identity != nil {
+		entry.User = identity.Name
+		entry.Groups = identity.Groups
+	}
+
+	if route[2] == "configuration" {
+		entry.ObjectType = string(authorization.Configuration)
+		entry.Name = c.Param("name")
+		if len(route) > 3 && route[3] == "contexts" {
+			entry.Context = c.Param("name")
+		}
+		return entry
+	}
+
+	entry.Context = c.Param("contextName")
+	entry.Namespace = c.Param("namespace")
+	entry.Name = c.Param("name")
+	if len(route) > 4 {
+		entry.ObjectType = string(getObjectTypeByPlural(route[4]))
+	}
+
+	// The objects created or updated are only named in the body
+	if len(entry.Name) == 0 && len(body) > 0 {
+		object := struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+		}{}
+		if err := json.Unmarshal(body, &object); err == nil {
+			entry.Name = object.Metadata.Name
+		}
+	}
+
+	return entry
+}
+
+// getObjectTypeByPlural returns the type of the objects whose plural is used in the routes
+func getObjectTypeByPlural(plural string) types.ObjectType {
+
+	for _, definitions := range [][]types.ObjectDefinition{types.ClusterObjectDefinitions, types.NamespaceObjectDefinitions} {
+		for _, definition := range definitions {
+			if definition.PluralVariable == plural {
+				return definition.Type
+			}
+		}
+	}
+
+	return ""
+}
+
+// getAuditStatus returns the status of the response and the error message, if any
+func getAuditStatus(c echo.Context, err error) (int, string) {
+
+	if err == nil {
+		return c.Response().Status, ""
+	}
+
+	if httpError, ok := err.(*echo.HTTPError); ok {
+		return httpError.Code, fmt.Sprintf("%v", httpError.Message)
+	}
+
+	return http.StatusInternalServerError, err.Error()
+}
+
+// getAudit returns the most recent entries of the audit
+// @Summary Get the audit of the modifications
+// @Description Get the most recent entries of the audit of the requests creating, updating or deleting the objects or the configuration, the most recent first
+// @ID get-audit
+// @Tags Audit
+// @Produce application/json
+// @Param user query string false "the name of the caller"
+// @Param contextName query string false "the name of the context"
+// @Param namespace query string false "the name of the namespace"
+// @Param objectType query string false "the type of the objects"
+// @Param verb query string false "the verb: create, update or delete"
+// @Param outcome query string false "the outcome: success or failure"
+// @Param since query string false "the oldest time of the entries, in RFC 3339 format"
+// @Param limit query int false "the maximum number of entries"
+// @Success 200 {array} audit.Entry
+// @Failure 400 {object} HTTPError
+// @Failure 404 {object} HTTPError
+// @Router /api/v1/audit [get]
+func getAudit(e echo.Context) error {
+
+	if auditMemorySink == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "the audit in memory is disabled by the configuration of the application")
+	}
+
+	filter := audit.Filter{
+		User:       e.QueryParam("user"),
+		Context:    e.QueryParam("contextName"),
+		Namespace:  e.QueryParam("namespace"),
+		ObjectType: e.QueryParam("objectType"),
+		Verb:       e.QueryParam("verb"),
+		Outcome:    e.QueryParam("outcome"),
+	}
+
+	if sinceParam := e.QueryParam("since"); len(sinceParam) > 0 {
+		since, err := time.Parse(time.RFC3339, sinceParam)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("the since parameter %s is not a valid RFC 3339 time", sinceParam))
+		}
+		filter.Since = since
+	}
+
+	if limitParam := e.QueryParam("limit"); len(limitParam) > 0 {
+		limit, err := strconv.Atoi(limitParam)
+		if err != nil || limit < 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("the limit parameter %s is not a valid positive integer", limitParam))
+		}
+		filter.Limit = limit
+	}
+
+	return e.JSON(http.StatusOK, auditMemorySink.GetEntries(filter))
+}
@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authentication"
+)
+
+// The key of the identity of the caller in the context of the requests
+const identityKey = "identity"
+
+// AuthenticationMiddleware rejects the requests not authenticated by one of the authenticators. The identity of the
+// caller is then available to the controllers. As the WebSocket handshake is a standard request, the WebSockets are
+// protected the same way
+func AuthenticationMiddleware(authenticators []authentication.Authenticator) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+
+			identity, err := authentication.Authenticate(authenticators, c.Request())
+			if err != nil {
+				return unauthorized(c, authenticators, err.Error())
+			}
+			if identity == nil {
+				return unauthorized(c, authenticators, "authentication required")
+			}
+
+			c.Set(identityKey, identity)
+
+			return next(c)
+		}
+	}
+}
+
+// RedactQueryTokenMiddleware hides the token given in the query of the requests from the logs. It must be placed
+// before the logger
+func RedactQueryTokenMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		authentication.RedactQueryToken(c.Request())
+		return next(c)
+	}
+}
+
+// getIdentity returns the identity of the caller, nil if the authentication is disabled
+func getIdentity(c echo.Context) *authentication.Identity {
+
+	identity, ok := c.Get(identityKey).(*authentication.Identity)
+	if !ok {
+		return nil
+	}
+
+	return identity
+}
+
+// unauthorized returns the error for the requests not authenticated, along with the methods accepted
+func unauthorized(c echo.Context, authenticators []authentication.Authenticator, message string) error {
+
+	for _, authenticator := range authenticators {
+		if challenge := authenticator.Challenge(); len(challenge) > 0 {
+			c.Response().Header().Add(echo.HeaderWWWAuthenticate, challenge)
+		}
+	}
+
+	return echo.NewHTTPError(http.StatusUnauthorized, message)
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
+	"github.com/twuillemin/kuboxy/internal/configuration"
+	"github.com/twuillemin/kuboxy/pkg/types"
+)
+
+// The policy authorizing the requests, nil if the authorization is disabled
+var authorizationPolicy *authorization.Policy
+
+// SetAuthorizationPolicy sets the policy authorizing the requests. It must be called before the controllers are
+// registered. Without policy, all the requests are allowed
+func SetAuthorizationPolicy(policy *authorization.Policy) {
+	authorizationPolicy = policy
+}
+
+// authorize returns the middleware rejecting the requests not allowed by the policy. The context and the namespace
+// are read from the path of the request, the objects at the cluster level having no namespace
+func authorize(objectType types.ObjectType, verb string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+
+			if !isAllowed(c, c.Param("contextName"), c.Param("namespace"), objectType, verb) {
+				return forbidden(objectType, verb)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// authorizeConfiguration returns the middleware rejecting the requests to the configuration not allowed by the policy.
+// When the configuration of a context is requested, the context is read from the path of the request. If the
+// application is read-only, all the modifications of the configuration are rejected
+func authorizeConfiguration(verb string, isContextRequest bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+
+			if verb != authorization.VerbGet {
+				if err := checkConfigurationWritable(); err != nil {
+					return err
+				}
+			}
+
+			contextName := ""
+			if isContextRequest {
+				contextName = c.Param("name")
+			}
+
+			if !isAllowed(c, contextName, "", authorization.Configuration, verb) {
+				return forbidden(authorization.Configuration, verb)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// checkConfigurationWritable checks that the configuration of the application allows to modify the configuration
+func checkConfigurationWritable() error {
+
+	applicationConfiguration, err := configuration.GetConfiguration()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if applicationConfiguration.ReadOnly {
+		return echo.NewHTTPError(http.StatusForbidden, "the configuration can't be modified as the application is read-only")
+	}
+
+	return nil
+}
+
+// isAllowed checks if the caller is allowed to do a request
+func isAllowed(c echo.Context, contextName string, namespace string, objectType types.ObjectType, verb string) bool {
+
+	if authorizationPolicy == nil {
+		return true
+	}
+
+	return authorizationPolicy.IsAllowed(authorization.Request{
+		Identity:   getIdentity(c),
+		Context:    contextName,
+		Namespace:  namespace,
+		ObjectType: objectType,
+		Verb:       verb,
+	})
+}
+
+// forbidden returns the error for the requests not allowed by the policy
+func forbidden(objectType types.ObjectType, verb string) error {
+	return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("not allowed to %s the %s objects", verb, objectType))
+}
@@ -2,75 +2,153 @@ package controller
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
+	"github.com/twuillemin/kuboxy/internal/configuration"
 	"github.com/twuillemin/kuboxy/pkg/context"
+	"github.com/twuillemin/kuboxy/pkg/event"
+	"gopkg.in/yaml.v2"
 )
 
+// The number of days used for finding the certificates expiring soon, when not given
+const defaultExpiringDays = 30
+
 func registerConfigurationController(e *echo.Echo) {
 
 	// Declare the routes
-	e.GET("api/v1/configuration/", getConfiguration)
+	e.GET("api/v1/configuration/", getConfiguration, authorizeConfiguration(authorization.VerbGet, false))
 
 	// The users
-	e.GET("api/v1/configuration/users/", getConfigurationUsers)
-	e.POST("/api/v1/configuration/users/:name/username-password", createConfigurationUserUserNamePassword)
-	e.PUT("/api/v1/configuration/users/:name/username-password", updateConfigurationUserUserNamePassword)
-	e.POST("/api/v1/configuration/users/:name/certificate-file", createConfigurationUserFile)
-	e.PUT("/api/v1/configuration/users/:name/certificate-file", updateConfigurationUserFile)
-	e.POST("/api/v1/configuration/users/:name/certificate-embedded", createConfigurationUserEmbedded)
-	e.PUT("/api/v1/configuration/users/:name/certificate-embedded", updateConfigurationUserEmbedded)
+	e.GET("api/v1/configuration/users/", getConfigurationUsers, authorizeConfiguration(authorization.VerbGet, false))
+	e.POST("/api/v1/configuration/users/:name/username-password", createConfigurationUserUserNamePassword, authorizeConfiguration(authorization.VerbCreate, false))
+	e.PUT("/api/v1/configuration/users/:name/username-password", updateConfigurationUserUserNamePassword, authorizeConfiguration(authorization.VerbUpdate, false))
+	e.POST("/api/v1/configuration/users/:name/certificate-file", createConfigurationUserFile, authorizeConfiguration(authorization.VerbCreate, false))
+	e.PUT("/api/v1/configuration/users/:name/certificate-file", updateConfigurationUserFile, authorizeConfiguration(authorization.VerbUpdate, false))
+	e.POST("/api/v1/configuration/users/:name/certificate-embedded", createConfigurationUserEmbedded, authorizeConfiguration(authorization.VerbCreate, false))
+	e.PUT("/api/v1/configuration/users/:name/certificate-embedded", updateConfigurationUserEmbedded, authorizeConfiguration(authorization.VerbUpdate, false))
+	e.POST("/api/v1/configuration/users/:name/token", createConfigurationUserToken, authorizeConfiguration(authorization.VerbCreate, false))
+	e.PUT("/api/v1/configuration/users/:name/token", updateConfigurationUserToken, authorizeConfiguration(authorization.VerbUpdate, false))
+	e.POST("/api/v1/configuration/users/:name/token-file", createConfigurationUserTokenFile, authorizeConfiguration(authorization.VerbCreate, false))
+	e.PUT("/api/v1/configuration/users/:name/token-file", updateConfigurationUserTokenFile, authorizeConfiguration(authorization.VerbUpdate, false))
+	e.POST("/api/v1/configuration/users/:name/exec", createConfigurationUserExec, authorizeConfiguration(authorization.VerbCreate, false))
+	e.PUT("/api/v1/configuration/users/:name/exec", updateConfigurationUserExec, authorizeConfiguration(authorization.VerbUpdate, false))
+	e.POST("/api/v1/configuration/users/:name/auth-provider", createConfigurationUserAuthProvider, authorizeConfiguration(authorization.VerbCreate, false))
+	e.PUT("/api/v1/configuration/users/:name/auth-provider", updateConfigurationUserAuthProvider, authorizeConfiguration(authorization.VerbUpdate, false))
+	e.DELETE("/api/v1/configuration/users/:name", deleteConfigurationUser, authorizeConfiguration(authorization.VerbDelete, false))
 
 	// The cluster
-	e.GET("api/v1/configuration/clusters/", getConfigurationClusters)
-	e.POST("/api/v1/configuration/clusters/:name/insecure", createConfigurationClusterInsecure)
-	e.PUT("/api/v1/configuration/clusters/:name/insecure", updateConfigurationClusterInsecure)
-	e.POST("/api/v1/configuration/clusters/:name/certificate-file", createConfigurationClusterFile)
-	e.PUT("/api/v1/configuration/clusters/:name/certificate-file", updateConfigurationClusterFile)
-	e.POST("/api/v1/configuration/clusters/:name/certificate-embedded", createConfigurationClusterEmbedded)
-	e.PUT("/api/v1/configuration/clusters/:name/certificate-embedded", updateConfigurationClusterEmbedded)
+	e.GET("api/v1/configuration/clusters/", getConfigurationClusters, authorizeConfiguration(authorization.VerbGet, false))
+	e.POST("/api/v1/configuration/clusters/:name/insecure", createConfigurationClusterInsecure, authorizeConfiguration(authorization.VerbCreate, false))
+	e.PUT("/api/v1/configuration/clusters/:name/insecure", updateConfigurationClusterInsecure, authorizeConfiguration(authorization.VerbUpdate, false))
+	e.POST("/api/v1/configuration/clusters/:name/certificate-file", createConfigurationClusterFile, authorizeConfiguration(authorization.VerbCreate, false))
+	e.PUT("/api/v1/configuration/clusters/:name/certificate-file", updateConfigurationClusterFile, authorizeConfiguration(authorization.VerbUpdate, false))
+	e.POST("/api/v1/configuration/clusters/:name/certificate-embedded", createConfigurationClusterEmbedded, authorizeConfiguration(authorization.VerbCreate, false))
+	e.PUT("/api/v1/configuration/clusters/:name/certificate-embedded", updateConfigurationClusterEmbedded, authorizeConfiguration(authorization.VerbUpdate, false))
+	e.DELETE("/api/v1/configuration/clusters/:name", deleteConfigurationCluster, authorizeConfiguration(authorization.VerbDelete, false))
 
 	// The context
-	e.GET("api/v1/configuration/contexts/", getConfigurationContexts)
-	e.POST("/api/v1/configuration/contexts/:name", createConfigurationContext)
-	e.PUT("/api/v1/configuration/contexts/:name", updateConfigurationContext)
+	e.GET("api/v1/configuration/contexts/", getConfigurationContexts, authorizeConfiguration(authorization.VerbGet, false))
+	e.POST("/api/v1/configuration/contexts/:name", createConfigurationContext, authorizeConfiguration(authorization.VerbCreate, true))
+	e.PUT("/api/v1/configuration/contexts/:name", updateConfigurationContext, authorizeConfiguration(authorization.VerbUpdate, true))
+	e.DELETE("/api/v1/configuration/contexts/:name", deleteConfigurationContext, authorizeConfiguration(authorization.VerbDelete, true))
+	e.GET("api/v1/configuration/contexts/:name/status", getConfigurationContextStatus, authorizeConfiguration(authorization.VerbGet, true))
+	e.GET("api/v1/configuration/contexts/:name/client-settings", getConfigurationContextClientSettings, authorizeConfiguration(authorization.VerbGet, true))
+	e.PUT("/api/v1/configuration/contexts/:name/client-settings", updateConfigurationContextClientSettings, authorizeConfiguration(authorization.VerbUpdate, true))
+	e.DELETE("/api/v1/configuration/contexts/:name/client-settings", deleteConfigurationContextClientSettings, authorizeConfiguration(authorization.VerbDelete, true))
+
+	// Client settings
+	e.GET("api/v1/configuration/client-settings/", getConfigurationClientSettings, authorizeConfiguration(authorization.VerbGet, false))
+
+	// The import of a whole kubeconfig
+	e.POST("/api/v1/configuration/import", importConfiguration, authorizeConfiguration(authorization.VerbCreate, false))
+
+	// The export of a self-contained kubeconfig
+	e.GET("api/v1/configuration/export", exportConfiguration, authorizeConfiguration(authorization.VerbGet, false))
+
+	// The state of the contexts
+	e.GET("api/v1/configuration/states/", getConfigurationStates, authorizeConfiguration(authorization.VerbGet, false))
+
+	// The certificates expiring soon
+	e.GET("api/v1/configuration/certificates/expiring", getConfigurationExpiringCertificates, authorizeConfiguration(authorization.VerbGet, false))
 }
 
 // getConfiguration generates a JSON representation of all the configuration
 // @Summary Retrieve the configuration
-// @Description get the configuration
+// @Description get the configuration. The credentials of the users are replaced by REDACTED unless the reveal
+// @Description parameter is set, revealing the credentials is allowed by the configuration of the application and
+// @Description the verb reveal on the configuration is explicitly granted by the authorization policy
 // @ID get-configuration
 // @Tags Configuration
 // @Produce application/json
+// @Param reveal query bool false "return the credentials in clear text"
 // @Success 200 {object} context.KubeConfig
+// @Failure 400 {object} HTTPError
+// @Failure 403 {object} HTTPError
 // @Failure 500 {object} HTTPError
 // @Router /api/v1/configuration/ [get]
 func getConfiguration(e echo.Context) error {
 
+	reveal, err := isRevealRequested(e)
+	if err != nil {
+		return err
+	}
+
 	conf, err := context.GetKubeConfig()
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
-	return e.JSON(http.StatusOK, conf)
+
+	if reveal {
+		if err = decryptUsers(conf.Users); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err)
+		}
+		return e.JSON(http.StatusOK, conf)
+	}
+	return e.JSON(http.StatusOK, redactKubeConfig(*conf))
 }
 
 // getConfigurationUsers generates a JSON representation of the users
 // @Summary Retrieve the users
-// @Description get the users
+// @Description get the users. The credentials are replaced by REDACTED unless the reveal parameter is set,
+// @Description revealing the credentials is allowed by the configuration of the application and the verb reveal on
+// @Description the configuration is explicitly granted by the authorization policy. The client certificates
+// @Description are described (subject, issuer, SANs, validity and days remaining)
 // @ID get-configuration-users
 // @Tags Configuration
 // @Produce application/json
+// @Param reveal query bool false "return the credentials in clear text"
 // @Success 200 {array} context.NamedUser
+// @Failure 400 {object} HTTPError
+// @Failure 403 {object} HTTPError
 // @Failure 500 {object} HTTPError
 // @Router /api/v1/configuration/users/ [get]
 func getConfigurationUsers(e echo.Context) error {
 
+	reveal, err := isRevealRequested(e)
+	if err != nil {
+		return err
+	}
+
 	users, err := context.GetKubeUsers()
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
-	return e.JSON(http.StatusOK, users)
+
+	for i := range users {
+		users[i].Certificates = context.InspectUserCertificates(users[i])
+	}
+
+	if reveal {
+		if err = decryptUsers(users); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err)
+		}
+		return e.JSON(http.StatusOK, users)
+	}
+	return e.JSON(http.StatusOK, redactUsers(users))
 }
 
 // createConfigurationUserUserNamePassword creates a new user in the configuration
@@ -124,7 +202,7 @@ func createConfigurationUserUserNamePassword(e echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unable to retrieve the user %s from configuration after creation", name))
 	}
 
-	return e.JSON(http.StatusOK, user)
+	return e.JSON(http.StatusOK, redactUser(*user))
 }
 
 // updateConfigurationUserUserNamePassword updates an existing user in the configuration
@@ -178,7 +256,7 @@ func updateConfigurationUserUserNamePassword(e echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unable to retrieve the user %s from configuration after update", name))
 	}
 
-	return e.JSON(http.StatusOK, user)
+	return e.JSON(http.StatusOK, redactUser(*user))
 }
 
 // createConfigurationUserFile creates a new user in the configuration with the certificate given as local files
@@ -189,13 +267,437 @@ func updateConfigurationUserUserNamePassword(e echo.Context) error {
 // @Accept json
 // @Produce application/json
 // @Param name path string true "the name of the user in the configuration"
-// @Param body body context.ParamCredentialsCertificateFile true "the credentials"
+// @Param body body context.ParamCredentialsCertificateFile true "the credentials"
+// @Success 200 {object} context.NamedUser
+// @Failure 400 {object} HTTPError
+// @Failure 409 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/users/{name}/certificate-file [post]
+func createConfigurationUserFile(e echo.Context) error {
+
+	name := e.Param("name")
+
+	// Ensure that the user does not already exist
+	user, err := context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user != nil {
+		return echo.NewHTTPError(http.StatusConflict, fmt.Errorf("the user %s already exist", name))
+	}
+
+	// Parse the credentials
+	credentials := new(context.ParamCredentialsCertificateFile)
+	if err = e.Bind(credentials); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	// Add the user
+	err = context.SetUserWithCertificateFile(name, *credentials)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	// Read the newly created object
+	user, err = context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unable to retrieve the user %s from configuration after creation", name))
+	}
+
+	return e.JSON(http.StatusOK, redactUser(*user))
+}
+
+// updateConfigurationUserFile updates an existing user in the configuration with the certificate given as local files
+// @Summary Update an existing user with the certificates given as local files
+// @Description Update an existing user by giving its name in the configuration and its certificates as local files
+// @ID put-configuration-user-file
+// @Tags Configuration
+// @Accept json
+// @Produce application/json
+// @Param name path string true "the name of the user in the configuration"
+// @Param body body context.ParamCredentialsCertificateFile true "the credentials"
+// @Success 200 {object} context.NamedUser
+// @Failure 404 {object} HTTPError
+// @Failure 409 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/users/{name}/certificate-file [put]
+func updateConfigurationUserFile(e echo.Context) error {
+
+	name := e.Param("name")
+
+	// Ensure that the user does not already exist
+	user, err := context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("the user %s does not exist", name))
+	}
+
+	// Parse the credentials
+	credentials := new(context.ParamCredentialsCertificateFile)
+	if err = e.Bind(credentials); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	// Add the user
+	err = context.SetUserWithCertificateFile(name, *credentials)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	// Read the newly created object
+	user, err = context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unable to retrieve the user %s from configuration after update", name))
+	}
+
+	return e.JSON(http.StatusOK, redactUser(*user))
+}
+
+// createConfigurationUserEmbedded creates a new user in the configuration with the certificate embedded
+// @Summary Create a new user with the certificates embedded
+// @Description Create a new user by giving its name in the configuration and its certificates embedded
+// @ID post-configuration-user-embedded
+// @Tags Configuration
+// @Accept json
+// @Produce application/json
+// @Param name path string true "the name of the user in the configuration"
+// @Param body body context.ParamCredentialsCertificateEmbedded true "the credentials"
+// @Success 200 {object} context.NamedUser
+// @Failure 400 {object} HTTPError
+// @Failure 409 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/users/{name}/certificate-embedded [post]
+func createConfigurationUserEmbedded(e echo.Context) error {
+
+	name := e.Param("name")
+
+	// Ensure that the user does not already exist
+	user, err := context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user != nil {
+		return echo.NewHTTPError(http.StatusConflict, fmt.Errorf("the user %s already exist", name))
+	}
+
+	// Parse the credentials
+	credentials := new(context.ParamCredentialsCertificateEmbedded)
+	if err = e.Bind(credentials); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	// Add the user
+	err = context.SetUserWithCertificateFileEmbedded(name, *credentials)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	// Read the newly created object
+	user, err = context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unable to retrieve the user %s from configuration after creation", name))
+	}
+
+	return e.JSON(http.StatusOK, redactUser(*user))
+}
+
+// updateConfigurationUserEmbedded updates an existing user in the configuration with the certificate embedded
+// @Summary Update an existing user with the certificates embedded
+// @Description Update an existing user by giving its name in the configuration and its certificates embedded
+// @ID put-configuration-user-embedded
+// @Tags Configuration
+// @Accept json
+// @Produce application/json
+// @Param name path string true "the name of the user in the configuration"
+// @Param body body context.ParamCredentialsCertificateEmbedded true "the credentials"
+// @Success 200 {object} context.NamedUser
+// @Failure 404 {object} HTTPError
+// @Failure 409 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/users/{name}/certificate-embedded [put]
+func updateConfigurationUserEmbedded(e echo.Context) error {
+
+	name := e.Param("name")
+
+	// Ensure that the user does not already exist
+	user, err := context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("the user %s does not exist", name))
+	}
+
+	// Parse the credentials
+	credentials := new(context.ParamCredentialsCertificateEmbedded)
+	if err = e.Bind(credentials); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	// Add the user
+	err = context.SetUserWithCertificateFileEmbedded(name, *credentials)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	// Read the newly created object
+	user, err = context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unable to retrieve the user %s from configuration after update", name))
+	}
+
+	return e.JSON(http.StatusOK, redactUser(*user))
+}
+
+// createConfigurationUserToken creates a new user in the configuration with a bearer token
+// @Summary Create a new user with a bearer token
+// @Description Create a new user by giving its name in the configuration and its bearer token
+// @ID post-configuration-user-token
+// @Tags Configuration
+// @Accept json
+// @Produce application/json
+// @Param name path string true "the name of the user in the configuration"
+// @Param body body context.ParamCredentialsToken true "the credentials"
+// @Success 200 {object} context.NamedUser
+// @Failure 400 {object} HTTPError
+// @Failure 409 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/users/{name}/token [post]
+func createConfigurationUserToken(e echo.Context) error {
+
+	name := e.Param("name")
+
+	// Ensure that the user does not already exist
+	user, err := context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user != nil {
+		return echo.NewHTTPError(http.StatusConflict, fmt.Errorf("the user %s already exist", name))
+	}
+
+	// Parse the credentials
+	credentials := new(context.ParamCredentialsToken)
+	if err = e.Bind(credentials); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	// Add the user
+	err = context.SetUserWithToken(name, *credentials)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	// Read the newly created object
+	user, err = context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unable to retrieve the user %s from configuration after creation", name))
+	}
+
+	return e.JSON(http.StatusOK, redactUser(*user))
+}
+
+// updateConfigurationUserToken updates an existing user in the configuration with a bearer token
+// @Summary Update an existing user with a bearer token
+// @Description Update an existing user by giving its name in the configuration and its bearer token
+// @ID put-configuration-user-token
+// @Tags Configuration
+// @Accept json
+// @Produce application/json
+// @Param name path string true "the name of the user in the configuration"
+// @Param body body context.ParamCredentialsToken true "the credentials"
+// @Success 200 {object} context.NamedUser
+// @Failure 404 {object} HTTPError
+// @Failure 409 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/users/{name}/token [put]
+func updateConfigurationUserToken(e echo.Context) error {
+
+	name := e.Param("name")
+
+	// Ensure that the user exists
+	user, err := context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("the user %s does not exist", name))
+	}
+
+	// Parse the credentials
+	credentials := new(context.ParamCredentialsToken)
+	if err = e.Bind(credentials); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	// Add the user
+	err = context.SetUserWithToken(name, *credentials)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	// Read the newly created object
+	user, err = context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unable to retrieve the user %s from configuration after update", name))
+	}
+
+	return e.JSON(http.StatusOK, redactUser(*user))
+}
+
+// createConfigurationUserTokenFile creates a new user in the configuration with a bearer token given as a local file
+// @Summary Create a new user with a bearer token given as a local file
+// @Description Create a new user by giving its name in the configuration and its bearer token given as a local file
+// @ID post-configuration-user-token-file
+// @Tags Configuration
+// @Accept json
+// @Produce application/json
+// @Param name path string true "the name of the user in the configuration"
+// @Param body body context.ParamCredentialsTokenFile true "the credentials"
+// @Success 200 {object} context.NamedUser
+// @Failure 400 {object} HTTPError
+// @Failure 409 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/users/{name}/token-file [post]
+func createConfigurationUserTokenFile(e echo.Context) error {
+
+	name := e.Param("name")
+
+	// Ensure that the user does not already exist
+	user, err := context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user != nil {
+		return echo.NewHTTPError(http.StatusConflict, fmt.Errorf("the user %s already exist", name))
+	}
+
+	// Parse the credentials
+	credentials := new(context.ParamCredentialsTokenFile)
+	if err = e.Bind(credentials); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	// Add the user
+	err = context.SetUserWithTokenFile(name, *credentials)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	// Read the newly created object
+	user, err = context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unable to retrieve the user %s from configuration after creation", name))
+	}
+
+	return e.JSON(http.StatusOK, redactUser(*user))
+}
+
+// updateConfigurationUserTokenFile updates an existing user in the configuration with a bearer token given as a local file
+// @Summary Update an existing user with a bearer token given as a local file
+// @Description Update an existing user by giving its name in the configuration and its bearer token given as a local file
+// @ID put-configuration-user-token-file
+// @Tags Configuration
+// @Accept json
+// @Produce application/json
+// @Param name path string true "the name of the user in the configuration"
+// @Param body body context.ParamCredentialsTokenFile true "the credentials"
+// @Success 200 {object} context.NamedUser
+// @Failure 404 {object} HTTPError
+// @Failure 409 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/users/{name}/token-file [put]
+func updateConfigurationUserTokenFile(e echo.Context) error {
+
+	name := e.Param("name")
+
+	// Ensure that the user exists
+	user, err := context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("the user %s does not exist", name))
+	}
+
+	// Parse the credentials
+	credentials := new(context.ParamCredentialsTokenFile)
+	if err = e.Bind(credentials); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	// Add the user
+	err = context.SetUserWithTokenFile(name, *credentials)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	// Read the newly created object
+	user, err = context.GetKubeUser(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if user == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unable to retrieve the user %s from configuration after update", name))
+	}
+
+	return e.JSON(http.StatusOK, redactUser(*user))
+}
+
+// createConfigurationUserExec creates a new user in the configuration with credentials provided by an external command
+// @Summary Create a new user with credentials provided by an external command
+// @Description Create a new user by giving its name in the configuration and the external command providing its credentials
+// @ID post-configuration-user-exec
+// @Tags Configuration
+// @Accept json
+// @Produce application/json
+// @Param name path string true "the name of the user in the configuration"
+// @Param body body context.ParamCredentialsExec true "the credentials"
 // @Success 200 {object} context.NamedUser
 // @Failure 400 {object} HTTPError
 // @Failure 409 {object} HTTPError
 // @Failure 500 {object} HTTPError
-// @Router /api/v1/configuration/users/{name}/certificate-file [post]
-func createConfigurationUserFile(e echo.Context) error {
+// @Router /api/v1/configuration/users/{name}/exec [post]
+func createConfigurationUserExec(e echo.Context) error {
 
 	name := e.Param("name")
 
@@ -210,13 +712,13 @@ func createConfigurationUserFile(e echo.Context) error {
 	}
 
 	// Parse the credentials
-	credentials := new(context.ParamCredentialsCertificateFile)
+	credentials := new(context.ParamCredentialsExec)
 	if err = e.Bind(credentials); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err)
 	}
 
 	// Add the user
-	err = context.SetUserWithCertificateFile(name, *credentials)
+	err = context.SetUserWithExec(name, *credentials)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -231,28 +733,28 @@ func createConfigurationUserFile(e echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unable to retrieve the user %s from configuration after creation", name))
 	}
 
-	return e.JSON(http.StatusOK, user)
+	return e.JSON(http.StatusOK, redactUser(*user))
 }
 
-// updateConfigurationUserFile updates an existing user in the configuration with the certificate given as local files
-// @Summary Update an existing user with the certificates given as local files
-// @Description Update an existing user by giving its name in the configuration and its certificates as local files
-// @ID put-configuration-user-file
+// updateConfigurationUserExec updates an existing user in the configuration with credentials provided by an external command
+// @Summary Update an existing user with credentials provided by an external command
+// @Description Update an existing user by giving its name in the configuration and the external command providing its credentials
+// @ID put-configuration-user-exec
 // @Tags Configuration
 // @Accept json
 // @Produce application/json
 // @Param name path string true "the name of the user in the configuration"
-// @Param body body context.ParamCredentialsCertificateFile true "the credentials"
+// @Param body body context.ParamCredentialsExec true "the credentials"
 // @Success 200 {object} context.NamedUser
 // @Failure 404 {object} HTTPError
 // @Failure 409 {object} HTTPError
 // @Failure 500 {object} HTTPError
-// @Router /api/v1/configuration/users/{name}/certificate-file [put]
-func updateConfigurationUserFile(e echo.Context) error {
+// @Router /api/v1/configuration/users/{name}/exec [put]
+func updateConfigurationUserExec(e echo.Context) error {
 
 	name := e.Param("name")
 
-	// Ensure that the user does not already exist
+	// Ensure that the user exists
 	user, err := context.GetKubeUser(name)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
@@ -263,13 +765,13 @@ func updateConfigurationUserFile(e echo.Context) error {
 	}
 
 	// Parse the credentials
-	credentials := new(context.ParamCredentialsCertificateFile)
+	credentials := new(context.ParamCredentialsExec)
 	if err = e.Bind(credentials); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err)
 	}
 
 	// Add the user
-	err = context.SetUserWithCertificateFile(name, *credentials)
+	err = context.SetUserWithExec(name, *credentials)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -284,24 +786,24 @@ func updateConfigurationUserFile(e echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unable to retrieve the user %s from configuration after update", name))
 	}
 
-	return e.JSON(http.StatusOK, user)
+	return e.JSON(http.StatusOK, redactUser(*user))
 }
 
-// createConfigurationUserEmbedded creates a new user in the configuration with the certificate embedded
-// @Summary Create a new user with the certificates embedded
-// @Description Create a new user by giving its name in the configuration and its certificates embedded
-// @ID post-configuration-user-embedded
+// createConfigurationUserAuthProvider creates a new user in the configuration with credentials provided by an authentication provider
+// @Summary Create a new user with credentials provided by an authentication provider
+// @Description Create a new user by giving its name in the configuration and the authentication provider (gcp, oidc, azure, ...) providing its credentials
+// @ID post-configuration-user-auth-provider
 // @Tags Configuration
 // @Accept json
 // @Produce application/json
 // @Param name path string true "the name of the user in the configuration"
-// @Param body body context.ParamCredentialsCertificateEmbedded true "the credentials"
+// @Param body body context.ParamCredentialsAuthProvider true "the credentials"
 // @Success 200 {object} context.NamedUser
 // @Failure 400 {object} HTTPError
 // @Failure 409 {object} HTTPError
 // @Failure 500 {object} HTTPError
-// @Router /api/v1/configuration/users/{name}/certificate-embedded [post]
-func createConfigurationUserEmbedded(e echo.Context) error {
+// @Router /api/v1/configuration/users/{name}/auth-provider [post]
+func createConfigurationUserAuthProvider(e echo.Context) error {
 
 	name := e.Param("name")
 
@@ -316,13 +818,13 @@ func createConfigurationUserEmbedded(e echo.Context) error {
 	}
 
 	// Parse the credentials
-	credentials := new(context.ParamCredentialsCertificateEmbedded)
+	credentials := new(context.ParamCredentialsAuthProvider)
 	if err = e.Bind(credentials); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err)
 	}
 
 	// Add the user
-	err = context.SetUserWithCertificateFileEmbedded(name, *credentials)
+	err = context.SetUserWithAuthProvider(name, *credentials)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -337,28 +839,28 @@ func createConfigurationUserEmbedded(e echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unable to retrieve the user %s from configuration after creation", name))
 	}
 
-	return e.JSON(http.StatusOK, user)
+	return e.JSON(http.StatusOK, redactUser(*user))
 }
 
-// updateConfigurationUserEmbedded updates an existing user in the configuration with the certificate embedded
-// @Summary Update an existing user with the certificates embedded
-// @Description Update an existing user by giving its name in the configuration and its certificates embedded
-// @ID put-configuration-user-embedded
+// updateConfigurationUserAuthProvider updates an existing user in the configuration with credentials provided by an authentication provider
+// @Summary Update an existing user with credentials provided by an authentication provider
+// @Description Update an existing user by giving its name in the configuration and the authentication provider (gcp, oidc, azure, ...) providing its credentials
+// @ID put-configuration-user-auth-provider
 // @Tags Configuration
 // @Accept json
 // @Produce application/json
 // @Param name path string true "the name of the user in the configuration"
-// @Param body body context.ParamCredentialsCertificateEmbedded true "the credentials"
+// @Param body body context.ParamCredentialsAuthProvider true "the credentials"
 // @Success 200 {object} context.NamedUser
 // @Failure 404 {object} HTTPError
 // @Failure 409 {object} HTTPError
 // @Failure 500 {object} HTTPError
-// @Router /api/v1/configuration/users/{name}/certificate-embedded [put]
-func updateConfigurationUserEmbedded(e echo.Context) error {
+// @Router /api/v1/configuration/users/{name}/auth-provider [put]
+func updateConfigurationUserAuthProvider(e echo.Context) error {
 
 	name := e.Param("name")
 
-	// Ensure that the user does not already exist
+	// Ensure that the user exists
 	user, err := context.GetKubeUser(name)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
@@ -369,13 +871,13 @@ func updateConfigurationUserEmbedded(e echo.Context) error {
 	}
 
 	// Parse the credentials
-	credentials := new(context.ParamCredentialsCertificateEmbedded)
+	credentials := new(context.ParamCredentialsAuthProvider)
 	if err = e.Bind(credentials); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err)
 	}
 
 	// Add the user
-	err = context.SetUserWithCertificateFileEmbedded(name, *credentials)
+	err = context.SetUserWithAuthProvider(name, *credentials)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -390,12 +892,47 @@ func updateConfigurationUserEmbedded(e echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unable to retrieve the user %s from configuration after update", name))
 	}
 
-	return e.JSON(http.StatusOK, user)
+	return e.JSON(http.StatusOK, redactUser(*user))
+}
+
+// deleteConfigurationUser deletes an existing user from the configuration
+// @Summary Delete an existing user
+// @Description Delete an existing user. If the user is still used by some contexts, the deletion is refused unless
+// @Description the cascade parameter is set, in which case the contexts are deleted as well
+// @ID delete-configuration-user
+// @Tags Configuration
+// @Param name path string true "the name of the user in the configuration"
+// @Param cascade query bool false "delete also the contexts using the user"
+// @Success 200 {array} string
+// @Failure 400 {object} HTTPError
+// @Failure 404 {object} HTTPError
+// @Failure 409 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/users/{name} [delete]
+func deleteConfigurationUser(e echo.Context) error {
+
+	name := e.Param("name")
+
+	cascade, err := getCascadeParameter(e)
+	if err != nil {
+		return err
+	}
+
+	// Delete the user
+	deletedContextNames, err := context.DeleteUser(name, cascade)
+	if err != nil {
+		return getDeletionHTTPError(err)
+	}
+
+	stopEventReceivers(deletedContextNames)
+
+	return e.JSON(http.StatusOK, deletedContextNames)
 }
 
 // getConfigurationClusters generates a JSON representation of the clusters
 // @Summary Retrieve the clusters
-// @Description get the clusters
+// @Description get the clusters. The certificate authorities are described (subject, issuer, SANs, validity and days
+// @Description remaining)
 // @ID get-configuration-clusters
 // @Tags Configuration
 // @Produce application/json
@@ -408,6 +945,11 @@ func getConfigurationClusters(e echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
+
+	for i := range clusters {
+		clusters[i].Certificates = context.InspectClusterCertificates(clusters[i])
+	}
+
 	return e.JSON(http.StatusOK, clusters)
 }
 
@@ -729,6 +1271,40 @@ func updateConfigurationClusterEmbedded(e echo.Context) error {
 	return e.JSON(http.StatusOK, cluster)
 }
 
+// deleteConfigurationCluster deletes an existing cluster from the configuration
+// @Summary Delete an existing cluster
+// @Description Delete an existing cluster. If the cluster is still used by some contexts, the deletion is refused unless
+// @Description the cascade parameter is set, in which case the contexts are deleted as well
+// @ID delete-configuration-cluster
+// @Tags Configuration
+// @Param name path string true "the name of the cluster in the configuration"
+// @Param cascade query bool false "delete also the contexts using the cluster"
+// @Success 200 {array} string
+// @Failure 400 {object} HTTPError
+// @Failure 404 {object} HTTPError
+// @Failure 409 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/clusters/{name} [delete]
+func deleteConfigurationCluster(e echo.Context) error {
+
+	name := e.Param("name")
+
+	cascade, err := getCascadeParameter(e)
+	if err != nil {
+		return err
+	}
+
+	// Delete the cluster
+	deletedContextNames, err := context.DeleteCluster(name, cascade)
+	if err != nil {
+		return getDeletionHTTPError(err)
+	}
+
+	stopEventReceivers(deletedContextNames)
+
+	return e.JSON(http.StatusOK, deletedContextNames)
+}
+
 // getConfigurationContexts generates a JSON representation of the contexts
 // @Summary Retrieve the contexts
 // @Description get the contexts
@@ -852,3 +1428,402 @@ func updateConfigurationContext(e echo.Context) error {
 
 	return e.JSON(http.StatusOK, kubeContext)
 }
+
+// deleteConfigurationContext deletes an existing context from the configuration
+// @Summary Delete an existing context
+// @Description Delete an existing context. The events received from the context are stopped
+// @ID delete-configuration-context
+// @Tags Configuration
+// @Param name path string true "the name of the context in the configuration"
+// @Failure 404 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/contexts/{name} [delete]
+func deleteConfigurationContext(e echo.Context) error {
+
+	name := e.Param("name")
+
+	// Delete the context
+	err := context.DeleteContext(name)
+	if err != nil {
+		return getDeletionHTTPError(err)
+	}
+
+	stopEventReceivers([]string{name})
+
+	return e.NoContent(http.StatusOK)
+}
+
+// importConfiguration merges a kubeconfig into the configuration
+// @Summary Import a kubeconfig
+// @Description Merge the clusters, users and contexts of a kubeconfig document (YAML or JSON) into the configuration.
+// @Description When an object already exists, it is skipped, overwritten or imported under a new name depending on
+// @Description the strategy. In dry run mode, the changes are reported but not applied
+// @ID post-configuration-import
+// @Tags Configuration
+// @Accept application/json
+// @Accept application/x-yaml
+// @Produce application/json
+// @Param body body context.KubeConfig true "the kubeconfig to import"
+// @Param strategy query string false "the conflict strategy: skip (default), overwrite or rename"
+// @Param suffix query string false "the suffix added to the name of the renamed objects (default -imported)"
+// @Param dryRun query bool false "only report the changes without applying them"
+// @Success 200 {object} context.ImportReport
+// @Failure 400 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/import [post]
+func importConfiguration(e echo.Context) error {
+
+	// Read the options
+	strategy := context.ConflictStrategy(e.QueryParam("strategy"))
+	switch strategy {
+	case "", context.Skip, context.Overwrite, context.Rename:
+	default:
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("the conflict strategy %s is not valid", strategy))
+	}
+
+	dryRun := false
+	if dryRunParam := e.QueryParam("dryRun"); len(dryRunParam) > 0 {
+		var err error
+		if dryRun, err = strconv.ParseBool(dryRunParam); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("the dryRun parameter %s is not a valid boolean", dryRunParam))
+		}
+	}
+
+	// Read the kubeconfig
+	data, err := ioutil.ReadAll(e.Request().Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	imported, err := context.ParseKubeConfig(data)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	// Merge it
+	report, err := context.MergeKubeConfig(
+		imported,
+		context.ImportOptions{
+			Strategy: strategy,
+			Suffix:   e.QueryParam("suffix"),
+			DryRun:   dryRun,
+		})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return e.JSON(http.StatusOK, report)
+}
+
+// exportConfiguration generates a self-contained kubeconfig for some contexts
+// @Summary Export a kubeconfig
+// @Description Generate a kubeconfig holding only the requested contexts, along with the clusters and the users
+// @Description they are referencing. As the credentials are exported in clear text, the export is refused if
+// @Description revealing the credentials is disabled by the configuration of the application or if the verb reveal
+// @Description on the configuration of the exported contexts is not explicitly granted by the authorization policy
+// @ID get-configuration-export
+// @Tags Configuration
+// @Produce application/x-yaml
+// @Produce application/json
+// @Param context query []string true "the name of the contexts to export"
+// @Param inline query bool false "embed the certificates given as local files, if they were referenced at startup or are in an allowed directory"
+// @Param currentContext query string false "the current context of the kubeconfig (default the first exported context)"
+// @Param format query string false "the format of the kubeconfig: yaml (default) or json"
+// @Success 200 {object} context.KubeConfig
+// @Failure 400 {object} HTTPError
+// @Failure 403 {object} HTTPError
+// @Failure 404 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/export [get]
+func exportConfiguration(e echo.Context) error {
+
+	// Read the options
+	contextNames := e.QueryParams()["context"]
+	if len(contextNames) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("at least one context must be exported"))
+	}
+
+	// The exported configuration holds the credentials in clear text
+	if err := checkRevealAllowed(e, contextNames...); err != nil {
+		return err
+	}
+
+	inline := false
+	if inlineParam := e.QueryParam("inline"); len(inlineParam) > 0 {
+		var err error
+		if inline, err = strconv.ParseBool(inlineParam); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("the inline parameter %s is not a valid boolean", inlineParam))
+		}
+	}
+
+	format := e.QueryParam("format")
+	if len(format) > 0 && format != "yaml" && format != "json" {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("the format %s is not valid", format))
+	}
+
+	// Build the configuration
+	exported, err := context.ExportKubeConfig(
+		context.ExportOptions{
+			ContextNames:       contextNames,
+			InlineCertificates: inline,
+			CurrentContext:     e.QueryParam("currentContext"),
+		})
+	if err != nil {
+		if _, ok := err.(*context.NotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		if _, ok := err.(*context.InlineNotAllowedError); ok {
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if format == "json" {
+		return e.JSON(http.StatusOK, exported)
+	}
+
+	data, err := yaml.Marshal(exported)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return e.Blob(http.StatusOK, "application/x-yaml", data)
+}
+
+// getConfigurationContextStatus probes a context
+// @Summary Probe a context
+// @Description Check whether a context actually works: dial the API server, report its version and the round-trip
+// @Description latency, check the TLS and the credentials, the availability of the metrics-server and the permissions
+// @Description of the identity in the namespace of the context
+// @ID get-configuration-context-status
+// @Tags Configuration
+// @Produce application/json
+// @Param name path string true "the name of the context in the configuration"
+// @Success 200 {object} context.ContextHealth
+// @Failure 404 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/contexts/{name}/status [get]
+func getConfigurationContextStatus(e echo.Context) error {
+
+	name := e.Param("name")
+
+	health, err := context.ProbeContext(name)
+	if err != nil {
+		if _, ok := err.(*context.NotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return e.JSON(http.StatusOK, health)
+}
+
+// getConfigurationClientSettings generates a JSON representation of the settings of the clients of all the contexts
+// @Summary Retrieve the settings of the clients
+// @Description get the settings of the clients (QPS, burst, timeout, user agent and proxy) of the contexts having some
+// @ID get-configuration-client-settings
+// @Tags Configuration
+// @Produce application/json
+// @Success 200 {array} context.NamedClientSettings
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/client-settings/ [get]
+func getConfigurationClientSettings(e echo.Context) error {
+
+	clientSettings, err := context.GetClientSettings()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+	return e.JSON(http.StatusOK, clientSettings)
+}
+
+// getConfigurationContextClientSettings generates a JSON representation of the settings of the clients of a context
+// @Summary Retrieve the settings of the clients of a context
+// @Description get the settings of the clients (QPS, burst, timeout, user agent and proxy) of a context
+// @ID get-configuration-context-client-settings
+// @Tags Configuration
+// @Produce application/json
+// @Param name path string true "the name of the context in the configuration"
+// @Success 200 {object} context.NamedClientSettings
+// @Failure 404 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/contexts/{name}/client-settings [get]
+func getConfigurationContextClientSettings(e echo.Context) error {
+
+	name := e.Param("name")
+
+	clientSettings, err := context.GetContextClientSettings(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if clientSettings == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("the context %s does not have client settings", name))
+	}
+
+	return e.JSON(http.StatusOK, clientSettings)
+}
+
+// updateConfigurationContextClientSettings creates or updates the settings of the clients of a context
+// @Summary Define the settings of the clients of a context
+// @Description Define the settings of the clients of a context: QPS, burst, request timeout (such as 30s), a suffix for
+// @Description the user agent and a proxy. Empty values keep the default behaviour. The clients of the context are
+// @Description rebuilt on next use
+// @ID put-configuration-context-client-settings
+// @Tags Configuration
+// @Accept json
+// @Produce application/json
+// @Param name path string true "the name of the context in the configuration"
+// @Param body body context.ParamClientSettings true "the settings of the clients"
+// @Success 200 {object} context.NamedClientSettings
+// @Failure 400 {object} HTTPError
+// @Failure 404 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/contexts/{name}/client-settings [put]
+func updateConfigurationContextClientSettings(e echo.Context) error {
+
+	name := e.Param("name")
+
+	// Parse the settings
+	settingsParam := new(context.ParamClientSettings)
+	if err := e.Bind(settingsParam); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	if err := context.ValidateClientSettings(*settingsParam); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Define the settings
+	err := context.SetClientSettings(name, *settingsParam)
+	if err != nil {
+		if _, ok := err.(*context.NotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	// Read the newly created object
+	clientSettings, err := context.GetContextClientSettings(name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if clientSettings == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unable to retrieve the client settings of the context %s from configuration after update", name))
+	}
+
+	return e.JSON(http.StatusOK, clientSettings)
+}
+
+// deleteConfigurationContextClientSettings deletes the settings of the clients of a context
+// @Summary Delete the settings of the clients of a context
+// @Description Delete the settings of the clients of a context, which then uses the default settings
+// @ID delete-configuration-context-client-settings
+// @Tags Configuration
+// @Param name path string true "the name of the context in the configuration"
+// @Failure 404 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/contexts/{name}/client-settings [delete]
+func deleteConfigurationContextClientSettings(e echo.Context) error {
+
+	name := e.Param("name")
+
+	err := context.DeleteClientSettings(name)
+	if err != nil {
+		return getDeletionHTTPError(err)
+	}
+
+	return e.NoContent(http.StatusOK)
+}
+
+// getCascadeParameter reads the optional cascade parameter of the deletion endpoints
+func getCascadeParameter(e echo.Context) (bool, error) {
+
+	cascadeParam := e.QueryParam("cascade")
+	if len(cascadeParam) == 0 {
+		return false, nil
+	}
+
+	cascade, err := strconv.ParseBool(cascadeParam)
+	if err != nil {
+		return false, echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("the cascade parameter %s is not a valid boolean", cascadeParam))
+	}
+
+	return cascade, nil
+}
+
+// getDeletionHTTPError converts an error raised while deleting an object of the configuration
+func getDeletionHTTPError(err error) *echo.HTTPError {
+	if _, ok := err.(*context.NotFoundError); ok {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	if _, ok := err.(*context.ObjectNotFoundError); ok {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	if _, ok := err.(*context.ReferencedError); ok {
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, err)
+}
+
+// stopEventReceivers stops receiving the events of the given contexts
+func stopEventReceivers(contextNames []string) {
+	for _, contextName := range contextNames {
+		event.StopContextReceivers(contextName)
+	}
+}
+
+// getConfigurationStates generates a JSON representation of the contexts known by the application and their state
+// @Summary Retrieve the state of the contexts
+// @Description get the contexts known by the application along with the state of their connection
+// @ID get-configuration-states
+// @Tags Configuration
+// @Produce application/json
+// @Success 200 {array} context.ContextState
+// @Router /api/v1/configuration/states/ [get]
+func getConfigurationStates(e echo.Context) error {
+	return e.JSON(http.StatusOK, context.GetContextStates())
+}
+
+// getConfigurationExpiringCertificates generates a JSON representation of the certificates expiring soon
+// @Summary Retrieve the certificates expiring soon
+// @Description get the client certificates of the users, the certificate authorities of the clusters and the
+// @Description certificate of the application that expire within the given number of days. The certificates already
+// @Description expired and the certificates that can not be read are also returned
+// @ID get-configuration-expiring-certificates
+// @Tags Configuration
+// @Produce application/json
+// @Param days query int false "the number of days, 30 by default"
+// @Success 200 {array} context.ExpiringCertificate
+// @Failure 400 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/configuration/certificates/expiring [get]
+func getConfigurationExpiringCertificates(e echo.Context) error {
+
+	days := defaultExpiringDays
+	if daysParam := e.QueryParam("days"); len(daysParam) > 0 {
+		value, err := strconv.Atoi(daysParam)
+		if err != nil || value < 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("the days parameter %s is not a valid number of days", daysParam))
+		}
+		days = value
+	}
+
+	certificates, err := context.GetExpiringCertificates(days)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	// Also check the certificate used by the application for HTTPS
+	applicationConfiguration, err := configuration.GetConfiguration()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if len(applicationConfiguration.CertificateFileName) > 0 {
+		applicationCertificates := context.InspectCertificateFile("certificate-file", applicationConfiguration.CertificateFileName)
+		certificates = append(certificates, context.SelectExpiringCertificates(context.CertificateOwnerApplication, "kuboxy", applicationCertificates, days)...)
+	}
+
+	return e.JSON(http.StatusOK, certificates)
+}
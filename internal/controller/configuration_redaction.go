@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
+	"github.com/twuillemin/kuboxy/internal/configuration"
+	"github.com/twuillemin/kuboxy/pkg/context"
+)
+
+// RedactedValue is the value replacing the credentials in the responses of the configuration endpoints
+const RedactedValue = "REDACTED"
+
+// isRevealRequested checks if the caller asked to receive the credentials in clear text with the reveal parameter.
+// If revealing the credentials is disabled in the configuration of the application or not granted to the caller, an
+// error is returned
+func isRevealRequested(e echo.Context) (bool, error) {
+
+	revealParam := e.QueryParam("reveal")
+	if len(revealParam) == 0 {
+		return false, nil
+	}
+
+	reveal, err := strconv.ParseBool(revealParam)
+	if err != nil {
+		return false, echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("the reveal parameter %s is not a valid boolean", revealParam))
+	}
+
+	if reveal {
+		if err = checkRevealAllowed(e); err != nil {
+			return false, err
+		}
+	}
+
+	return reveal, nil
+}
+
+// checkRevealAllowed checks that the caller can receive the credentials in clear text. Revealing the credentials
+// must be allowed by the configuration of the application and explicitly granted by the authorization policy with the
+// verb reveal on the configuration, for each of the given contexts or, if none is given, for the whole configuration
+func checkRevealAllowed(e echo.Context, contextNames ...string) error {
+
+	applicationConfiguration, err := configuration.GetConfiguration()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	if applicationConfiguration.DisableCredentialsReveal {
+		return echo.NewHTTPError(http.StatusForbidden, "revealing the credentials is disabled by the configuration of the application")
+	}
+
+	if authorizationPolicy == nil {
+		return echo.NewHTTPError(http.StatusForbidden, "revealing the credentials requires an authorization policy granting the reveal verb")
+	}
+
+	if len(contextNames) == 0 {
+		contextNames = []string{""}
+	}
+
+	for _, contextName := range contextNames {
+		if !isAllowed(e, contextName, "", authorization.Configuration, authorization.VerbReveal) {
+			return forbidden(authorization.Configuration, authorization.VerbReveal)
+		}
+	}
+
+	return nil
+}
+
+// decryptUsers decrypts in place the credentials of the users, when they are encrypted in the configuration
+func decryptUsers(users []context.NamedUser) error {
+	for i := range users {
+		if err := context.DecryptUserCredentials(&users[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// redactKubeConfig returns a copy of the configuration in which the credentials of all users are masked
+func redactKubeConfig(config context.KubeConfig) context.KubeConfig {
+
+	users := make([]context.NamedUser, 0, len(config.Users))
+	for _, user := range config.Users {
+		users = append(users, redactUser(user))
+	}
+	config.Users = users
+
+	return config
+}
+
+// redactUsers returns a copy of the users in which the credentials are masked
+func redactUsers(users []context.NamedUser) []context.NamedUser {
+
+	result := make([]context.NamedUser, 0, len(users))
+	for _, user := range users {
+		result = append(result, redactUser(user))
+	}
+
+	return result
+}
+
+// redactUser returns a copy of the user in which the credentials are masked. The references to local files are
+// kept as they are not secret by themselves
+func redactUser(user context.NamedUser) context.NamedUser {
+
+	definition := &user.DefinitionUser
+
+	definition.Password = redactString(definition.Password)
+	definition.ClientCertificateData = redactString(definition.ClientCertificateData)
+	definition.ClientKeyData = redactString(definition.ClientKeyData)
+	definition.Token = redactString(definition.Token)
+
+	if definition.Exec != nil {
+		exec := *definition.Exec
+		exec.Env = make([]context.ExecEnvVar, 0, len(definition.Exec.Env))
+		for _, env := range definition.Exec.Env {
+			env.Value = redactString(env.Value)
+			exec.Env = append(exec.Env, env)
+		}
+		definition.Exec = &exec
+	}
+
+	if definition.AuthProvider != nil {
+		authProvider := *definition.AuthProvider
+		authProvider.Config = make(map[string]string, len(definition.AuthProvider.Config))
+		for key, value := range definition.AuthProvider.Config {
+			if context.IsSecretAuthProviderKey(key) {
+				value = redactString(value)
+			}
+			authProvider.Config[key] = value
+		}
+		definition.AuthProvider = &authProvider
+	}
+
+	return user
+}
+
+// redactString masks a non empty value
+func redactString(value string) string {
+	if len(value) == 0 {
+		return value
+	}
+	return RedactedValue
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/twuillemin/kuboxy/pkg/context"
+)
+
+func TestRedactUser(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		user     context.DefinitionUser
+		expected context.DefinitionUser
+	}{
+		{
+			name:     "password",
+			user:     context.DefinitionUser{UserName: "admin", Password: "secret"},
+			expected: context.DefinitionUser{UserName: "admin", Password: RedactedValue},
+		},
+		{
+			name:     "token",
+			user:     context.DefinitionUser{Token: "token", TokenFile: "/var/run/token"},
+			expected: context.DefinitionUser{Token: RedactedValue, TokenFile: "/var/run/token"},
+		},
+		{
+			name:     "embedded certificate",
+			user:     context.DefinitionUser{ClientCertificateData: "certificate", ClientKeyData: "key"},
+			expected: context.DefinitionUser{ClientCertificateData: RedactedValue, ClientKeyData: RedactedValue},
+		},
+		{
+			name:     "certificate files",
+			user:     context.DefinitionUser{ClientCertificate: "/etc/user.crt", ClientKey: "/etc/user.key"},
+			expected: context.DefinitionUser{ClientCertificate: "/etc/user.crt", ClientKey: "/etc/user.key"},
+		},
+		{
+			name: "exec",
+			user: context.DefinitionUser{Exec: &context.ExecConfig{
+				Command: "aws",
+				Env:     []context.ExecEnvVar{{Name: "AWS_PROFILE", Value: "production"}, {Name: "EMPTY"}},
+			}},
+			expected: context.DefinitionUser{Exec: &context.ExecConfig{
+				Command: "aws",
+				Env:     []context.ExecEnvVar{{Name: "AWS_PROFILE", Value: RedactedValue}, {Name: "EMPTY"}},
+			}},
+		},
+		{
+			name: "auth-provider",
+			user: context.DefinitionUser{AuthProvider: &context.AuthProviderConfig{
+				Name:   "oidc",
+				Config: map[string]string{"client-id": "kuboxy", "client-secret": "secret", "refresh-token": "token"},
+			}},
+			expected: context.DefinitionUser{AuthProvider: &context.AuthProviderConfig{
+				Name:   "oidc",
+				Config: map[string]string{"client-id": "kuboxy", "client-secret": RedactedValue, "refresh-token": RedactedValue},
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			original := context.NamedUser{Name: "user", DefinitionUser: test.user}
+			redacted := redactUser(original)
+
+			if !reflect.DeepEqual(redacted.DefinitionUser, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, redacted.DefinitionUser)
+			}
+			if !reflect.DeepEqual(original.DefinitionUser, test.user) {
+				t.Errorf("the original user was modified: %+v", original.DefinitionUser)
+			}
+		})
+	}
+}
+
+func TestRedactKubeConfig(t *testing.T) {
+
+	config := context.KubeConfig{
+		Clusters: []context.NamedCluster{{Name: "cluster", DefinitionCluster: context.DefinitionCluster{CertificateAuthorityData: "ca"}}},
+		Users: []context.NamedUser{
+			{Name: "admin", DefinitionUser: context.DefinitionUser{Token: "admin-token"}},
+			{Name: "viewer", DefinitionUser: context.DefinitionUser{Password: "viewer-password"}},
+		},
+	}
+
+	redacted := redactKubeConfig(config)
+
+	if redacted.Users[0].DefinitionUser.Token != RedactedValue || redacted.Users[1].DefinitionUser.Password != RedactedValue {
+		t.Errorf("the users are not redacted: %+v", redacted.Users)
+	}
+	if redacted.Clusters[0].DefinitionCluster.CertificateAuthorityData != "ca" {
+		t.Error("the certificate authorities of the clusters must be kept")
+	}
+	if config.Users[0].DefinitionUser.Token != "admin-token" {
+		t.Error("the original configuration was modified")
+	}
+}
@@ -2,7 +2,7 @@ package controller
 
 import (
 	"fmt"
-	"github.com/labstack/echo"
+	"github.com/labstack/echo/v4"
 	"github.com/twuillemin/kuboxy/pkg/context"
 	"net/http"
 )
@@ -11,5 +11,8 @@ func getHTTPError(err error) *echo.HTTPError {
 	if e, ok := err.(*context.NotFoundError); ok {
 		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("the context \"%s\" does not exist", e.ContextName()))
 	}
+	if e, ok := err.(*context.ReadOnlyError); ok {
+		return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("the context \"%s\" is read-only", e.ContextName()))
+	}
 	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 }
@@ -3,9 +3,14 @@ package controller
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"sync"
+
 	"golang.org/x/net/websocket"
 
 	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
+	"github.com/twuillemin/kuboxy/pkg/event"
 	"github.com/twuillemin/kuboxy/pkg/types"
 )
 
@@ -42,19 +47,92 @@ type forwarderInformation struct {
 	stopForwarderChannel chan struct{}
 }
 
+// The websockets currently opened, so that they can be closed when the application stops
+var eventWebSockets = struct {
+	lock     sync.Mutex
+	closed   bool
+	closing  chan struct{}
+	handlers sync.WaitGroup
+}{
+	closing: make(chan struct{}),
+}
+
+// CloseEventWebSockets closes all the websockets, sending a close frame to their clients, and refuses the new ones.
+// It waits until all the websockets are closed or until the deadline is signaled. If the deadline was reached, false
+// is returned
+func CloseEventWebSockets(deadline <-chan struct{}) bool {
+
+	eventWebSockets.lock.Lock()
+	if !eventWebSockets.closed {
+		eventWebSockets.closed = true
+		close(eventWebSockets.closing)
+	}
+	eventWebSockets.lock.Unlock()
+
+	handlersDone := make(chan struct{})
+	go func() {
+		eventWebSockets.handlers.Wait()
+		close(handlersDone)
+	}()
+
+	select {
+	case <-handlersDone:
+		return true
+	case <-deadline:
+		return false
+	}
+}
+
+// startEventWebSocket registers a new websocket, unless the application is stopping
+func startEventWebSocket() bool {
+
+	eventWebSockets.lock.Lock()
+	defer eventWebSockets.lock.Unlock()
+
+	if eventWebSockets.closed {
+		return false
+	}
+
+	eventWebSockets.handlers.Add(1)
+	return true
+}
+
+// isClosingEventWebSockets checks if the websockets are being closed
+func isClosingEventWebSockets() bool {
+
+	eventWebSockets.lock.Lock()
+	defer eventWebSockets.lock.Unlock()
+
+	return eventWebSockets.closed
+}
+
 // getEventsByWebSocket create the persistent websocket between a client and the server
-// @Summary Connect to a WebSocket for managing events (port 8081)
+// @Summary Connect to a WebSocket for managing events (port 8081, or along the REST services in single port mode)
 // @Description the websocket used for receiving the configuration and then return the requested events. Each event is a full object when created / updated / deleted
+// @Description A ConfigurationEvent listing the added, removed and changed contexts is also sent when the contexts configuration file is modified
 // @ID get-events-by-websocket
 // @Tags Events
 // @Produce text/plain
 // @Param contextName path string true "the name of the configuration"
 // @Success 200 {string} string
+// @Failure 401 {object} HTTPError
 // @Failure 404 {object} HTTPError
+// @Failure 503 {object} HTTPError
 // @Router /api/v1/events/ [get]
 func getEventsByWebSocket(c echo.Context) (err error) {
+
+	if !startEventWebSocket() {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "the application is stopping")
+	}
+	defer eventWebSockets.handlers.Done()
+
+	if identity := getIdentity(c); identity != nil {
+		c.Logger().Infof("WebSocket for events opened by %s (%s)", identity.Name, identity.Method)
+	}
+
 	websocket.Handler(func(ws *websocket.Conn) {
 
+		// Closing the websocket sends a close frame to the client
 		defer func() {
 			err = ws.Close()
 		}()
@@ -63,9 +141,12 @@ func getEventsByWebSocket(c echo.Context) (err error) {
 
 		var stopFlag struct{}
 		sendMessageChannel := make(chan interface{})
-		stopSendingChannel := make(chan struct{})
+		stopSendingChannel := make(chan struct{}, 1)
 		stopHandlerChannel := make(chan struct{})
 
+		// Forward the modifications of the configuration to the client
+		stopConfigurationForwarderChannel := addConfigurationEventForwarder(sendMessageChannel)
+
 		// Start a goroutine for receiving client
 		go func() {
 			// Wait client message indefinitely
@@ -75,7 +156,8 @@ func getEventsByWebSocket(c echo.Context) (err error) {
 				if err == nil {
 					forwarders = processCommand(c, msg, forwarders, sendMessageChannel)
 				} else {
-					if err.Error() != "EOF" {
+					// The websockets closed when the application stops are not an error
+					if err.Error() != "EOF" && !isClosingEventWebSockets() {
 						c.Logger().Error(err)
 					}
 					stopSendingChannel <- stopFlag
@@ -92,7 +174,7 @@ func getEventsByWebSocket(c echo.Context) (err error) {
 				// Block until we receive from one of following events
 				select {
 				case message := <-sendMessageChannel:
-					jsonMessage, _ := json.Marshal(message)
+					jsonMessage, _ := json.Marshal(maskObject(message))
 					err = websocket.Message.Send(ws, string(jsonMessage))
 					if err != nil {
 						if err.Error() != "EOF" {
@@ -102,6 +184,8 @@ func getEventsByWebSocket(c echo.Context) (err error) {
 					}
 				case <-stopSendingChannel:
 					break sendLoop
+				case <-eventWebSockets.closing:
+					break sendLoop
 				}
 			}
 
@@ -113,12 +197,54 @@ func getEventsByWebSocket(c echo.Context) (err error) {
 
 		// Stop all forwarders if any remaining
 		forwarders = stopAllForwarders(forwarders)
+		stopConfigurationForwarderChannel <- stopFlag
 
 	}).ServeHTTP(c.Response(), c.Request())
 
 	return nil
 }
 
+// addConfigurationEventForwarder forwards the modifications of the configuration to the sending queue until the
+// returned channel is signaled
+func addConfigurationEventForwarder(sendChannel chan interface{}) chan struct{} {
+
+	// Create a channel for killing the Forwarder
+	stopForwarderChannel := make(chan struct{})
+
+	// Create a channel so that the Forwarder can receive the event
+	receiveEventChannel := make(chan event.ConfigurationEvent)
+
+	// link the Forwarder to the event producer
+	event.AddConfigurationEventClient(receiveEventChannel)
+
+	// Start forwarding
+	go func() {
+		for {
+			select {
+
+			case eventReceived := <-receiveEventChannel:
+
+				// Forward the message to the sending queue
+				sendChannel <- eventReceived
+
+			case <-stopForwarderChannel:
+
+				// Stop listening
+				event.RemoveConfigurationEventClient(receiveEventChannel)
+
+				// Close the channels
+				close(receiveEventChannel)
+				close(stopForwarderChannel)
+
+				// Quit the forwarding loop
+				return
+			}
+		}
+	}()
+
+	return stopForwarderChannel
+}
+
 // Process a command
 func processCommand(c echo.Context, message string, forwarders []*forwarderInformation, sendMessageChannel chan interface{}) []*forwarderInformation {
 
@@ -140,6 +266,20 @@ func processCommand(c echo.Context, message string, forwarders []*forwarderInfor
 	switch commandReceived.Command {
 
 	case AddSource:
+		// Ensure the caller is allowed to watch the source. A source without namespace is only allowed if the caller
+		// is allowed to watch the objects outside of a namespace
+		if !isAllowed(c, source.ContextName, source.NamespaceName, source.ObjectType, authorization.VerbWatch) {
+			c.Logger().Warn(fmt.Sprintf("unable to add the source %v as the caller is not allowed to watch it", source))
+			break
+		}
+
+		// The events are received on behalf of Kuboxy, so they would bypass the RBAC of the clusters that applies to
+		// the impersonated callers
+		if getImpersonation(c) != nil {
+			c.Logger().Warn(fmt.Sprintf("unable to add the source %v as the events can not be received on behalf of an impersonated caller", source))
+			break
+		}
+
 		// Ensure previous provider does not exist
 		var idxForwarder = getExistingProviderIndex(forwarders, source)
 		if idxForwarder == -1 {
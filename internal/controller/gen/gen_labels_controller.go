@@ -60,13 +60,14 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
 	"github.com/twuillemin/kuboxy/pkg/provider"
 )
 
 func registerLabelsController(e *echo.Echo) {
 
 	// Declare the routes
-	e.GET("api/v1/labels/:contextName/:namespace", getLabels)
+	e.GET("api/v1/labels/:contextName/:namespace", getLabels, authorize(authorization.Labels, authorization.VerbList))
 }
 
 // getLabels generates a JSON representation of all the labels and their values in the given configuration
@@ -85,12 +86,13 @@ func getLabels(e echo.Context) error {
 
 	queryContextName := e.Param("contextName")
 	queryNamespace := e.Param("namespace")
+	impersonation := getImpersonation(e)
 
 	labels := make(map[string]map[string]bool)
 
 {{ range .ObjectTypes.ClusterEntities }}
 	// Get the state of the cluster
-	{{ .PluralVariable }}, err := provider.Get{{ .Plural }}(queryContextName)
+	{{ .PluralVariable }}, err := provider.Get{{ .Plural }}(queryContextName, impersonation)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -109,7 +111,7 @@ func getLabels(e echo.Context) error {
 
 {{ range .ObjectTypes.NamespaceEntities }}
 	// Get the state of the cluster
-	{{ .PluralVariable }}, err := provider.Get{{ .Plural }}(queryContextName, queryNamespace)
+	{{ .PluralVariable }}, err := provider.Get{{ .Plural }}(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -50,7 +50,9 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
 	"github.com/twuillemin/kuboxy/pkg/provider"
+	"github.com/twuillemin/kuboxy/pkg/types"
 
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -60,11 +62,11 @@ import (
 func registerObjectClusterControllers(e *echo.Echo) {
 {{ range .ObjectDefinitions }}
 	// {{ .Plural }}
-	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}", getObject{{ .Plural }})
-	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}/:name", getObject{{ .Name }})
-	e.POST("api/v1/objects/:contextName/{{ .PluralVariable }}", createObject{{ .Name }})
-	e.PUT("api/v1/objects/:contextName/{{ .PluralVariable }}", updateObject{{ .Name }})
-	e.DELETE("api/v1/objects/:contextName/{{ .PluralVariable }}/:name", deleteObject{{ .Name }})
+	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}", getObject{{ .Plural }}, authorize(types.{{ .Type }}, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}/:name", getObject{{ .Name }}, authorize(types.{{ .Type }}, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/{{ .PluralVariable }}", createObject{{ .Name }}, authorize(types.{{ .Type }}, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/{{ .PluralVariable }}", updateObject{{ .Name }}, authorize(types.{{ .Type }}, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/{{ .PluralVariable }}/:name", deleteObject{{ .Name }}, authorize(types.{{ .Type }}, authorization.VerbDelete))
 {{ end }}
 }
 
@@ -85,7 +87,7 @@ func getObject{{ .Plural }}(e echo.Context) error {
 	contextName := e.Param("contextName")
 
 	// Get the state of the cluster
-	{{ .PluralVariable }}, err := provider.Get{{ .Plural }}(contextName)
+	{{ .PluralVariable }}, err := provider.Get{{ .Plural }}(contextName, getImpersonation(e))
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -111,7 +113,7 @@ func getObject{{ .Name }}(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	{{ .Variable }}, err := provider.Get{{ .Name }}(contextName, name)
+	{{ .Variable }}, err := provider.Get{{ .Name }}(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -144,7 +146,7 @@ func createObject{{ .Name }}(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.Create{{ .Name }}(contextName, {{ .Variable }})
+	saved, err := provider.Create{{ .Name }}(contextName, getImpersonation(e), {{ .Variable }})
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -177,7 +179,7 @@ func updateObject{{ .Name }}(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.Update{{ .Name }}(contextName, {{ .Variable }})
+	saved, err := provider.Update{{ .Name }}(contextName, getImpersonation(e), {{ .Variable }})
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -201,7 +203,7 @@ func deleteObject{{ .Name }}(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.Delete{{ .Name }}(contextName, name)
+	err := provider.Delete{{ .Name }}(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
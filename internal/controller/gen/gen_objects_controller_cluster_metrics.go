@@ -50,14 +50,16 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
 	"github.com/twuillemin/kuboxy/pkg/provider"
+	"github.com/twuillemin/kuboxy/pkg/types"
 )
 
 func registerObjectClusterMetricsControllers(e *echo.Echo) {
 {{ range .ObjectDefinitions }}
 	// {{ .Plural }}
-	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}", getObject{{ .Plural }})
-	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}/:name", getObject{{ .Name }})
+	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}", getObject{{ .Plural }}, authorize(types.{{ .Type }}, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}/:name", getObject{{ .Name }}, authorize(types.{{ .Type }}, authorization.VerbGet))
 {{ end }}
 }
 
@@ -78,7 +80,7 @@ func getObject{{ .Plural }}(e echo.Context) error {
 	contextName := e.Param("contextName")
 
 	// Get the state of the cluster
-	{{ .PluralVariable }}, err := provider.Get{{ .Plural }}(contextName)
+	{{ .PluralVariable }}, err := provider.Get{{ .Plural }}(contextName, getImpersonation(e))
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -104,7 +106,7 @@ func getObject{{ .Name }}(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	{{ .Variable }}, err := provider.Get{{ .Name }}(contextName, name)
+	{{ .Variable }}, err := provider.Get{{ .Name }}(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
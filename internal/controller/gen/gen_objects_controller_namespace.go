@@ -51,7 +51,9 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
 	"github.com/twuillemin/kuboxy/pkg/provider"
+	"github.com/twuillemin/kuboxy/pkg/types"
 
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -64,11 +66,11 @@ import (
 func registerObjectNamespaceControllers(e *echo.Echo) {
 {{ range .ObjectDefinitions }}
 	// {{ .Plural }}
-	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}/:namespace", getObject{{ .Plural }})
-	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}/:namespace/:name", getObject{{ .Name }})
-	e.POST("api/v1/objects/:contextName/{{ .PluralVariable }}/:namespace", createObject{{ .Name }})
-	e.PUT("api/v1/objects/:contextName/{{ .PluralVariable }}/:namespace", updateObject{{ .Name }})
-	e.DELETE("api/v1/objects/:contextName/{{ .PluralVariable }}/:namespace/:name", deleteObject{{ .Name }})
+	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}/:namespace", getObject{{ .Plural }}, authorize(types.{{ .Type }}, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}/:namespace/:name", getObject{{ .Name }}, authorize(types.{{ .Type }}, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/{{ .PluralVariable }}/:namespace", createObject{{ .Name }}, authorize(types.{{ .Type }}, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/{{ .PluralVariable }}/:namespace", updateObject{{ .Name }}, authorize(types.{{ .Type }}, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/{{ .PluralVariable }}/:namespace/:name", deleteObject{{ .Name }}, authorize(types.{{ .Type }}, authorization.VerbDelete))
 {{ end }}
 }
 
@@ -81,7 +83,7 @@ func registerObjectNamespaceControllers(e *echo.Echo) {
 // @Produce application/json
 // @Param contextName path string true "the name of the context"
 // @Param namespace path string true "the name of the namespace"
-// @Success 200 {array} {{ .Name }}
+// @Success 200 {array} {{ template "response" . }}
 // @Failure 404 {object} HTTPError
 // @Failure 500 {object} HTTPError
 // @Router /api/v1/objects/{contextName}/{{ .PluralVariable }}/{namespace} [get]
@@ -91,12 +93,12 @@ func getObject{{ .Plural }}(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	{{ .PluralVariable }}, err := provider.Get{{ .Plural }}(contextName, namespace)
+	{{ .PluralVariable }}, err := provider.Get{{ .Plural }}(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, {{ .PluralVariable }})
+	return e.JSON(http.StatusOK, maskObject({{ .PluralVariable }}))
 }
 
 // getObject{{ .Name }} returns a JSON representation of a {{ .Variable }}
@@ -108,7 +110,7 @@ func getObject{{ .Plural }}(e echo.Context) error {
 // @Param contextName path string true "the name of the context"
 // @Param namespace path string true "the name of the namespace"
 // @Param name path string true "the name of the object"
-// @Success 200 {object} {{ .Name }}
+// @Success 200 {object} {{ template "response" . }}
 // @Failure 404 {object} HTTPError
 // @Failure 500 {object} HTTPError
 // @Router /api/v1/objects/{contextName}/{{ .PluralVariable }}/{namespace}/{name} [get]
@@ -119,12 +121,12 @@ func getObject{{ .Name }}(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	{{ .Variable }}, err := provider.Get{{ .Name }}(contextName, namespace, name)
+	{{ .Variable }}, err := provider.Get{{ .Name }}(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, {{ .Variable }})
+	return e.JSON(http.StatusOK, maskObject({{ .Variable }}))
 }
 
 // createObject{{ .Name }} creates a new {{ .Variable }} with the given object
@@ -137,7 +139,7 @@ func getObject{{ .Name }}(e echo.Context) error {
 // @Param contextName path string true "the name of the context"
 // @Param namespace path string true "the name of the namespace"
 // @Param body body {{ .Name }} true "the definition of the {{ .Variable }}"
-// @Success 200 {object} {{ .Name }}
+// @Success 200 {object} {{ template "response" . }}
 // @Failure 400 {object} HTTPError
 // @Failure 404 {object} HTTPError
 // @Failure 500 {object} HTTPError
@@ -158,12 +160,12 @@ func createObject{{ .Name }}(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.Create{{ .Name }}(contextName, namespace, {{ .Variable }})
+	saved, err := provider.Create{{ .Name }}(contextName, getImpersonation(e), namespace, {{ .Variable }})
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObject{{ .Name }} updates a {{ .Variable }} with the given object
@@ -176,7 +178,7 @@ func createObject{{ .Name }}(e echo.Context) error {
 // @Param contextName path string true "the name of the context"
 // @Param namespace path string true "the name of the namespace"
 // @Param body body {{ .Name }} true "the definition of the {{ .Variable }}"
-// @Success 200 {object} {{ .Name }}
+// @Success 200 {object} {{ template "response" . }}
 // @Failure 400 {object} HTTPError
 // @Failure 404 {object} HTTPError
 // @Failure 500 {object} HTTPError
@@ -197,12 +199,12 @@ func updateObject{{ .Name }}(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.Update{{ .Name }}(contextName, namespace, {{ .Variable }})
+	saved, err := provider.Update{{ .Name }}(contextName, getImpersonation(e), namespace, {{ .Variable }})
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObject{{ .Name }} deletes a {{ .Variable }}
@@ -223,11 +225,12 @@ func deleteObject{{ .Name }}(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.Delete{{ .Name }}(contextName, namespace, name)
+	err := provider.Delete{{ .Name }}(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
 	return e.NoContent(http.StatusOK)
 }
-{{ end }}`))
+{{ end }}
+{{- define "response" }}{{ if eq .Name "Secret" }}MaskedSecret{{ else if eq .Name "ConfigMap" }}MaskedConfigMap{{ else }}{{ .Name }}{{ end }}{{ end }}`))
@@ -50,14 +50,16 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
 	"github.com/twuillemin/kuboxy/pkg/provider"
+	"github.com/twuillemin/kuboxy/pkg/types"
 )
 
 func registerObjectNamespaceMetricsControllers(e *echo.Echo) {
 {{ range .ObjectDefinitions }}
 	// {{ .Plural }}
-	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}/:namespace", getObject{{ .Plural }})
-	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}/:namespace/:name", getObject{{ .Name }})
+	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}/:namespace", getObject{{ .Plural }}, authorize(types.{{ .Type }}, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/{{ .PluralVariable }}/:namespace/:name", getObject{{ .Name }}, authorize(types.{{ .Type }}, authorization.VerbGet))
 {{ end }}
 }
 
@@ -80,7 +82,7 @@ func getObject{{ .Plural }}(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	{{ .PluralVariable }}, err := provider.Get{{ .Plural }}(contextName, namespace)
+	{{ .PluralVariable }}, err := provider.Get{{ .Plural }}(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -108,7 +110,7 @@ func getObject{{ .Name }}(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	{{ .Variable }}, err := provider.Get{{ .Name }}(contextName, namespace, name)
+	{{ .Variable }}, err := provider.Get{{ .Name }}(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -0,0 +1,27 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/configuration"
+	"github.com/twuillemin/kuboxy/pkg/context"
+)
+
+// getImpersonation returns the identity on behalf of which the clusters are queried, nil if the callers are not
+// impersonated or if the caller is not authenticated
+func getImpersonation(c echo.Context) *context.Impersonation {
+
+	applicationConfiguration, err := configuration.GetConfiguration()
+	if err != nil || !applicationConfiguration.ImpersonateCallers {
+		return nil
+	}
+
+	identity := getIdentity(c)
+	if identity == nil {
+		return nil
+	}
+
+	return &context.Impersonation{
+		UserName: identity.Name,
+		Groups:   identity.Groups,
+	}
+}
@@ -29,6 +29,12 @@ import (
 // @host localhost:8080
 // @BasePath /api/v1
 
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name Authorization
+
+// @securityDefinitions.basic BasicAuth
+
 // RegisterControllers registers all the controller of the application within the given configuration
 func RegisterControllers(e *echo.Echo) {
 
@@ -53,9 +59,18 @@ func RegisterControllers(e *echo.Echo) {
 	registerLabelsController(e)
 	registerSummaryControllers(e)
 	registerSearchControllers(e)
+	registerAuditController(e)
+	registerRevealControllers(e)
 }
 
 // RegisterEventWebSocketController register the controller for the websocket dedicated to events
 func RegisterEventWebSocketController(ews *echo.Echo) {
 	ews.GET("events", getEventsByWebSocket)
 }
+
+// RegisterSinglePortControllers registers all the controllers of the application and the websocket dedicated to
+// events, so that everything is served by a single server. The websocket is then available on /api/v1/events
+func RegisterSinglePortControllers(e *echo.Echo) {
+	RegisterControllers(e)
+	e.GET("/api/v1/events", getEventsByWebSocket)
+}
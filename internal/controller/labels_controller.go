@@ -2,20 +2,21 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 //
-// This file was generated by gen_labels_controller.go at 2019-06-17 22:10:08.761157621 +0300 EEST m=+0.000916439
+// This file was generated by gen_labels_controller.go at 2026-10-18 06:02:36.795531951 +0000 UTC m=+0.000921952
 package controller
 
 import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
 	"github.com/twuillemin/kuboxy/pkg/provider"
 )
 
 func registerLabelsController(e *echo.Echo) {
 
 	// Declare the routes
-	e.GET("api/v1/labels/:contextName/:namespace", getLabels)
+	e.GET("api/v1/labels/:contextName/:namespace", getLabels, authorize(authorization.Labels, authorization.VerbList))
 }
 
 // getLabels generates a JSON representation of all the labels and their values in the given configuration
@@ -34,11 +35,12 @@ func getLabels(e echo.Context) error {
 
 	queryContextName := e.Param("contextName")
 	queryNamespace := e.Param("namespace")
+	impersonation := getImpersonation(e)
 
 	labels := make(map[string]map[string]bool)
 
 	// Get the state of the cluster
-	namespaces, err := provider.GetNamespaces(queryContextName)
+	namespaces, err := provider.GetNamespaces(queryContextName, impersonation)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -55,7 +57,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	nodes, err := provider.GetNodes(queryContextName)
+	nodes, err := provider.GetNodes(queryContextName, impersonation)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -72,7 +74,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	persistentVolumes, err := provider.GetPersistentVolumes(queryContextName)
+	persistentVolumes, err := provider.GetPersistentVolumes(queryContextName, impersonation)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -89,7 +91,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	clusterRoles, err := provider.GetClusterRoles(queryContextName)
+	clusterRoles, err := provider.GetClusterRoles(queryContextName, impersonation)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -106,7 +108,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	clusterRoleBindings, err := provider.GetClusterRoleBindings(queryContextName)
+	clusterRoleBindings, err := provider.GetClusterRoleBindings(queryContextName, impersonation)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -123,7 +125,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	storageClasses, err := provider.GetStorageClasses(queryContextName)
+	storageClasses, err := provider.GetStorageClasses(queryContextName, impersonation)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -140,7 +142,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	services, err := provider.GetServices(queryContextName, queryNamespace)
+	services, err := provider.GetServices(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -157,7 +159,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	pods, err := provider.GetPods(queryContextName, queryNamespace)
+	pods, err := provider.GetPods(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -174,7 +176,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	persistentVolumeClaims, err := provider.GetPersistentVolumeClaims(queryContextName, queryNamespace)
+	persistentVolumeClaims, err := provider.GetPersistentVolumeClaims(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -191,7 +193,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	configMaps, err := provider.GetConfigMaps(queryContextName, queryNamespace)
+	configMaps, err := provider.GetConfigMaps(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -208,7 +210,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	replicationControllers, err := provider.GetReplicationControllers(queryContextName, queryNamespace)
+	replicationControllers, err := provider.GetReplicationControllers(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -225,7 +227,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	secrets, err := provider.GetSecrets(queryContextName, queryNamespace)
+	secrets, err := provider.GetSecrets(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -242,7 +244,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	serviceAccounts, err := provider.GetServiceAccounts(queryContextName, queryNamespace)
+	serviceAccounts, err := provider.GetServiceAccounts(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -259,7 +261,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	deployments, err := provider.GetDeployments(queryContextName, queryNamespace)
+	deployments, err := provider.GetDeployments(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -276,7 +278,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	statefulSets, err := provider.GetStatefulSets(queryContextName, queryNamespace)
+	statefulSets, err := provider.GetStatefulSets(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -293,7 +295,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	daemonSets, err := provider.GetDaemonSets(queryContextName, queryNamespace)
+	daemonSets, err := provider.GetDaemonSets(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -310,7 +312,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	replicaSets, err := provider.GetReplicaSets(queryContextName, queryNamespace)
+	replicaSets, err := provider.GetReplicaSets(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -327,7 +329,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	networkPolicies, err := provider.GetNetworkPolicies(queryContextName, queryNamespace)
+	networkPolicies, err := provider.GetNetworkPolicies(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -344,7 +346,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	roles, err := provider.GetRoles(queryContextName, queryNamespace)
+	roles, err := provider.GetRoles(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -361,7 +363,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	roleBindings, err := provider.GetRoleBindings(queryContextName, queryNamespace)
+	roleBindings, err := provider.GetRoleBindings(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -378,7 +380,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	jobs, err := provider.GetJobs(queryContextName, queryNamespace)
+	jobs, err := provider.GetJobs(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -395,7 +397,7 @@ func getLabels(e echo.Context) error {
 	}
 
 	// Get the state of the cluster
-	cronJobs, err := provider.GetCronJobs(queryContextName, queryNamespace)
+	cronJobs, err := provider.GetCronJobs(queryContextName, impersonation, queryNamespace)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
@@ -0,0 +1,298 @@
+package controller
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	"github.com/twuillemin/kuboxy/internal/configuration"
+	"github.com/twuillemin/kuboxy/pkg/event"
+	"github.com/twuillemin/kuboxy/pkg/report"
+	"github.com/twuillemin/kuboxy/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaskedValue describes a value of a Secret or of a ConfigMap without revealing it. The HMAC-SHA256 is keyed with a
+// random secret generated when the server starts, so that the values can't be guessed offline from their hash. It
+// allows to know if two values are identical or if a value was modified, as long as the server is not restarted
+type MaskedValue struct {
+	Masked     bool   `json:"masked"`
+	Length     int    `json:"length"`
+	HMACSHA256 string `json:"hmacSha256"`
+}
+
+// The key of the HMAC of the masked values, generated once by server
+var (
+	maskingKey     []byte
+	maskingKeyOnce sync.Once
+)
+
+// maskedSecret is a Secret whose values are masked
+type maskedSecret struct {
+	corev1.Secret
+	Data       map[string]MaskedValue `json:"data,omitempty"`
+	StringData map[string]MaskedValue `json:"stringData,omitempty"`
+}
+
+// maskedConfigMap is a ConfigMap whose values are masked
+type maskedConfigMap struct {
+	corev1.ConfigMap
+	Data       map[string]MaskedValue `json:"data,omitempty"`
+	BinaryData map[string]MaskedValue `json:"binaryData,omitempty"`
+}
+
+// maskedSecretReport is the summary of a Secret whose values are masked
+type maskedSecretReport struct {
+	report.SecretReport
+	Data map[string]MaskedValue `json:"data"`
+}
+
+// maskedConfigMapReport is the summary of a ConfigMap whose values are masked
+type maskedConfigMapReport struct {
+	report.ConfigMapReport
+	Data map[string]MaskedValue `json:"data"`
+}
+
+// maskedClusterStateReport is the summary of a cluster whose Secrets and ConfigMaps may be masked
+type maskedClusterStateReport struct {
+	*report.ClusterStateReport
+	ConfigMapReports interface{} `json:"configMaps"`
+	SecretReports    interface{} `json:"secrets"`
+}
+
+// maskedSecretEvent is the event of a Secret whose values are masked
+type maskedSecretEvent struct {
+	event.SecretEvent
+	Secret maskedSecret
+}
+
+// maskedConfigMapEvent is the event of a ConfigMap whose values are masked
+type maskedConfigMapEvent struct {
+	event.ConfigMapEvent
+	ConfigMap maskedConfigMap
+}
+
+// maskObject returns the given value with the values of its Secrets and ConfigMaps masked, as defined by the
+// configuration of the application. The objects, the lists of objects, the search results, the summary and the events
+// are masked. Any other value is returned as is
+func maskObject(value interface{}) interface{} {
+
+	applicationConfiguration, err := configuration.GetConfiguration()
+	if err != nil {
+		return value
+	}
+
+	return maskObjectWith(value, applicationConfiguration.MaskSecrets, applicationConfiguration.MaskConfigMaps)
+}
+
+// maskObjectWith returns the given value with the values of its Secrets masked if maskSecrets is set and the values
+// of its ConfigMaps masked if maskConfigMaps is set
+func maskObjectWith(value interface{}, maskSecrets bool, maskConfigMaps bool) interface{} {
+
+	if !maskSecrets && !maskConfigMaps {
+		return value
+	}
+
+	switch object := value.(type) {
+
+	case corev1.Secret:
+		if maskSecrets {
+			return maskSecret(object)
+		}
+
+	case *corev1.Secret:
+		if maskSecrets && object != nil {
+			masked := maskSecret(*object)
+			return &masked
+		}
+
+	case []corev1.Secret:
+		if maskSecrets {
+			result := make([]maskedSecret, 0, len(object))
+			for _, secret := range object {
+				result = append(result, maskSecret(secret))
+			}
+			return result
+		}
+
+	case corev1.ConfigMap:
+		if maskConfigMaps {
+			return maskConfigMap(object)
+		}
+
+	case *corev1.ConfigMap:
+		if maskConfigMaps && object != nil {
+			masked := maskConfigMap(*object)
+			return &masked
+		}
+
+	case []corev1.ConfigMap:
+		if maskConfigMaps {
+			result := make([]maskedConfigMap, 0, len(object))
+			for _, configMap := range object {
+				result = append(result, maskConfigMap(configMap))
+			}
+			return result
+		}
+
+	case event.SecretEvent:
+		if maskSecrets {
+			return maskedSecretEvent{object, maskSecret(object.Secret)}
+		}
+
+	case event.ConfigMapEvent:
+		if maskConfigMaps {
+			return maskedConfigMapEvent{object, maskConfigMap(object.ConfigMap)}
+		}
+
+	case map[types.ObjectType][]interface{}:
+		result := make(map[types.ObjectType][]interface{}, len(object))
+		for objectType, objects := range object {
+			maskedObjects := make([]interface{}, 0, len(objects))
+			for _, child := range objects {
+				maskedObjects = append(maskedObjects, maskObjectWith(child, maskSecrets, maskConfigMaps))
+			}
+			result[objectType] = maskedObjects
+		}
+		return result
+
+	case *report.ClusterStateReport:
+		if object != nil {
+			return maskClusterStateReport(object, maskSecrets, maskConfigMaps)
+		}
+	}
+
+	return value
+}
+
+// maskSecret returns a copy of a Secret whose values are masked
+func maskSecret(secret corev1.Secret) maskedSecret {
+
+	secret.ObjectMeta = removeLastAppliedConfiguration(secret.ObjectMeta)
+
+	masked := maskedSecret{
+		Secret: secret,
+		Data:   maskBinaryValues(secret.Data),
+	}
+
+	if len(secret.StringData) > 0 {
+		masked.StringData = maskStringValues(secret.StringData)
+	}
+
+	return masked
+}
+
+// maskConfigMap returns a copy of a ConfigMap whose values are masked
+func maskConfigMap(configMap corev1.ConfigMap) maskedConfigMap {
+
+	configMap.ObjectMeta = removeLastAppliedConfiguration(configMap.ObjectMeta)
+
+	masked := maskedConfigMap{
+		ConfigMap: configMap,
+		Data:      maskStringValues(configMap.Data),
+	}
+
+	if len(configMap.BinaryData) > 0 {
+		masked.BinaryData = maskBinaryValues(configMap.BinaryData)
+	}
+
+	return masked
+}
+
+// removeLastAppliedConfiguration returns a copy of the metadata without the annotation set by kubectl apply, as it
+// holds the whole object, values included
+func removeLastAppliedConfiguration(metadata metav1.ObjectMeta) metav1.ObjectMeta {
+
+	if _, ok := metadata.Annotations[corev1.LastAppliedConfigAnnotation]; !ok {
+		return metadata
+	}
+
+	annotations := make(map[string]string, len(metadata.Annotations))
+	for key, value := range metadata.Annotations {
+		if key != corev1.LastAppliedConfigAnnotation {
+			annotations[key] = value
+		}
+	}
+	metadata.Annotations = annotations
+
+	return metadata
+}
+
+// maskClusterStateReport returns a copy of the summary of a cluster whose Secrets or ConfigMaps are masked
+func maskClusterStateReport(stateReport *report.ClusterStateReport, maskSecrets bool, maskConfigMaps bool) maskedClusterStateReport {
+
+	masked := maskedClusterStateReport{
+		ClusterStateReport: stateReport,
+		ConfigMapReports:   stateReport.ConfigMapReports,
+		SecretReports:      stateReport.SecretReports,
+	}
+
+	if maskSecrets {
+		secretReports := make([]maskedSecretReport, 0, len(stateReport.SecretReports))
+		for _, secretReport := range stateReport.SecretReports {
+			secretReports = append(secretReports, maskedSecretReport{secretReport, maskBinaryValues(secretReport.Data)})
+		}
+		masked.SecretReports = secretReports
+	}
+
+	if maskConfigMaps {
+		configMapReports := make([]maskedConfigMapReport, 0, len(stateReport.ConfigMapReports))
+		for _, configMapReport := range stateReport.ConfigMapReports {
+			configMapReports = append(configMapReports, maskedConfigMapReport{configMapReport, maskStringValues(configMapReport.Data)})
+		}
+		masked.ConfigMapReports = configMapReports
+	}
+
+	return masked
+}
+
+// maskBinaryValues masks the values of a map
+func maskBinaryValues(values map[string][]byte) map[string]MaskedValue {
+
+	result := make(map[string]MaskedValue, len(values))
+	for key, value := range values {
+		result[key] = maskValue(value)
+	}
+
+	return result
+}
+
+// maskStringValues masks the values of a map
+func maskStringValues(values map[string]string) map[string]MaskedValue {
+
+	result := make(map[string]MaskedValue, len(values))
+	for key, value := range values {
+		result[key] = maskValue([]byte(value))
+	}
+
+	return result
+}
+
+// maskValue describes a value by its length and its HMAC
+func maskValue(value []byte) MaskedValue {
+
+	mac := hmac.New(sha256.New, getMaskingKey())
+	mac.Write(value)
+
+	return MaskedValue{
+		Masked:     true,
+		Length:     len(value),
+		HMACSHA256: hex.EncodeToString(mac.Sum(nil)),
+	}
+}
+
+// getMaskingKey returns the key of the HMAC of the masked values, generating it on the first call
+func getMaskingKey() []byte {
+
+	maskingKeyOnce.Do(func() {
+		maskingKey = make([]byte, sha256.Size)
+		if _, err := rand.Read(maskingKey); err != nil {
+			panic(err)
+		}
+	})
+
+	return maskingKey
+}
@@ -0,0 +1,191 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/twuillemin/kuboxy/pkg/event"
+	"github.com/twuillemin/kuboxy/pkg/report"
+	"github.com/twuillemin/kuboxy/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The values of the objects used by the tests, "czNjcjN0" being the value of the Secret encoded in base64 as in JSON
+const (
+	testSecretValue          = "s3cr3t"
+	testEncodedSecretValue   = "czNjcjN0"
+	testConfigMapValue       = "pl4in"
+	testMaskedValueSignature = `"masked":true`
+)
+
+func TestMaskObject(t *testing.T) {
+
+	secret := corev1.Secret{Data: map[string][]byte{"password": []byte(testSecretValue)}}
+	configMap := corev1.ConfigMap{Data: map[string]string{"setting": testConfigMapValue}}
+
+	// The objects created by kubectl apply keep their whole definition in an annotation
+	appliedMetadata := func(content string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:        "applied",
+			Annotations: map[string]string{corev1.LastAppliedConfigAnnotation: content, "team": "a"},
+		}
+	}
+	appliedSecret := corev1.Secret{
+		ObjectMeta: appliedMetadata(`{"apiVersion":"v1","kind":"Secret","stringData":{"password":"` + testSecretValue + `"}}`),
+		Data:       secret.Data,
+	}
+	appliedConfigMap := corev1.ConfigMap{
+		ObjectMeta: appliedMetadata(`{"apiVersion":"v1","kind":"ConfigMap","data":{"setting":"` + testConfigMapValue + `"}}`),
+		Data:       configMap.Data,
+	}
+
+	tests := []struct {
+		name           string
+		value          interface{}
+		maskSecrets    bool
+		maskConfigMaps bool
+		hiddenValues   []string
+		visibleValues  []string
+	}{
+		{
+			name:          "nothing masked",
+			value:         secret,
+			visibleValues: []string{testEncodedSecretValue},
+		},
+		{
+			name:         "secret",
+			value:        secret,
+			maskSecrets:  true,
+			hiddenValues: []string{testEncodedSecretValue},
+		},
+		{
+			name:         "secret string data",
+			value:        &corev1.Secret{StringData: map[string]string{"password": testSecretValue}},
+			maskSecrets:  true,
+			hiddenValues: []string{testSecretValue},
+		},
+		{
+			name:           "secret not masked with the config maps",
+			value:          []corev1.Secret{secret},
+			maskConfigMaps: true,
+			visibleValues:  []string{testEncodedSecretValue},
+		},
+		{
+			name:         "list of secrets",
+			value:        []corev1.Secret{secret, secret},
+			maskSecrets:  true,
+			hiddenValues: []string{testEncodedSecretValue},
+		},
+		{
+			name:           "config map",
+			value:          &configMap,
+			maskConfigMaps: true,
+			hiddenValues:   []string{testConfigMapValue},
+		},
+		{
+			name:           "config map binary data",
+			value:          corev1.ConfigMap{BinaryData: map[string][]byte{"setting": []byte(testSecretValue)}},
+			maskConfigMaps: true,
+			hiddenValues:   []string{testEncodedSecretValue},
+		},
+		{
+			name:          "config map not masked with the secrets",
+			value:         []corev1.ConfigMap{configMap},
+			maskSecrets:   true,
+			visibleValues: []string{testConfigMapValue},
+		},
+		{
+			name:         "secret event",
+			value:        event.SecretEvent{EventType: event.Create, Secret: secret},
+			maskSecrets:  true,
+			hiddenValues: []string{testEncodedSecretValue},
+		},
+		{
+			name:           "config map event",
+			value:          event.ConfigMapEvent{EventType: event.Update, ConfigMap: configMap},
+			maskConfigMaps: true,
+			hiddenValues:   []string{testConfigMapValue},
+		},
+		{
+			name:           "search results",
+			value:          map[types.ObjectType][]interface{}{types.Secret: {appliedSecret}, types.ConfigMap: {appliedConfigMap}},
+			maskSecrets:    true,
+			maskConfigMaps: true,
+			hiddenValues:   []string{testEncodedSecretValue, testSecretValue, testConfigMapValue},
+		},
+		{
+			name: "summary of the cluster",
+			value: &report.ClusterStateReport{
+				SecretReports:    []report.SecretReport{{Name: "database", Data: secret.Data}},
+				ConfigMapReports: []report.ConfigMapReport{{Name: "settings", Data: configMap.Data}},
+			},
+			maskSecrets:   true,
+			hiddenValues:  []string{testEncodedSecretValue},
+			visibleValues: []string{testConfigMapValue, `"name":"database"`},
+		},
+		{
+			name:          "other objects",
+			value:         corev1.Pod{Spec: corev1.PodSpec{Hostname: testSecretValue}},
+			maskSecrets:   true,
+			visibleValues: []string{testSecretValue},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			content, err := json.Marshal(maskObjectWith(test.value, test.maskSecrets, test.maskConfigMaps))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, hidden := range test.hiddenValues {
+				if strings.Contains(string(content), hidden) {
+					t.Errorf("the value %s is not masked in %s", hidden, content)
+				}
+			}
+			if len(test.hiddenValues) > 0 && !strings.Contains(string(content), testMaskedValueSignature) {
+				t.Errorf("the masked values are not described in %s", content)
+			}
+
+			for _, visible := range test.visibleValues {
+				if !strings.Contains(string(content), visible) {
+					t.Errorf("the value %s is missing in %s", visible, content)
+				}
+			}
+		})
+	}
+
+	if len(appliedSecret.Annotations) != 2 {
+		t.Error("the annotations of the original secret were modified")
+	}
+}
+
+func TestMaskValue(t *testing.T) {
+
+	hash := sha256.Sum256([]byte("hello"))
+	plainHash := hex.EncodeToString(hash[:])
+
+	masked := maskValue([]byte("hello"))
+
+	tests := []struct {
+		name  string
+		check bool
+	}{
+		{"masked", masked.Masked},
+		{"length", masked.Length == 5},
+		{"identical values have the same HMAC", maskValue([]byte("hello")) == masked},
+		{"different values have different HMACs", maskValue([]byte("hellO")).HMACSHA256 != masked.HMACSHA256},
+		{"not the plain hash of the value", len(masked.HMACSHA256) == len(plainHash) && masked.HMACSHA256 != plainHash},
+	}
+
+	for _, test := range tests {
+		if !test.check {
+			t.Errorf("%s: unexpected masked value %+v", test.name, masked)
+		}
+	}
+}
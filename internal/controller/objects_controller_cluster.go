@@ -2,14 +2,16 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 //
-// This file was generated by gen_objects_controller_cluster.go at 2019-06-17 22:10:09.044022663 +0300 EEST m=+0.002467569
+// This file was generated by gen_objects_controller_cluster.go at 2026-10-18 06:02:37.113710151 +0000 UTC m=+0.000837225
 package controller
 
 import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
 	"github.com/twuillemin/kuboxy/pkg/provider"
+	"github.com/twuillemin/kuboxy/pkg/types"
 
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -19,46 +21,46 @@ import (
 func registerObjectClusterControllers(e *echo.Echo) {
 
 	// Namespaces
-	e.GET("api/v1/objects/:contextName/namespaces", getObjectNamespaces)
-	e.GET("api/v1/objects/:contextName/namespaces/:name", getObjectNamespace)
-	e.POST("api/v1/objects/:contextName/namespaces", createObjectNamespace)
-	e.PUT("api/v1/objects/:contextName/namespaces", updateObjectNamespace)
-	e.DELETE("api/v1/objects/:contextName/namespaces/:name", deleteObjectNamespace)
+	e.GET("api/v1/objects/:contextName/namespaces", getObjectNamespaces, authorize(types.Namespace, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/namespaces/:name", getObjectNamespace, authorize(types.Namespace, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/namespaces", createObjectNamespace, authorize(types.Namespace, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/namespaces", updateObjectNamespace, authorize(types.Namespace, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/namespaces/:name", deleteObjectNamespace, authorize(types.Namespace, authorization.VerbDelete))
 
 	// Nodes
-	e.GET("api/v1/objects/:contextName/nodes", getObjectNodes)
-	e.GET("api/v1/objects/:contextName/nodes/:name", getObjectNode)
-	e.POST("api/v1/objects/:contextName/nodes", createObjectNode)
-	e.PUT("api/v1/objects/:contextName/nodes", updateObjectNode)
-	e.DELETE("api/v1/objects/:contextName/nodes/:name", deleteObjectNode)
+	e.GET("api/v1/objects/:contextName/nodes", getObjectNodes, authorize(types.Node, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/nodes/:name", getObjectNode, authorize(types.Node, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/nodes", createObjectNode, authorize(types.Node, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/nodes", updateObjectNode, authorize(types.Node, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/nodes/:name", deleteObjectNode, authorize(types.Node, authorization.VerbDelete))
 
 	// PersistentVolumes
-	e.GET("api/v1/objects/:contextName/persistentVolumes", getObjectPersistentVolumes)
-	e.GET("api/v1/objects/:contextName/persistentVolumes/:name", getObjectPersistentVolume)
-	e.POST("api/v1/objects/:contextName/persistentVolumes", createObjectPersistentVolume)
-	e.PUT("api/v1/objects/:contextName/persistentVolumes", updateObjectPersistentVolume)
-	e.DELETE("api/v1/objects/:contextName/persistentVolumes/:name", deleteObjectPersistentVolume)
+	e.GET("api/v1/objects/:contextName/persistentVolumes", getObjectPersistentVolumes, authorize(types.PersistentVolume, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/persistentVolumes/:name", getObjectPersistentVolume, authorize(types.PersistentVolume, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/persistentVolumes", createObjectPersistentVolume, authorize(types.PersistentVolume, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/persistentVolumes", updateObjectPersistentVolume, authorize(types.PersistentVolume, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/persistentVolumes/:name", deleteObjectPersistentVolume, authorize(types.PersistentVolume, authorization.VerbDelete))
 
 	// ClusterRoles
-	e.GET("api/v1/objects/:contextName/clusterRoles", getObjectClusterRoles)
-	e.GET("api/v1/objects/:contextName/clusterRoles/:name", getObjectClusterRole)
-	e.POST("api/v1/objects/:contextName/clusterRoles", createObjectClusterRole)
-	e.PUT("api/v1/objects/:contextName/clusterRoles", updateObjectClusterRole)
-	e.DELETE("api/v1/objects/:contextName/clusterRoles/:name", deleteObjectClusterRole)
+	e.GET("api/v1/objects/:contextName/clusterRoles", getObjectClusterRoles, authorize(types.ClusterRole, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/clusterRoles/:name", getObjectClusterRole, authorize(types.ClusterRole, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/clusterRoles", createObjectClusterRole, authorize(types.ClusterRole, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/clusterRoles", updateObjectClusterRole, authorize(types.ClusterRole, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/clusterRoles/:name", deleteObjectClusterRole, authorize(types.ClusterRole, authorization.VerbDelete))
 
 	// ClusterRoleBindings
-	e.GET("api/v1/objects/:contextName/clusterRoleBindings", getObjectClusterRoleBindings)
-	e.GET("api/v1/objects/:contextName/clusterRoleBindings/:name", getObjectClusterRoleBinding)
-	e.POST("api/v1/objects/:contextName/clusterRoleBindings", createObjectClusterRoleBinding)
-	e.PUT("api/v1/objects/:contextName/clusterRoleBindings", updateObjectClusterRoleBinding)
-	e.DELETE("api/v1/objects/:contextName/clusterRoleBindings/:name", deleteObjectClusterRoleBinding)
+	e.GET("api/v1/objects/:contextName/clusterRoleBindings", getObjectClusterRoleBindings, authorize(types.ClusterRoleBinding, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/clusterRoleBindings/:name", getObjectClusterRoleBinding, authorize(types.ClusterRoleBinding, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/clusterRoleBindings", createObjectClusterRoleBinding, authorize(types.ClusterRoleBinding, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/clusterRoleBindings", updateObjectClusterRoleBinding, authorize(types.ClusterRoleBinding, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/clusterRoleBindings/:name", deleteObjectClusterRoleBinding, authorize(types.ClusterRoleBinding, authorization.VerbDelete))
 
 	// StorageClasses
-	e.GET("api/v1/objects/:contextName/storageClasses", getObjectStorageClasses)
-	e.GET("api/v1/objects/:contextName/storageClasses/:name", getObjectStorageClass)
-	e.POST("api/v1/objects/:contextName/storageClasses", createObjectStorageClass)
-	e.PUT("api/v1/objects/:contextName/storageClasses", updateObjectStorageClass)
-	e.DELETE("api/v1/objects/:contextName/storageClasses/:name", deleteObjectStorageClass)
+	e.GET("api/v1/objects/:contextName/storageClasses", getObjectStorageClasses, authorize(types.StorageClass, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/storageClasses/:name", getObjectStorageClass, authorize(types.StorageClass, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/storageClasses", createObjectStorageClass, authorize(types.StorageClass, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/storageClasses", updateObjectStorageClass, authorize(types.StorageClass, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/storageClasses/:name", deleteObjectStorageClass, authorize(types.StorageClass, authorization.VerbDelete))
 
 }
 
@@ -78,7 +80,7 @@ func getObjectNamespaces(e echo.Context) error {
 	contextName := e.Param("contextName")
 
 	// Get the state of the cluster
-	namespaces, err := provider.GetNamespaces(contextName)
+	namespaces, err := provider.GetNamespaces(contextName, getImpersonation(e))
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -104,7 +106,7 @@ func getObjectNamespace(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	namespace, err := provider.GetNamespace(contextName, name)
+	namespace, err := provider.GetNamespace(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -137,7 +139,7 @@ func createObjectNamespace(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateNamespace(contextName, namespace)
+	saved, err := provider.CreateNamespace(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -170,7 +172,7 @@ func updateObjectNamespace(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateNamespace(contextName, namespace)
+	saved, err := provider.UpdateNamespace(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -194,7 +196,7 @@ func deleteObjectNamespace(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteNamespace(contextName, name)
+	err := provider.DeleteNamespace(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -218,7 +220,7 @@ func getObjectNodes(e echo.Context) error {
 	contextName := e.Param("contextName")
 
 	// Get the state of the cluster
-	nodes, err := provider.GetNodes(contextName)
+	nodes, err := provider.GetNodes(contextName, getImpersonation(e))
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -244,7 +246,7 @@ func getObjectNode(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	node, err := provider.GetNode(contextName, name)
+	node, err := provider.GetNode(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -277,7 +279,7 @@ func createObjectNode(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateNode(contextName, node)
+	saved, err := provider.CreateNode(contextName, getImpersonation(e), node)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -310,7 +312,7 @@ func updateObjectNode(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateNode(contextName, node)
+	saved, err := provider.UpdateNode(contextName, getImpersonation(e), node)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -334,7 +336,7 @@ func deleteObjectNode(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteNode(contextName, name)
+	err := provider.DeleteNode(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -358,7 +360,7 @@ func getObjectPersistentVolumes(e echo.Context) error {
 	contextName := e.Param("contextName")
 
 	// Get the state of the cluster
-	persistentVolumes, err := provider.GetPersistentVolumes(contextName)
+	persistentVolumes, err := provider.GetPersistentVolumes(contextName, getImpersonation(e))
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -384,7 +386,7 @@ func getObjectPersistentVolume(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	persistentVolume, err := provider.GetPersistentVolume(contextName, name)
+	persistentVolume, err := provider.GetPersistentVolume(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -417,7 +419,7 @@ func createObjectPersistentVolume(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreatePersistentVolume(contextName, persistentVolume)
+	saved, err := provider.CreatePersistentVolume(contextName, getImpersonation(e), persistentVolume)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -450,7 +452,7 @@ func updateObjectPersistentVolume(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdatePersistentVolume(contextName, persistentVolume)
+	saved, err := provider.UpdatePersistentVolume(contextName, getImpersonation(e), persistentVolume)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -474,7 +476,7 @@ func deleteObjectPersistentVolume(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeletePersistentVolume(contextName, name)
+	err := provider.DeletePersistentVolume(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -498,7 +500,7 @@ func getObjectClusterRoles(e echo.Context) error {
 	contextName := e.Param("contextName")
 
 	// Get the state of the cluster
-	clusterRoles, err := provider.GetClusterRoles(contextName)
+	clusterRoles, err := provider.GetClusterRoles(contextName, getImpersonation(e))
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -524,7 +526,7 @@ func getObjectClusterRole(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	clusterRole, err := provider.GetClusterRole(contextName, name)
+	clusterRole, err := provider.GetClusterRole(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -557,7 +559,7 @@ func createObjectClusterRole(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateClusterRole(contextName, clusterRole)
+	saved, err := provider.CreateClusterRole(contextName, getImpersonation(e), clusterRole)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -590,7 +592,7 @@ func updateObjectClusterRole(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateClusterRole(contextName, clusterRole)
+	saved, err := provider.UpdateClusterRole(contextName, getImpersonation(e), clusterRole)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -614,7 +616,7 @@ func deleteObjectClusterRole(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteClusterRole(contextName, name)
+	err := provider.DeleteClusterRole(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -638,7 +640,7 @@ func getObjectClusterRoleBindings(e echo.Context) error {
 	contextName := e.Param("contextName")
 
 	// Get the state of the cluster
-	clusterRoleBindings, err := provider.GetClusterRoleBindings(contextName)
+	clusterRoleBindings, err := provider.GetClusterRoleBindings(contextName, getImpersonation(e))
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -664,7 +666,7 @@ func getObjectClusterRoleBinding(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	clusterRoleBinding, err := provider.GetClusterRoleBinding(contextName, name)
+	clusterRoleBinding, err := provider.GetClusterRoleBinding(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -697,7 +699,7 @@ func createObjectClusterRoleBinding(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateClusterRoleBinding(contextName, clusterRoleBinding)
+	saved, err := provider.CreateClusterRoleBinding(contextName, getImpersonation(e), clusterRoleBinding)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -730,7 +732,7 @@ func updateObjectClusterRoleBinding(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateClusterRoleBinding(contextName, clusterRoleBinding)
+	saved, err := provider.UpdateClusterRoleBinding(contextName, getImpersonation(e), clusterRoleBinding)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -754,7 +756,7 @@ func deleteObjectClusterRoleBinding(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteClusterRoleBinding(contextName, name)
+	err := provider.DeleteClusterRoleBinding(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -778,7 +780,7 @@ func getObjectStorageClasses(e echo.Context) error {
 	contextName := e.Param("contextName")
 
 	// Get the state of the cluster
-	storageClasses, err := provider.GetStorageClasses(contextName)
+	storageClasses, err := provider.GetStorageClasses(contextName, getImpersonation(e))
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -804,7 +806,7 @@ func getObjectStorageClass(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	storageClass, err := provider.GetStorageClass(contextName, name)
+	storageClass, err := provider.GetStorageClass(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -837,7 +839,7 @@ func createObjectStorageClass(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateStorageClass(contextName, storageClass)
+	saved, err := provider.CreateStorageClass(contextName, getImpersonation(e), storageClass)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -870,7 +872,7 @@ func updateObjectStorageClass(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateStorageClass(contextName, storageClass)
+	saved, err := provider.UpdateStorageClass(contextName, getImpersonation(e), storageClass)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -894,7 +896,7 @@ func deleteObjectStorageClass(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteStorageClass(contextName, name)
+	err := provider.DeleteStorageClass(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -2,21 +2,23 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 //
-// This file was generated by gen_objects_controller_cluster_metrics.go at 2019-06-17 22:10:09.32040677 +0300 EEST m=+0.001278175
+// This file was generated by gen_objects_controller_cluster_metrics.go at 2026-10-18 06:02:37.437636937 +0000 UTC m=+0.000799879
 package controller
 
 import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
 	"github.com/twuillemin/kuboxy/pkg/provider"
+	"github.com/twuillemin/kuboxy/pkg/types"
 )
 
 func registerObjectClusterMetricsControllers(e *echo.Echo) {
 
 	// NodeMetricses
-	e.GET("api/v1/objects/:contextName/nodeMetricses", getObjectNodeMetricses)
-	e.GET("api/v1/objects/:contextName/nodeMetricses/:name", getObjectNodeMetrics)
+	e.GET("api/v1/objects/:contextName/nodeMetricses", getObjectNodeMetricses, authorize(types.NodeMetrics, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/nodeMetricses/:name", getObjectNodeMetrics, authorize(types.NodeMetrics, authorization.VerbGet))
 
 }
 
@@ -36,7 +38,7 @@ func getObjectNodeMetricses(e echo.Context) error {
 	contextName := e.Param("contextName")
 
 	// Get the state of the cluster
-	nodeMetricses, err := provider.GetNodeMetricses(contextName)
+	nodeMetricses, err := provider.GetNodeMetricses(contextName, getImpersonation(e))
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -62,7 +64,7 @@ func getObjectNodeMetrics(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	nodeMetrics, err := provider.GetNodeMetrics(contextName, name)
+	nodeMetrics, err := provider.GetNodeMetrics(contextName, getImpersonation(e), name)
 	if err != nil {
 		return getHTTPError(err)
 	}
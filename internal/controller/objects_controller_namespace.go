@@ -2,7 +2,7 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 //
-// This file was generated by gen_objects_controller_namespace.go at 2019-06-17 22:10:09.592181302 +0300 EEST m=+0.002681179
+// This file was generated by gen_objects_controller_namespace.go at 2026-10-18 06:51:45.993703782 +0000 UTC m=+0.001542092
 package controller
 
 import (
@@ -10,7 +10,9 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
 	"github.com/twuillemin/kuboxy/pkg/provider"
+	"github.com/twuillemin/kuboxy/pkg/types"
 
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -23,116 +25,116 @@ import (
 func registerObjectNamespaceControllers(e *echo.Echo) {
 
 	// Services
-	e.GET("api/v1/objects/:contextName/services/:namespace", getObjectServices)
-	e.GET("api/v1/objects/:contextName/services/:namespace/:name", getObjectService)
-	e.POST("api/v1/objects/:contextName/services/:namespace", createObjectService)
-	e.PUT("api/v1/objects/:contextName/services/:namespace", updateObjectService)
-	e.DELETE("api/v1/objects/:contextName/services/:namespace/:name", deleteObjectService)
+	e.GET("api/v1/objects/:contextName/services/:namespace", getObjectServices, authorize(types.Service, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/services/:namespace/:name", getObjectService, authorize(types.Service, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/services/:namespace", createObjectService, authorize(types.Service, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/services/:namespace", updateObjectService, authorize(types.Service, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/services/:namespace/:name", deleteObjectService, authorize(types.Service, authorization.VerbDelete))
 
 	// Pods
-	e.GET("api/v1/objects/:contextName/pods/:namespace", getObjectPods)
-	e.GET("api/v1/objects/:contextName/pods/:namespace/:name", getObjectPod)
-	e.POST("api/v1/objects/:contextName/pods/:namespace", createObjectPod)
-	e.PUT("api/v1/objects/:contextName/pods/:namespace", updateObjectPod)
-	e.DELETE("api/v1/objects/:contextName/pods/:namespace/:name", deleteObjectPod)
+	e.GET("api/v1/objects/:contextName/pods/:namespace", getObjectPods, authorize(types.Pod, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/pods/:namespace/:name", getObjectPod, authorize(types.Pod, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/pods/:namespace", createObjectPod, authorize(types.Pod, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/pods/:namespace", updateObjectPod, authorize(types.Pod, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/pods/:namespace/:name", deleteObjectPod, authorize(types.Pod, authorization.VerbDelete))
 
 	// PersistentVolumeClaims
-	e.GET("api/v1/objects/:contextName/persistentVolumeClaims/:namespace", getObjectPersistentVolumeClaims)
-	e.GET("api/v1/objects/:contextName/persistentVolumeClaims/:namespace/:name", getObjectPersistentVolumeClaim)
-	e.POST("api/v1/objects/:contextName/persistentVolumeClaims/:namespace", createObjectPersistentVolumeClaim)
-	e.PUT("api/v1/objects/:contextName/persistentVolumeClaims/:namespace", updateObjectPersistentVolumeClaim)
-	e.DELETE("api/v1/objects/:contextName/persistentVolumeClaims/:namespace/:name", deleteObjectPersistentVolumeClaim)
+	e.GET("api/v1/objects/:contextName/persistentVolumeClaims/:namespace", getObjectPersistentVolumeClaims, authorize(types.PersistentVolumeClaim, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/persistentVolumeClaims/:namespace/:name", getObjectPersistentVolumeClaim, authorize(types.PersistentVolumeClaim, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/persistentVolumeClaims/:namespace", createObjectPersistentVolumeClaim, authorize(types.PersistentVolumeClaim, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/persistentVolumeClaims/:namespace", updateObjectPersistentVolumeClaim, authorize(types.PersistentVolumeClaim, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/persistentVolumeClaims/:namespace/:name", deleteObjectPersistentVolumeClaim, authorize(types.PersistentVolumeClaim, authorization.VerbDelete))
 
 	// ConfigMaps
-	e.GET("api/v1/objects/:contextName/configMaps/:namespace", getObjectConfigMaps)
-	e.GET("api/v1/objects/:contextName/configMaps/:namespace/:name", getObjectConfigMap)
-	e.POST("api/v1/objects/:contextName/configMaps/:namespace", createObjectConfigMap)
-	e.PUT("api/v1/objects/:contextName/configMaps/:namespace", updateObjectConfigMap)
-	e.DELETE("api/v1/objects/:contextName/configMaps/:namespace/:name", deleteObjectConfigMap)
+	e.GET("api/v1/objects/:contextName/configMaps/:namespace", getObjectConfigMaps, authorize(types.ConfigMap, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/configMaps/:namespace/:name", getObjectConfigMap, authorize(types.ConfigMap, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/configMaps/:namespace", createObjectConfigMap, authorize(types.ConfigMap, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/configMaps/:namespace", updateObjectConfigMap, authorize(types.ConfigMap, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/configMaps/:namespace/:name", deleteObjectConfigMap, authorize(types.ConfigMap, authorization.VerbDelete))
 
 	// ReplicationControllers
-	e.GET("api/v1/objects/:contextName/replicationControllers/:namespace", getObjectReplicationControllers)
-	e.GET("api/v1/objects/:contextName/replicationControllers/:namespace/:name", getObjectReplicationController)
-	e.POST("api/v1/objects/:contextName/replicationControllers/:namespace", createObjectReplicationController)
-	e.PUT("api/v1/objects/:contextName/replicationControllers/:namespace", updateObjectReplicationController)
-	e.DELETE("api/v1/objects/:contextName/replicationControllers/:namespace/:name", deleteObjectReplicationController)
+	e.GET("api/v1/objects/:contextName/replicationControllers/:namespace", getObjectReplicationControllers, authorize(types.ReplicationController, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/replicationControllers/:namespace/:name", getObjectReplicationController, authorize(types.ReplicationController, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/replicationControllers/:namespace", createObjectReplicationController, authorize(types.ReplicationController, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/replicationControllers/:namespace", updateObjectReplicationController, authorize(types.ReplicationController, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/replicationControllers/:namespace/:name", deleteObjectReplicationController, authorize(types.ReplicationController, authorization.VerbDelete))
 
 	// Secrets
-	e.GET("api/v1/objects/:contextName/secrets/:namespace", getObjectSecrets)
-	e.GET("api/v1/objects/:contextName/secrets/:namespace/:name", getObjectSecret)
-	e.POST("api/v1/objects/:contextName/secrets/:namespace", createObjectSecret)
-	e.PUT("api/v1/objects/:contextName/secrets/:namespace", updateObjectSecret)
-	e.DELETE("api/v1/objects/:contextName/secrets/:namespace/:name", deleteObjectSecret)
+	e.GET("api/v1/objects/:contextName/secrets/:namespace", getObjectSecrets, authorize(types.Secret, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/secrets/:namespace/:name", getObjectSecret, authorize(types.Secret, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/secrets/:namespace", createObjectSecret, authorize(types.Secret, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/secrets/:namespace", updateObjectSecret, authorize(types.Secret, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/secrets/:namespace/:name", deleteObjectSecret, authorize(types.Secret, authorization.VerbDelete))
 
 	// ServiceAccounts
-	e.GET("api/v1/objects/:contextName/serviceAccounts/:namespace", getObjectServiceAccounts)
-	e.GET("api/v1/objects/:contextName/serviceAccounts/:namespace/:name", getObjectServiceAccount)
-	e.POST("api/v1/objects/:contextName/serviceAccounts/:namespace", createObjectServiceAccount)
-	e.PUT("api/v1/objects/:contextName/serviceAccounts/:namespace", updateObjectServiceAccount)
-	e.DELETE("api/v1/objects/:contextName/serviceAccounts/:namespace/:name", deleteObjectServiceAccount)
+	e.GET("api/v1/objects/:contextName/serviceAccounts/:namespace", getObjectServiceAccounts, authorize(types.ServiceAccount, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/serviceAccounts/:namespace/:name", getObjectServiceAccount, authorize(types.ServiceAccount, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/serviceAccounts/:namespace", createObjectServiceAccount, authorize(types.ServiceAccount, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/serviceAccounts/:namespace", updateObjectServiceAccount, authorize(types.ServiceAccount, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/serviceAccounts/:namespace/:name", deleteObjectServiceAccount, authorize(types.ServiceAccount, authorization.VerbDelete))
 
 	// Deployments
-	e.GET("api/v1/objects/:contextName/deployments/:namespace", getObjectDeployments)
-	e.GET("api/v1/objects/:contextName/deployments/:namespace/:name", getObjectDeployment)
-	e.POST("api/v1/objects/:contextName/deployments/:namespace", createObjectDeployment)
-	e.PUT("api/v1/objects/:contextName/deployments/:namespace", updateObjectDeployment)
-	e.DELETE("api/v1/objects/:contextName/deployments/:namespace/:name", deleteObjectDeployment)
+	e.GET("api/v1/objects/:contextName/deployments/:namespace", getObjectDeployments, authorize(types.Deployment, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/deployments/:namespace/:name", getObjectDeployment, authorize(types.Deployment, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/deployments/:namespace", createObjectDeployment, authorize(types.Deployment, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/deployments/:namespace", updateObjectDeployment, authorize(types.Deployment, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/deployments/:namespace/:name", deleteObjectDeployment, authorize(types.Deployment, authorization.VerbDelete))
 
 	// StatefulSets
-	e.GET("api/v1/objects/:contextName/statefulSets/:namespace", getObjectStatefulSets)
-	e.GET("api/v1/objects/:contextName/statefulSets/:namespace/:name", getObjectStatefulSet)
-	e.POST("api/v1/objects/:contextName/statefulSets/:namespace", createObjectStatefulSet)
-	e.PUT("api/v1/objects/:contextName/statefulSets/:namespace", updateObjectStatefulSet)
-	e.DELETE("api/v1/objects/:contextName/statefulSets/:namespace/:name", deleteObjectStatefulSet)
+	e.GET("api/v1/objects/:contextName/statefulSets/:namespace", getObjectStatefulSets, authorize(types.StatefulSet, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/statefulSets/:namespace/:name", getObjectStatefulSet, authorize(types.StatefulSet, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/statefulSets/:namespace", createObjectStatefulSet, authorize(types.StatefulSet, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/statefulSets/:namespace", updateObjectStatefulSet, authorize(types.StatefulSet, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/statefulSets/:namespace/:name", deleteObjectStatefulSet, authorize(types.StatefulSet, authorization.VerbDelete))
 
 	// DaemonSets
-	e.GET("api/v1/objects/:contextName/daemonSets/:namespace", getObjectDaemonSets)
-	e.GET("api/v1/objects/:contextName/daemonSets/:namespace/:name", getObjectDaemonSet)
-	e.POST("api/v1/objects/:contextName/daemonSets/:namespace", createObjectDaemonSet)
-	e.PUT("api/v1/objects/:contextName/daemonSets/:namespace", updateObjectDaemonSet)
-	e.DELETE("api/v1/objects/:contextName/daemonSets/:namespace/:name", deleteObjectDaemonSet)
+	e.GET("api/v1/objects/:contextName/daemonSets/:namespace", getObjectDaemonSets, authorize(types.DaemonSet, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/daemonSets/:namespace/:name", getObjectDaemonSet, authorize(types.DaemonSet, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/daemonSets/:namespace", createObjectDaemonSet, authorize(types.DaemonSet, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/daemonSets/:namespace", updateObjectDaemonSet, authorize(types.DaemonSet, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/daemonSets/:namespace/:name", deleteObjectDaemonSet, authorize(types.DaemonSet, authorization.VerbDelete))
 
 	// ReplicaSets
-	e.GET("api/v1/objects/:contextName/replicaSets/:namespace", getObjectReplicaSets)
-	e.GET("api/v1/objects/:contextName/replicaSets/:namespace/:name", getObjectReplicaSet)
-	e.POST("api/v1/objects/:contextName/replicaSets/:namespace", createObjectReplicaSet)
-	e.PUT("api/v1/objects/:contextName/replicaSets/:namespace", updateObjectReplicaSet)
-	e.DELETE("api/v1/objects/:contextName/replicaSets/:namespace/:name", deleteObjectReplicaSet)
+	e.GET("api/v1/objects/:contextName/replicaSets/:namespace", getObjectReplicaSets, authorize(types.ReplicaSet, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/replicaSets/:namespace/:name", getObjectReplicaSet, authorize(types.ReplicaSet, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/replicaSets/:namespace", createObjectReplicaSet, authorize(types.ReplicaSet, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/replicaSets/:namespace", updateObjectReplicaSet, authorize(types.ReplicaSet, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/replicaSets/:namespace/:name", deleteObjectReplicaSet, authorize(types.ReplicaSet, authorization.VerbDelete))
 
 	// NetworkPolicies
-	e.GET("api/v1/objects/:contextName/networkPolicies/:namespace", getObjectNetworkPolicies)
-	e.GET("api/v1/objects/:contextName/networkPolicies/:namespace/:name", getObjectNetworkPolicy)
-	e.POST("api/v1/objects/:contextName/networkPolicies/:namespace", createObjectNetworkPolicy)
-	e.PUT("api/v1/objects/:contextName/networkPolicies/:namespace", updateObjectNetworkPolicy)
-	e.DELETE("api/v1/objects/:contextName/networkPolicies/:namespace/:name", deleteObjectNetworkPolicy)
+	e.GET("api/v1/objects/:contextName/networkPolicies/:namespace", getObjectNetworkPolicies, authorize(types.NetworkPolicy, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/networkPolicies/:namespace/:name", getObjectNetworkPolicy, authorize(types.NetworkPolicy, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/networkPolicies/:namespace", createObjectNetworkPolicy, authorize(types.NetworkPolicy, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/networkPolicies/:namespace", updateObjectNetworkPolicy, authorize(types.NetworkPolicy, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/networkPolicies/:namespace/:name", deleteObjectNetworkPolicy, authorize(types.NetworkPolicy, authorization.VerbDelete))
 
 	// Roles
-	e.GET("api/v1/objects/:contextName/roles/:namespace", getObjectRoles)
-	e.GET("api/v1/objects/:contextName/roles/:namespace/:name", getObjectRole)
-	e.POST("api/v1/objects/:contextName/roles/:namespace", createObjectRole)
-	e.PUT("api/v1/objects/:contextName/roles/:namespace", updateObjectRole)
-	e.DELETE("api/v1/objects/:contextName/roles/:namespace/:name", deleteObjectRole)
+	e.GET("api/v1/objects/:contextName/roles/:namespace", getObjectRoles, authorize(types.Role, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/roles/:namespace/:name", getObjectRole, authorize(types.Role, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/roles/:namespace", createObjectRole, authorize(types.Role, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/roles/:namespace", updateObjectRole, authorize(types.Role, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/roles/:namespace/:name", deleteObjectRole, authorize(types.Role, authorization.VerbDelete))
 
 	// RoleBindings
-	e.GET("api/v1/objects/:contextName/roleBindings/:namespace", getObjectRoleBindings)
-	e.GET("api/v1/objects/:contextName/roleBindings/:namespace/:name", getObjectRoleBinding)
-	e.POST("api/v1/objects/:contextName/roleBindings/:namespace", createObjectRoleBinding)
-	e.PUT("api/v1/objects/:contextName/roleBindings/:namespace", updateObjectRoleBinding)
-	e.DELETE("api/v1/objects/:contextName/roleBindings/:namespace/:name", deleteObjectRoleBinding)
+	e.GET("api/v1/objects/:contextName/roleBindings/:namespace", getObjectRoleBindings, authorize(types.RoleBinding, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/roleBindings/:namespace/:name", getObjectRoleBinding, authorize(types.RoleBinding, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/roleBindings/:namespace", createObjectRoleBinding, authorize(types.RoleBinding, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/roleBindings/:namespace", updateObjectRoleBinding, authorize(types.RoleBinding, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/roleBindings/:namespace/:name", deleteObjectRoleBinding, authorize(types.RoleBinding, authorization.VerbDelete))
 
 	// Jobs
-	e.GET("api/v1/objects/:contextName/jobs/:namespace", getObjectJobs)
-	e.GET("api/v1/objects/:contextName/jobs/:namespace/:name", getObjectJob)
-	e.POST("api/v1/objects/:contextName/jobs/:namespace", createObjectJob)
-	e.PUT("api/v1/objects/:contextName/jobs/:namespace", updateObjectJob)
-	e.DELETE("api/v1/objects/:contextName/jobs/:namespace/:name", deleteObjectJob)
+	e.GET("api/v1/objects/:contextName/jobs/:namespace", getObjectJobs, authorize(types.Job, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/jobs/:namespace/:name", getObjectJob, authorize(types.Job, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/jobs/:namespace", createObjectJob, authorize(types.Job, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/jobs/:namespace", updateObjectJob, authorize(types.Job, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/jobs/:namespace/:name", deleteObjectJob, authorize(types.Job, authorization.VerbDelete))
 
 	// CronJobs
-	e.GET("api/v1/objects/:contextName/cronJobs/:namespace", getObjectCronJobs)
-	e.GET("api/v1/objects/:contextName/cronJobs/:namespace/:name", getObjectCronJob)
-	e.POST("api/v1/objects/:contextName/cronJobs/:namespace", createObjectCronJob)
-	e.PUT("api/v1/objects/:contextName/cronJobs/:namespace", updateObjectCronJob)
-	e.DELETE("api/v1/objects/:contextName/cronJobs/:namespace/:name", deleteObjectCronJob)
+	e.GET("api/v1/objects/:contextName/cronJobs/:namespace", getObjectCronJobs, authorize(types.CronJob, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/cronJobs/:namespace/:name", getObjectCronJob, authorize(types.CronJob, authorization.VerbGet))
+	e.POST("api/v1/objects/:contextName/cronJobs/:namespace", createObjectCronJob, authorize(types.CronJob, authorization.VerbCreate))
+	e.PUT("api/v1/objects/:contextName/cronJobs/:namespace", updateObjectCronJob, authorize(types.CronJob, authorization.VerbUpdate))
+	e.DELETE("api/v1/objects/:contextName/cronJobs/:namespace/:name", deleteObjectCronJob, authorize(types.CronJob, authorization.VerbDelete))
 
 }
 
@@ -154,12 +156,12 @@ func getObjectServices(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	services, err := provider.GetServices(contextName, namespace)
+	services, err := provider.GetServices(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, services)
+	return e.JSON(http.StatusOK, maskObject(services))
 }
 
 // getObjectService returns a JSON representation of a service
@@ -182,12 +184,12 @@ func getObjectService(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	service, err := provider.GetService(contextName, namespace, name)
+	service, err := provider.GetService(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, service)
+	return e.JSON(http.StatusOK, maskObject(service))
 }
 
 // createObjectService creates a new service with the given object
@@ -221,12 +223,12 @@ func createObjectService(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateService(contextName, namespace, service)
+	saved, err := provider.CreateService(contextName, getImpersonation(e), namespace, service)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectService updates a service with the given object
@@ -260,12 +262,12 @@ func updateObjectService(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateService(contextName, namespace, service)
+	saved, err := provider.UpdateService(contextName, getImpersonation(e), namespace, service)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectService deletes a service
@@ -286,7 +288,7 @@ func deleteObjectService(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteService(contextName, namespace, name)
+	err := provider.DeleteService(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -312,12 +314,12 @@ func getObjectPods(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	pods, err := provider.GetPods(contextName, namespace)
+	pods, err := provider.GetPods(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, pods)
+	return e.JSON(http.StatusOK, maskObject(pods))
 }
 
 // getObjectPod returns a JSON representation of a pod
@@ -340,12 +342,12 @@ func getObjectPod(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	pod, err := provider.GetPod(contextName, namespace, name)
+	pod, err := provider.GetPod(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, pod)
+	return e.JSON(http.StatusOK, maskObject(pod))
 }
 
 // createObjectPod creates a new pod with the given object
@@ -379,12 +381,12 @@ func createObjectPod(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreatePod(contextName, namespace, pod)
+	saved, err := provider.CreatePod(contextName, getImpersonation(e), namespace, pod)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectPod updates a pod with the given object
@@ -418,12 +420,12 @@ func updateObjectPod(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdatePod(contextName, namespace, pod)
+	saved, err := provider.UpdatePod(contextName, getImpersonation(e), namespace, pod)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectPod deletes a pod
@@ -444,7 +446,7 @@ func deleteObjectPod(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeletePod(contextName, namespace, name)
+	err := provider.DeletePod(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -470,12 +472,12 @@ func getObjectPersistentVolumeClaims(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	persistentVolumeClaims, err := provider.GetPersistentVolumeClaims(contextName, namespace)
+	persistentVolumeClaims, err := provider.GetPersistentVolumeClaims(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, persistentVolumeClaims)
+	return e.JSON(http.StatusOK, maskObject(persistentVolumeClaims))
 }
 
 // getObjectPersistentVolumeClaim returns a JSON representation of a persistentVolumeClaim
@@ -498,12 +500,12 @@ func getObjectPersistentVolumeClaim(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	persistentVolumeClaim, err := provider.GetPersistentVolumeClaim(contextName, namespace, name)
+	persistentVolumeClaim, err := provider.GetPersistentVolumeClaim(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, persistentVolumeClaim)
+	return e.JSON(http.StatusOK, maskObject(persistentVolumeClaim))
 }
 
 // createObjectPersistentVolumeClaim creates a new persistentVolumeClaim with the given object
@@ -537,12 +539,12 @@ func createObjectPersistentVolumeClaim(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreatePersistentVolumeClaim(contextName, namespace, persistentVolumeClaim)
+	saved, err := provider.CreatePersistentVolumeClaim(contextName, getImpersonation(e), namespace, persistentVolumeClaim)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectPersistentVolumeClaim updates a persistentVolumeClaim with the given object
@@ -576,12 +578,12 @@ func updateObjectPersistentVolumeClaim(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdatePersistentVolumeClaim(contextName, namespace, persistentVolumeClaim)
+	saved, err := provider.UpdatePersistentVolumeClaim(contextName, getImpersonation(e), namespace, persistentVolumeClaim)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectPersistentVolumeClaim deletes a persistentVolumeClaim
@@ -602,7 +604,7 @@ func deleteObjectPersistentVolumeClaim(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeletePersistentVolumeClaim(contextName, namespace, name)
+	err := provider.DeletePersistentVolumeClaim(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -618,7 +620,7 @@ func deleteObjectPersistentVolumeClaim(e echo.Context) error {
 // @Produce application/json
 // @Param contextName path string true "the name of the context"
 // @Param namespace path string true "the name of the namespace"
-// @Success 200 {array} ConfigMap
+// @Success 200 {array} MaskedConfigMap
 // @Failure 404 {object} HTTPError
 // @Failure 500 {object} HTTPError
 // @Router /api/v1/objects/{contextName}/configMaps/{namespace} [get]
@@ -628,12 +630,12 @@ func getObjectConfigMaps(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	configMaps, err := provider.GetConfigMaps(contextName, namespace)
+	configMaps, err := provider.GetConfigMaps(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, configMaps)
+	return e.JSON(http.StatusOK, maskObject(configMaps))
 }
 
 // getObjectConfigMap returns a JSON representation of a configMap
@@ -645,7 +647,7 @@ func getObjectConfigMaps(e echo.Context) error {
 // @Param contextName path string true "the name of the context"
 // @Param namespace path string true "the name of the namespace"
 // @Param name path string true "the name of the object"
-// @Success 200 {object} ConfigMap
+// @Success 200 {object} MaskedConfigMap
 // @Failure 404 {object} HTTPError
 // @Failure 500 {object} HTTPError
 // @Router /api/v1/objects/{contextName}/configMaps/{namespace}/{name} [get]
@@ -656,12 +658,12 @@ func getObjectConfigMap(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	configMap, err := provider.GetConfigMap(contextName, namespace, name)
+	configMap, err := provider.GetConfigMap(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, configMap)
+	return e.JSON(http.StatusOK, maskObject(configMap))
 }
 
 // createObjectConfigMap creates a new configMap with the given object
@@ -674,7 +676,7 @@ func getObjectConfigMap(e echo.Context) error {
 // @Param contextName path string true "the name of the context"
 // @Param namespace path string true "the name of the namespace"
 // @Param body body ConfigMap true "the definition of the configMap"
-// @Success 200 {object} ConfigMap
+// @Success 200 {object} MaskedConfigMap
 // @Failure 400 {object} HTTPError
 // @Failure 404 {object} HTTPError
 // @Failure 500 {object} HTTPError
@@ -695,12 +697,12 @@ func createObjectConfigMap(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateConfigMap(contextName, namespace, configMap)
+	saved, err := provider.CreateConfigMap(contextName, getImpersonation(e), namespace, configMap)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectConfigMap updates a configMap with the given object
@@ -713,7 +715,7 @@ func createObjectConfigMap(e echo.Context) error {
 // @Param contextName path string true "the name of the context"
 // @Param namespace path string true "the name of the namespace"
 // @Param body body ConfigMap true "the definition of the configMap"
-// @Success 200 {object} ConfigMap
+// @Success 200 {object} MaskedConfigMap
 // @Failure 400 {object} HTTPError
 // @Failure 404 {object} HTTPError
 // @Failure 500 {object} HTTPError
@@ -734,12 +736,12 @@ func updateObjectConfigMap(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateConfigMap(contextName, namespace, configMap)
+	saved, err := provider.UpdateConfigMap(contextName, getImpersonation(e), namespace, configMap)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectConfigMap deletes a configMap
@@ -760,7 +762,7 @@ func deleteObjectConfigMap(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteConfigMap(contextName, namespace, name)
+	err := provider.DeleteConfigMap(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -786,12 +788,12 @@ func getObjectReplicationControllers(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	replicationControllers, err := provider.GetReplicationControllers(contextName, namespace)
+	replicationControllers, err := provider.GetReplicationControllers(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, replicationControllers)
+	return e.JSON(http.StatusOK, maskObject(replicationControllers))
 }
 
 // getObjectReplicationController returns a JSON representation of a replicationController
@@ -814,12 +816,12 @@ func getObjectReplicationController(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	replicationController, err := provider.GetReplicationController(contextName, namespace, name)
+	replicationController, err := provider.GetReplicationController(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, replicationController)
+	return e.JSON(http.StatusOK, maskObject(replicationController))
 }
 
 // createObjectReplicationController creates a new replicationController with the given object
@@ -853,12 +855,12 @@ func createObjectReplicationController(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateReplicationController(contextName, namespace, replicationController)
+	saved, err := provider.CreateReplicationController(contextName, getImpersonation(e), namespace, replicationController)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectReplicationController updates a replicationController with the given object
@@ -892,12 +894,12 @@ func updateObjectReplicationController(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateReplicationController(contextName, namespace, replicationController)
+	saved, err := provider.UpdateReplicationController(contextName, getImpersonation(e), namespace, replicationController)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectReplicationController deletes a replicationController
@@ -918,7 +920,7 @@ func deleteObjectReplicationController(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteReplicationController(contextName, namespace, name)
+	err := provider.DeleteReplicationController(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -934,7 +936,7 @@ func deleteObjectReplicationController(e echo.Context) error {
 // @Produce application/json
 // @Param contextName path string true "the name of the context"
 // @Param namespace path string true "the name of the namespace"
-// @Success 200 {array} Secret
+// @Success 200 {array} MaskedSecret
 // @Failure 404 {object} HTTPError
 // @Failure 500 {object} HTTPError
 // @Router /api/v1/objects/{contextName}/secrets/{namespace} [get]
@@ -944,12 +946,12 @@ func getObjectSecrets(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	secrets, err := provider.GetSecrets(contextName, namespace)
+	secrets, err := provider.GetSecrets(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, secrets)
+	return e.JSON(http.StatusOK, maskObject(secrets))
 }
 
 // getObjectSecret returns a JSON representation of a secret
@@ -961,7 +963,7 @@ func getObjectSecrets(e echo.Context) error {
 // @Param contextName path string true "the name of the context"
 // @Param namespace path string true "the name of the namespace"
 // @Param name path string true "the name of the object"
-// @Success 200 {object} Secret
+// @Success 200 {object} MaskedSecret
 // @Failure 404 {object} HTTPError
 // @Failure 500 {object} HTTPError
 // @Router /api/v1/objects/{contextName}/secrets/{namespace}/{name} [get]
@@ -972,12 +974,12 @@ func getObjectSecret(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	secret, err := provider.GetSecret(contextName, namespace, name)
+	secret, err := provider.GetSecret(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, secret)
+	return e.JSON(http.StatusOK, maskObject(secret))
 }
 
 // createObjectSecret creates a new secret with the given object
@@ -990,7 +992,7 @@ func getObjectSecret(e echo.Context) error {
 // @Param contextName path string true "the name of the context"
 // @Param namespace path string true "the name of the namespace"
 // @Param body body Secret true "the definition of the secret"
-// @Success 200 {object} Secret
+// @Success 200 {object} MaskedSecret
 // @Failure 400 {object} HTTPError
 // @Failure 404 {object} HTTPError
 // @Failure 500 {object} HTTPError
@@ -1011,12 +1013,12 @@ func createObjectSecret(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateSecret(contextName, namespace, secret)
+	saved, err := provider.CreateSecret(contextName, getImpersonation(e), namespace, secret)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectSecret updates a secret with the given object
@@ -1029,7 +1031,7 @@ func createObjectSecret(e echo.Context) error {
 // @Param contextName path string true "the name of the context"
 // @Param namespace path string true "the name of the namespace"
 // @Param body body Secret true "the definition of the secret"
-// @Success 200 {object} Secret
+// @Success 200 {object} MaskedSecret
 // @Failure 400 {object} HTTPError
 // @Failure 404 {object} HTTPError
 // @Failure 500 {object} HTTPError
@@ -1050,12 +1052,12 @@ func updateObjectSecret(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateSecret(contextName, namespace, secret)
+	saved, err := provider.UpdateSecret(contextName, getImpersonation(e), namespace, secret)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectSecret deletes a secret
@@ -1076,7 +1078,7 @@ func deleteObjectSecret(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteSecret(contextName, namespace, name)
+	err := provider.DeleteSecret(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -1102,12 +1104,12 @@ func getObjectServiceAccounts(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	serviceAccounts, err := provider.GetServiceAccounts(contextName, namespace)
+	serviceAccounts, err := provider.GetServiceAccounts(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, serviceAccounts)
+	return e.JSON(http.StatusOK, maskObject(serviceAccounts))
 }
 
 // getObjectServiceAccount returns a JSON representation of a serviceAccount
@@ -1130,12 +1132,12 @@ func getObjectServiceAccount(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	serviceAccount, err := provider.GetServiceAccount(contextName, namespace, name)
+	serviceAccount, err := provider.GetServiceAccount(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, serviceAccount)
+	return e.JSON(http.StatusOK, maskObject(serviceAccount))
 }
 
 // createObjectServiceAccount creates a new serviceAccount with the given object
@@ -1169,12 +1171,12 @@ func createObjectServiceAccount(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateServiceAccount(contextName, namespace, serviceAccount)
+	saved, err := provider.CreateServiceAccount(contextName, getImpersonation(e), namespace, serviceAccount)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectServiceAccount updates a serviceAccount with the given object
@@ -1208,12 +1210,12 @@ func updateObjectServiceAccount(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateServiceAccount(contextName, namespace, serviceAccount)
+	saved, err := provider.UpdateServiceAccount(contextName, getImpersonation(e), namespace, serviceAccount)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectServiceAccount deletes a serviceAccount
@@ -1234,7 +1236,7 @@ func deleteObjectServiceAccount(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteServiceAccount(contextName, namespace, name)
+	err := provider.DeleteServiceAccount(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -1260,12 +1262,12 @@ func getObjectDeployments(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	deployments, err := provider.GetDeployments(contextName, namespace)
+	deployments, err := provider.GetDeployments(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, deployments)
+	return e.JSON(http.StatusOK, maskObject(deployments))
 }
 
 // getObjectDeployment returns a JSON representation of a deployment
@@ -1288,12 +1290,12 @@ func getObjectDeployment(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	deployment, err := provider.GetDeployment(contextName, namespace, name)
+	deployment, err := provider.GetDeployment(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, deployment)
+	return e.JSON(http.StatusOK, maskObject(deployment))
 }
 
 // createObjectDeployment creates a new deployment with the given object
@@ -1327,12 +1329,12 @@ func createObjectDeployment(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateDeployment(contextName, namespace, deployment)
+	saved, err := provider.CreateDeployment(contextName, getImpersonation(e), namespace, deployment)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectDeployment updates a deployment with the given object
@@ -1366,12 +1368,12 @@ func updateObjectDeployment(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateDeployment(contextName, namespace, deployment)
+	saved, err := provider.UpdateDeployment(contextName, getImpersonation(e), namespace, deployment)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectDeployment deletes a deployment
@@ -1392,7 +1394,7 @@ func deleteObjectDeployment(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteDeployment(contextName, namespace, name)
+	err := provider.DeleteDeployment(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -1418,12 +1420,12 @@ func getObjectStatefulSets(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	statefulSets, err := provider.GetStatefulSets(contextName, namespace)
+	statefulSets, err := provider.GetStatefulSets(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, statefulSets)
+	return e.JSON(http.StatusOK, maskObject(statefulSets))
 }
 
 // getObjectStatefulSet returns a JSON representation of a statefulSet
@@ -1446,12 +1448,12 @@ func getObjectStatefulSet(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	statefulSet, err := provider.GetStatefulSet(contextName, namespace, name)
+	statefulSet, err := provider.GetStatefulSet(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, statefulSet)
+	return e.JSON(http.StatusOK, maskObject(statefulSet))
 }
 
 // createObjectStatefulSet creates a new statefulSet with the given object
@@ -1485,12 +1487,12 @@ func createObjectStatefulSet(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateStatefulSet(contextName, namespace, statefulSet)
+	saved, err := provider.CreateStatefulSet(contextName, getImpersonation(e), namespace, statefulSet)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectStatefulSet updates a statefulSet with the given object
@@ -1524,12 +1526,12 @@ func updateObjectStatefulSet(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateStatefulSet(contextName, namespace, statefulSet)
+	saved, err := provider.UpdateStatefulSet(contextName, getImpersonation(e), namespace, statefulSet)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectStatefulSet deletes a statefulSet
@@ -1550,7 +1552,7 @@ func deleteObjectStatefulSet(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteStatefulSet(contextName, namespace, name)
+	err := provider.DeleteStatefulSet(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -1576,12 +1578,12 @@ func getObjectDaemonSets(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	daemonSets, err := provider.GetDaemonSets(contextName, namespace)
+	daemonSets, err := provider.GetDaemonSets(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, daemonSets)
+	return e.JSON(http.StatusOK, maskObject(daemonSets))
 }
 
 // getObjectDaemonSet returns a JSON representation of a daemonSet
@@ -1604,12 +1606,12 @@ func getObjectDaemonSet(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	daemonSet, err := provider.GetDaemonSet(contextName, namespace, name)
+	daemonSet, err := provider.GetDaemonSet(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, daemonSet)
+	return e.JSON(http.StatusOK, maskObject(daemonSet))
 }
 
 // createObjectDaemonSet creates a new daemonSet with the given object
@@ -1643,12 +1645,12 @@ func createObjectDaemonSet(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateDaemonSet(contextName, namespace, daemonSet)
+	saved, err := provider.CreateDaemonSet(contextName, getImpersonation(e), namespace, daemonSet)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectDaemonSet updates a daemonSet with the given object
@@ -1682,12 +1684,12 @@ func updateObjectDaemonSet(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateDaemonSet(contextName, namespace, daemonSet)
+	saved, err := provider.UpdateDaemonSet(contextName, getImpersonation(e), namespace, daemonSet)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectDaemonSet deletes a daemonSet
@@ -1708,7 +1710,7 @@ func deleteObjectDaemonSet(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteDaemonSet(contextName, namespace, name)
+	err := provider.DeleteDaemonSet(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -1734,12 +1736,12 @@ func getObjectReplicaSets(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	replicaSets, err := provider.GetReplicaSets(contextName, namespace)
+	replicaSets, err := provider.GetReplicaSets(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, replicaSets)
+	return e.JSON(http.StatusOK, maskObject(replicaSets))
 }
 
 // getObjectReplicaSet returns a JSON representation of a replicaSet
@@ -1762,12 +1764,12 @@ func getObjectReplicaSet(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	replicaSet, err := provider.GetReplicaSet(contextName, namespace, name)
+	replicaSet, err := provider.GetReplicaSet(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, replicaSet)
+	return e.JSON(http.StatusOK, maskObject(replicaSet))
 }
 
 // createObjectReplicaSet creates a new replicaSet with the given object
@@ -1801,12 +1803,12 @@ func createObjectReplicaSet(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateReplicaSet(contextName, namespace, replicaSet)
+	saved, err := provider.CreateReplicaSet(contextName, getImpersonation(e), namespace, replicaSet)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectReplicaSet updates a replicaSet with the given object
@@ -1840,12 +1842,12 @@ func updateObjectReplicaSet(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateReplicaSet(contextName, namespace, replicaSet)
+	saved, err := provider.UpdateReplicaSet(contextName, getImpersonation(e), namespace, replicaSet)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectReplicaSet deletes a replicaSet
@@ -1866,7 +1868,7 @@ func deleteObjectReplicaSet(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteReplicaSet(contextName, namespace, name)
+	err := provider.DeleteReplicaSet(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -1892,12 +1894,12 @@ func getObjectNetworkPolicies(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	networkPolicies, err := provider.GetNetworkPolicies(contextName, namespace)
+	networkPolicies, err := provider.GetNetworkPolicies(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, networkPolicies)
+	return e.JSON(http.StatusOK, maskObject(networkPolicies))
 }
 
 // getObjectNetworkPolicy returns a JSON representation of a networkPolicy
@@ -1920,12 +1922,12 @@ func getObjectNetworkPolicy(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	networkPolicy, err := provider.GetNetworkPolicy(contextName, namespace, name)
+	networkPolicy, err := provider.GetNetworkPolicy(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, networkPolicy)
+	return e.JSON(http.StatusOK, maskObject(networkPolicy))
 }
 
 // createObjectNetworkPolicy creates a new networkPolicy with the given object
@@ -1959,12 +1961,12 @@ func createObjectNetworkPolicy(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateNetworkPolicy(contextName, namespace, networkPolicy)
+	saved, err := provider.CreateNetworkPolicy(contextName, getImpersonation(e), namespace, networkPolicy)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectNetworkPolicy updates a networkPolicy with the given object
@@ -1998,12 +2000,12 @@ func updateObjectNetworkPolicy(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateNetworkPolicy(contextName, namespace, networkPolicy)
+	saved, err := provider.UpdateNetworkPolicy(contextName, getImpersonation(e), namespace, networkPolicy)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectNetworkPolicy deletes a networkPolicy
@@ -2024,7 +2026,7 @@ func deleteObjectNetworkPolicy(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteNetworkPolicy(contextName, namespace, name)
+	err := provider.DeleteNetworkPolicy(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -2050,12 +2052,12 @@ func getObjectRoles(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	roles, err := provider.GetRoles(contextName, namespace)
+	roles, err := provider.GetRoles(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, roles)
+	return e.JSON(http.StatusOK, maskObject(roles))
 }
 
 // getObjectRole returns a JSON representation of a role
@@ -2078,12 +2080,12 @@ func getObjectRole(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	role, err := provider.GetRole(contextName, namespace, name)
+	role, err := provider.GetRole(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, role)
+	return e.JSON(http.StatusOK, maskObject(role))
 }
 
 // createObjectRole creates a new role with the given object
@@ -2117,12 +2119,12 @@ func createObjectRole(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateRole(contextName, namespace, role)
+	saved, err := provider.CreateRole(contextName, getImpersonation(e), namespace, role)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectRole updates a role with the given object
@@ -2156,12 +2158,12 @@ func updateObjectRole(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateRole(contextName, namespace, role)
+	saved, err := provider.UpdateRole(contextName, getImpersonation(e), namespace, role)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectRole deletes a role
@@ -2182,7 +2184,7 @@ func deleteObjectRole(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteRole(contextName, namespace, name)
+	err := provider.DeleteRole(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -2208,12 +2210,12 @@ func getObjectRoleBindings(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	roleBindings, err := provider.GetRoleBindings(contextName, namespace)
+	roleBindings, err := provider.GetRoleBindings(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, roleBindings)
+	return e.JSON(http.StatusOK, maskObject(roleBindings))
 }
 
 // getObjectRoleBinding returns a JSON representation of a roleBinding
@@ -2236,12 +2238,12 @@ func getObjectRoleBinding(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	roleBinding, err := provider.GetRoleBinding(contextName, namespace, name)
+	roleBinding, err := provider.GetRoleBinding(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, roleBinding)
+	return e.JSON(http.StatusOK, maskObject(roleBinding))
 }
 
 // createObjectRoleBinding creates a new roleBinding with the given object
@@ -2275,12 +2277,12 @@ func createObjectRoleBinding(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateRoleBinding(contextName, namespace, roleBinding)
+	saved, err := provider.CreateRoleBinding(contextName, getImpersonation(e), namespace, roleBinding)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectRoleBinding updates a roleBinding with the given object
@@ -2314,12 +2316,12 @@ func updateObjectRoleBinding(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateRoleBinding(contextName, namespace, roleBinding)
+	saved, err := provider.UpdateRoleBinding(contextName, getImpersonation(e), namespace, roleBinding)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectRoleBinding deletes a roleBinding
@@ -2340,7 +2342,7 @@ func deleteObjectRoleBinding(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteRoleBinding(contextName, namespace, name)
+	err := provider.DeleteRoleBinding(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -2366,12 +2368,12 @@ func getObjectJobs(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	jobs, err := provider.GetJobs(contextName, namespace)
+	jobs, err := provider.GetJobs(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, jobs)
+	return e.JSON(http.StatusOK, maskObject(jobs))
 }
 
 // getObjectJob returns a JSON representation of a job
@@ -2394,12 +2396,12 @@ func getObjectJob(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	job, err := provider.GetJob(contextName, namespace, name)
+	job, err := provider.GetJob(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, job)
+	return e.JSON(http.StatusOK, maskObject(job))
 }
 
 // createObjectJob creates a new job with the given object
@@ -2433,12 +2435,12 @@ func createObjectJob(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateJob(contextName, namespace, job)
+	saved, err := provider.CreateJob(contextName, getImpersonation(e), namespace, job)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectJob updates a job with the given object
@@ -2472,12 +2474,12 @@ func updateObjectJob(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateJob(contextName, namespace, job)
+	saved, err := provider.UpdateJob(contextName, getImpersonation(e), namespace, job)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectJob deletes a job
@@ -2498,7 +2500,7 @@ func deleteObjectJob(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteJob(contextName, namespace, name)
+	err := provider.DeleteJob(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -2524,12 +2526,12 @@ func getObjectCronJobs(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	cronJobs, err := provider.GetCronJobs(contextName, namespace)
+	cronJobs, err := provider.GetCronJobs(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, cronJobs)
+	return e.JSON(http.StatusOK, maskObject(cronJobs))
 }
 
 // getObjectCronJob returns a JSON representation of a cronJob
@@ -2552,12 +2554,12 @@ func getObjectCronJob(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	cronJob, err := provider.GetCronJob(contextName, namespace, name)
+	cronJob, err := provider.GetCronJob(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, cronJob)
+	return e.JSON(http.StatusOK, maskObject(cronJob))
 }
 
 // createObjectCronJob creates a new cronJob with the given object
@@ -2591,12 +2593,12 @@ func createObjectCronJob(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.CreateCronJob(contextName, namespace, cronJob)
+	saved, err := provider.CreateCronJob(contextName, getImpersonation(e), namespace, cronJob)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusCreated, saved)
+	return e.JSON(http.StatusCreated, maskObject(saved))
 }
 
 // updateObjectCronJob updates a cronJob with the given object
@@ -2630,12 +2632,12 @@ func updateObjectCronJob(e echo.Context) error {
 	}
 
 	// Create the object
-	saved, err := provider.UpdateCronJob(contextName, namespace, cronJob)
+	saved, err := provider.UpdateCronJob(contextName, getImpersonation(e), namespace, cronJob)
 	if err != nil {
 		return getHTTPError(err)
 	}
 
-	return e.JSON(http.StatusOK, saved)
+	return e.JSON(http.StatusOK, maskObject(saved))
 }
 
 // deleteObjectCronJob deletes a cronJob
@@ -2656,7 +2658,7 @@ func deleteObjectCronJob(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	err := provider.DeleteCronJob(contextName, namespace, name)
+	err := provider.DeleteCronJob(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
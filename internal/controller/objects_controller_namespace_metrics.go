@@ -2,21 +2,23 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 //
-// This file was generated by gen_objects_controller_namespace_metrics.go at 2019-06-17 22:10:09.826585088 +0300 EEST m=+0.001195832
+// This file was generated by gen_objects_controller_namespace_metrics.go at 2026-10-18 06:02:38.010504982 +0000 UTC m=+0.001014934
 package controller
 
 import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
 	"github.com/twuillemin/kuboxy/pkg/provider"
+	"github.com/twuillemin/kuboxy/pkg/types"
 )
 
 func registerObjectNamespaceMetricsControllers(e *echo.Echo) {
 
 	// PodMetricses
-	e.GET("api/v1/objects/:contextName/podMetricses/:namespace", getObjectPodMetricses)
-	e.GET("api/v1/objects/:contextName/podMetricses/:namespace/:name", getObjectPodMetrics)
+	e.GET("api/v1/objects/:contextName/podMetricses/:namespace", getObjectPodMetricses, authorize(types.PodMetrics, authorization.VerbList))
+	e.GET("api/v1/objects/:contextName/podMetricses/:namespace/:name", getObjectPodMetrics, authorize(types.PodMetrics, authorization.VerbGet))
 
 }
 
@@ -38,7 +40,7 @@ func getObjectPodMetricses(e echo.Context) error {
 	namespace := e.Param("namespace")
 
 	// Get the state of the cluster
-	podMetricses, err := provider.GetPodMetricses(contextName, namespace)
+	podMetricses, err := provider.GetPodMetricses(contextName, getImpersonation(e), namespace)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -66,7 +68,7 @@ func getObjectPodMetrics(e echo.Context) error {
 	name := e.Param("name")
 
 	// Get the state of the cluster
-	podMetrics, err := provider.GetPodMetrics(contextName, namespace, name)
+	podMetrics, err := provider.GetPodMetrics(contextName, getImpersonation(e), namespace, name)
 	if err != nil {
 		return getHTTPError(err)
 	}
@@ -0,0 +1,138 @@
+package controller
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"unicode/utf8"
+
+	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
+	"github.com/twuillemin/kuboxy/pkg/provider"
+	"github.com/twuillemin/kuboxy/pkg/types"
+)
+
+// The encodings of the revealed values
+const (
+	revealedEncodingText   = "text"
+	revealedEncodingBase64 = "base64"
+)
+
+// RevealedValue is the value of a single key of a Secret or of a ConfigMap
+type RevealedValue struct {
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Encoding string `json:"encoding"`
+}
+
+func registerRevealControllers(e *echo.Echo) {
+
+	e.GET("api/v1/reveal/:contextName/secrets/:namespace/:name/:key", getRevealedSecretValue, requireAuthorizationPolicy, authorize(types.Secret, authorization.VerbReveal))
+	e.GET("api/v1/reveal/:contextName/configMaps/:namespace/:name/:key", getRevealedConfigMapValue, requireAuthorizationPolicy, authorize(types.ConfigMap, authorization.VerbReveal))
+}
+
+// requireAuthorizationPolicy rejects the requests if there is no policy, as revealing a value must be explicitly granted
+func requireAuthorizationPolicy(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+
+		if authorizationPolicy == nil {
+			return echo.NewHTTPError(http.StatusForbidden, "revealing a value requires an authorization policy granting the reveal verb")
+		}
+
+		return next(c)
+	}
+}
+
+// getRevealedSecretValue returns the decoded value of a single key of a Secret
+// @Summary Reveal a value of a secret
+// @Description Reveal the value of a single key of a secret. The caller must be explicitly granted the verb reveal by the authorization policy. The value is given as text if possible, otherwise encoded in base64.
+// @ID get-reveal-secret
+// @Tags Reveal
+// @Produce application/json
+// @Param contextName path string true "the name of the context"
+// @Param namespace path string true "the name of the namespace"
+// @Param name path string true "the name of the secret"
+// @Param key path string true "the key of the value"
+// @Success 200 {object} RevealedValue
+// @Failure 403 {object} HTTPError
+// @Failure 404 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/reveal/{contextName}/secrets/{namespace}/{name}/{key} [get]
+func getRevealedSecretValue(e echo.Context) error {
+
+	contextName := e.Param("contextName")
+	namespace := e.Param("namespace")
+	name := e.Param("name")
+	key := e.Param("key")
+
+	secret, err := provider.GetSecret(contextName, getImpersonation(e), namespace, name)
+	if err != nil {
+		return getHTTPError(err)
+	}
+
+	if value, ok := secret.Data[key]; ok {
+		return e.JSON(http.StatusOK, newRevealedValue(key, value))
+	}
+
+	if value, ok := secret.StringData[key]; ok {
+		return e.JSON(http.StatusOK, newRevealedValue(key, []byte(value)))
+	}
+
+	return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("the secret \"%s\" has no key \"%s\"", name, key))
+}
+
+// getRevealedConfigMapValue returns the decoded value of a single key of a ConfigMap
+// @Summary Reveal a value of a config map
+// @Description Reveal the value of a single key of a config map. The caller must be explicitly granted the verb reveal by the authorization policy. The value is given as text if possible, otherwise encoded in base64.
+// @ID get-reveal-config-map
+// @Tags Reveal
+// @Produce application/json
+// @Param contextName path string true "the name of the context"
+// @Param namespace path string true "the name of the namespace"
+// @Param name path string true "the name of the config map"
+// @Param key path string true "the key of the value"
+// @Success 200 {object} RevealedValue
+// @Failure 403 {object} HTTPError
+// @Failure 404 {object} HTTPError
+// @Failure 500 {object} HTTPError
+// @Router /api/v1/reveal/{contextName}/configMaps/{namespace}/{name}/{key} [get]
+func getRevealedConfigMapValue(e echo.Context) error {
+
+	contextName := e.Param("contextName")
+	namespace := e.Param("namespace")
+	name := e.Param("name")
+	key := e.Param("key")
+
+	configMap, err := provider.GetConfigMap(contextName, getImpersonation(e), namespace, name)
+	if err != nil {
+		return getHTTPError(err)
+	}
+
+	if value, ok := configMap.Data[key]; ok {
+		return e.JSON(http.StatusOK, newRevealedValue(key, []byte(value)))
+	}
+
+	if value, ok := configMap.BinaryData[key]; ok {
+		return e.JSON(http.StatusOK, newRevealedValue(key, value))
+	}
+
+	return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("the config map \"%s\" has no key \"%s\"", name, key))
+}
+
+// newRevealedValue returns a value as text if possible, otherwise encoded in base64
+func newRevealedValue(key string, value []byte) RevealedValue {
+
+	if utf8.Valid(value) {
+		return RevealedValue{
+			Key:      key,
+			Value:    string(value),
+			Encoding: revealedEncodingText,
+		}
+	}
+
+	return RevealedValue{
+		Key:      key,
+		Value:    base64.StdEncoding.EncodeToString(value),
+		Encoding: revealedEncodingBase64,
+	}
+}
@@ -2,19 +2,23 @@ package controller
 
 import (
 	"net/http"
+	"reflect"
 
 	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
 	"github.com/twuillemin/kuboxy/pkg/search"
+	"github.com/twuillemin/kuboxy/pkg/types"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func registerSearchControllers(e *echo.Echo) {
 
-	e.POST("api/v1/search/:contextName", postSearch)
+	e.POST("api/v1/search/:contextName", postSearch, authorize(authorization.Search, authorization.VerbList))
 }
 
 // postSearch searches the context for all kind objects
 // @Summary Search objects
-// @Description Search the context for all kind objects. All the parameters (except the object types) can be given as regexp.
+// @Description Search the context for all kind objects. All the parameters (except the object types) can be given as regexp. The objects that the caller is not allowed to list are not returned.
 // @ID post-search
 // @Tags Search
 // @Accept application/json
@@ -22,6 +26,7 @@ func registerSearchControllers(e *echo.Echo) {
 // @Param contextName path string true "the name of the context"
 // @Param body body search.Parameter true "the parameters of the search"
 // @Success 200 {array} array interface{}
+// @Failure 403 {object} HTTPError
 // @Failure 404 {object} HTTPError
 // @Failure 500 {object} HTTPError
 // @Router /api/v1/search/{contextName} [post]
@@ -36,10 +41,57 @@ func postSearch(e echo.Context) error {
 	}
 
 	// Build the report
-	results, err := search.Search(contextName, *searchParameter)
+	results, err := search.Search(contextName, getImpersonation(e), *searchParameter)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
 
-	return e.JSON(http.StatusOK, results)
+	return e.JSON(http.StatusOK, maskObject(filterSearchResults(e, contextName, results)))
+}
+
+// filterSearchResults removes from the results the objects that the caller is not allowed to list
+func filterSearchResults(e echo.Context, contextName string, results map[types.ObjectType][]interface{}) map[types.ObjectType][]interface{} {
+
+	if authorizationPolicy == nil {
+		return results
+	}
+
+	filteredResults := make(map[types.ObjectType][]interface{})
+	for objectType, objects := range results {
+
+		filteredObjects := make([]interface{}, 0, len(objects))
+		for _, object := range objects {
+			if isAllowed(e, contextName, getSearchResultNamespace(object), objectType, authorization.VerbList) {
+				filteredObjects = append(filteredObjects, object)
+			}
+		}
+
+		// Don't reveal the types that are entirely forbidden
+		if len(filteredObjects) > 0 || isAllowed(e, contextName, "", objectType, authorization.VerbList) {
+			filteredResults[objectType] = filteredObjects
+		}
+	}
+
+	return filteredResults
+}
+
+// getSearchResultNamespace returns the namespace of a Kubernetes object, empty for the objects at the cluster level
+func getSearchResultNamespace(object interface{}) string {
+
+	value := reflect.Indirect(reflect.ValueOf(object))
+	if value.Kind() != reflect.Struct {
+		return ""
+	}
+
+	field := value.FieldByName("ObjectMeta")
+	if !field.IsValid() {
+		return ""
+	}
+
+	objectMeta, ok := field.Interface().(meta.ObjectMeta)
+	if !ok {
+		return ""
+	}
+
+	return objectMeta.Namespace
 }
@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authentication"
+	"github.com/twuillemin/kuboxy/internal/authorization"
+)
+
+func TestSearchAuthorization(t *testing.T) {
+
+	// Alice can only use the objects of her namespace, so she can't search the whole context
+	SetAuthorizationPolicy(&authorization.Policy{
+		Rules: []authorization.Rule{
+			{Subjects: []string{"alice"}, Contexts: []string{"dev"}, Namespaces: []string{"team-a"}, Verbs: []string{authorization.VerbList}},
+			{Subjects: []string{"bob"}, Contexts: []string{"dev"}, Verbs: []string{authorization.VerbList}},
+		},
+	})
+	defer SetAuthorizationPolicy(nil)
+
+	tests := []struct {
+		name      string
+		user      string
+		forbidden bool
+	}{
+		{name: "namespace-scoped policy", user: "alice", forbidden: true},
+		{name: "context-level policy", user: "bob", forbidden: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			e := echo.New()
+			identity := &authentication.Identity{Name: test.user}
+			e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					c.Set(identityKey, identity)
+					return next(c)
+				}
+			})
+			registerSearchControllers(e)
+
+			request := httptest.NewRequest(http.MethodPost, "/api/v1/search/dev", strings.NewReader(`{}`))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			recorder := httptest.NewRecorder()
+			e.ServeHTTP(recorder, request)
+
+			if (recorder.Code == http.StatusForbidden) != test.forbidden {
+				t.Errorf("unexpected status %d: %s", recorder.Code, recorder.Body.String())
+			}
+		})
+	}
+}
@@ -2,19 +2,35 @@ package controller
 
 import (
 	"net/http"
+	"reflect"
 
 	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authorization"
 	"github.com/twuillemin/kuboxy/pkg/report"
+	"github.com/twuillemin/kuboxy/pkg/types"
 )
 
+// summaryObjectTypes gives the type of the objects of each list of the summary
+var summaryObjectTypes = map[string]types.ObjectType{
+	"Namespaces":                   types.Namespace,
+	"Nodes":                        types.Node,
+	"Services":                     types.Service,
+	"Pods":                         types.Pod,
+	"PersistentVolumeReports":      types.PersistentVolume,
+	"PersistentVolumeClaimReports": types.PersistentVolumeClaim,
+	"ConfigMapReports":             types.ConfigMap,
+	"SecretReports":                types.Secret,
+	"Deployments":                  types.Deployment,
+}
+
 func registerSummaryControllers(e *echo.Echo) {
 
-	e.GET("api/v1/summary/:contextName", getSummary)
+	e.GET("api/v1/summary/:contextName", getSummary, authorize(authorization.Summary, authorization.VerbGet))
 }
 
 // getSummary generates a JSON representation of all the information in the given configuration
 // @Summary Get the global status, or summary, of the given configuration
-// @Description get the summary of a configuration
+// @Description get the summary of a configuration. The objects that the caller is not allowed to list are not returned.
 // @ID get-summary
 // @Tags Summary
 // @Produce application/json
@@ -28,10 +44,35 @@ func getSummary(e echo.Context) error {
 	contextName := e.Param("contextName")
 
 	// Build the report
-	stateReport, err := report.BuildReport(contextName)
+	stateReport, err := report.BuildReport(contextName, getImpersonation(e))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
 
-	return e.JSON(http.StatusOK, stateReport)
+	return e.JSON(http.StatusOK, maskObject(filterSummaryReport(e, contextName, stateReport)))
+}
+
+// filterSummaryReport removes from the report the objects that the caller is not allowed to list
+func filterSummaryReport(e echo.Context, contextName string, stateReport *report.ClusterStateReport) *report.ClusterStateReport {
+
+	if authorizationPolicy == nil {
+		return stateReport
+	}
+
+	filteredReport := *stateReport
+	value := reflect.ValueOf(&filteredReport).Elem()
+	for fieldName, objectType := range summaryObjectTypes {
+
+		field := value.FieldByName(fieldName)
+		filteredObjects := reflect.MakeSlice(field.Type(), 0, field.Len())
+		for i := 0; i < field.Len(); i++ {
+			object := field.Index(i)
+			if isAllowed(e, contextName, object.FieldByName("NameSpace").String(), objectType, authorization.VerbList) {
+				filteredObjects = reflect.Append(filteredObjects, object)
+			}
+		}
+		field.Set(filteredObjects)
+	}
+
+	return &filteredReport
 }
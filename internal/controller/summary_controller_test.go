@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/twuillemin/kuboxy/internal/authentication"
+	"github.com/twuillemin/kuboxy/internal/authorization"
+	"github.com/twuillemin/kuboxy/pkg/report"
+)
+
+func TestFilterSummaryReport(t *testing.T) {
+
+	// Alice can only list the objects of her namespace, and the nodes
+	SetAuthorizationPolicy(&authorization.Policy{
+		Rules: []authorization.Rule{
+			{Subjects: []string{"alice"}, Contexts: []string{"dev"}, Namespaces: []string{"team-a"}, Verbs: []string{authorization.VerbList}},
+			{Subjects: []string{"alice"}, Contexts: []string{"dev"}, ObjectTypes: []string{"Node"}, Verbs: []string{authorization.VerbList}},
+		},
+	})
+	defer SetAuthorizationPolicy(nil)
+
+	stateReport := &report.ClusterStateReport{
+		Namespaces:       []report.NamespaceReport{{Name: "team-a"}, {Name: "team-b"}},
+		Nodes:            []report.NodeReport{{Name: "node"}},
+		Pods:             []report.PodReport{{NameSpace: "team-a", Name: "allowed"}, {NameSpace: "team-b", Name: "forbidden"}},
+		ConfigMapReports: []report.ConfigMapReport{{NameSpace: "team-b", Name: "forbidden"}},
+		SecretReports:    []report.SecretReport{{NameSpace: "team-a", Name: "allowed"}, {NameSpace: "team-b", Name: "forbidden"}},
+	}
+
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/api/v1/summary/dev", nil), httptest.NewRecorder())
+	c.Set(identityKey, &authentication.Identity{Name: "alice"})
+
+	filteredReport := filterSummaryReport(c, "dev", stateReport)
+
+	tests := []struct {
+		name     string
+		actual   interface{}
+		expected interface{}
+	}{
+		{"namespaces", filteredReport.Namespaces, []report.NamespaceReport{}},
+		{"nodes", filteredReport.Nodes, stateReport.Nodes},
+		{"pods", filteredReport.Pods, stateReport.Pods[:1]},
+		{"config maps", filteredReport.ConfigMapReports, []report.ConfigMapReport{}},
+		{"secrets", filteredReport.SecretReports, stateReport.SecretReports[:1]},
+		{"original report", len(stateReport.Pods), 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if !reflect.DeepEqual(test.actual, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, test.actual)
+			}
+		})
+	}
+}
+
+func TestSummaryObjectTypes(t *testing.T) {
+
+	// All the lists of the report must be filtered
+	reportType := reflect.TypeOf(report.ClusterStateReport{})
+	for i := 0; i < reportType.NumField(); i++ {
+		if _, ok := summaryObjectTypes[reportType.Field(i).Name]; !ok {
+			t.Errorf("the type of the objects of %s is not defined", reportType.Field(i).Name)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package context
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"time"
+)
+
+// The owners of the certificates checked for expiry
+const (
+	// CertificateOwnerUser is a user of the context configuration
+	CertificateOwnerUser = "user"
+	// CertificateOwnerCluster is a cluster of the context configuration
+	CertificateOwnerCluster = "cluster"
+	// CertificateOwnerApplication is the application itself, serving HTTPS
+	CertificateOwnerApplication = "application"
+)
+
+// CertificateInfo is the description of a certificate found in the configuration. If the certificate can not be read,
+// only its source and the error are given
+type CertificateInfo struct {
+	Source         string     `json:"source"`
+	Subject        string     `json:"subject,omitempty"`
+	Issuer         string     `json:"issuer,omitempty"`
+	DNSNames       []string   `json:"dnsNames,omitempty"`
+	IPAddresses    []string   `json:"ipAddresses,omitempty"`
+	EmailAddresses []string   `json:"emailAddresses,omitempty"`
+	IsCA           bool       `json:"isCA"`
+	NotBefore      *time.Time `json:"notBefore,omitempty"`
+	NotAfter       *time.Time `json:"notAfter,omitempty"`
+	DaysRemaining  int        `json:"daysRemaining"`
+	Expired        bool       `json:"expired"`
+	Error          string     `json:"error,omitempty"`
+}
+
+// ExpiringCertificate is a certificate expiring soon, along with the user, the cluster or the application using it
+type ExpiringCertificate struct {
+	Owner       string          `json:"owner"`
+	Name        string          `json:"name"`
+	Certificate CertificateInfo `json:"certificate"`
+}
+
+// InspectUserCertificates returns the description of the client certificate of a user, either embedded or kept as
+// a local file
+func InspectUserCertificates(user NamedUser) []CertificateInfo {
+
+	certificates := make([]CertificateInfo, 0)
+
+	if len(user.DefinitionUser.ClientCertificateData) > 0 {
+		data, err := decodeData(user.DefinitionUser.ClientCertificateData)
+		certificates = append(certificates, inspectCertificates("client-certificate-data", data, err)...)
+	}
+
+	if len(user.DefinitionUser.ClientCertificate) > 0 {
+		certificates = append(certificates, InspectCertificateFile("client-certificate", resolvePath(user.DefinitionUser.ClientCertificate))...)
+	}
+
+	return certificates
+}
+
+// InspectClusterCertificates returns the description of the certificate authorities of a cluster, either embedded
+// or kept as a local file
+func InspectClusterCertificates(cluster NamedCluster) []CertificateInfo {
+
+	certificates := make([]CertificateInfo, 0)
+
+	if len(cluster.DefinitionCluster.CertificateAuthorityData) > 0 {
+		data, err := decodeData(cluster.DefinitionCluster.CertificateAuthorityData)
+		certificates = append(certificates, inspectCertificates("certificate-authority-data", data, err)...)
+	}
+
+	if len(cluster.DefinitionCluster.CertificateAuthority) > 0 {
+		certificates = append(certificates, InspectCertificateFile("certificate-authority", resolvePath(cluster.DefinitionCluster.CertificateAuthority))...)
+	}
+
+	return certificates
+}
+
+// InspectCertificateFile returns the description of the certificates of a PEM file
+func InspectCertificateFile(source string, fileName string) []CertificateInfo {
+
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		err = fmt.Errorf("unable to read the certificate file %s due to: %v", fileName, err.Error())
+	}
+
+	return inspectCertificates(source, data, err)
+}
+
+// GetExpiringCertificates returns the certificates of the users and of the clusters expiring within the given number
+// of days. The certificates already expired and the certificates that can not be read are also returned
+func GetExpiringCertificates(days int) ([]ExpiringCertificate, error) {
+
+	config, err := GetKubeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	expiringCertificates := make([]ExpiringCertificate, 0)
+
+	for _, user := range config.Users {
+		expiringCertificates = append(expiringCertificates, SelectExpiringCertificates(CertificateOwnerUser, user.Name, InspectUserCertificates(user), days)...)
+	}
+
+	for _, cluster := range config.Clusters {
+		expiringCertificates = append(expiringCertificates, SelectExpiringCertificates(CertificateOwnerCluster, cluster.Name, InspectClusterCertificates(cluster), days)...)
+	}
+
+	return expiringCertificates, nil
+}
+
+// SelectExpiringCertificates keeps the certificates expiring within the given number of days, or that can not be read
+func SelectExpiringCertificates(owner string, name string, certificates []CertificateInfo, days int) []ExpiringCertificate {
+
+	limit := time.Now().Add(time.Duration(days) * 24 * time.Hour)
+
+	expiringCertificates := make([]ExpiringCertificate, 0)
+	for _, certificate := range certificates {
+		if certificate.NotAfter == nil || certificate.NotAfter.Before(limit) {
+			expiringCertificates = append(expiringCertificates, ExpiringCertificate{
+				Owner:       owner,
+				Name:        name,
+				Certificate: certificate,
+			})
+		}
+	}
+
+	return expiringCertificates
+}
+
+// checkContextCertificates returns an error for each certificate of the user and of the cluster of a context that is
+// expired or not yet valid, as the client only reports them as a generic TLS failure
+func checkContextCertificates(contextName string) []error {
+
+	config, err := GetKubeConfig()
+	if err != nil {
+		return nil
+	}
+
+	context := findContext(config, contextName)
+	if context == nil {
+		return nil
+	}
+
+	errors := make([]error, 0)
+
+	if user := findUser(config, context.DefinitionContext.User); user != nil {
+		for _, certificate := range InspectUserCertificates(*user) {
+			if err := checkCertificateValidity(certificate); err != nil {
+				errors = append(errors, fmt.Errorf("the client certificate of the user \"%s\" %v", user.Name, err.Error()))
+			}
+		}
+	}
+
+	if cluster := findCluster(config, context.DefinitionContext.Cluster); cluster != nil {
+		for _, certificate := range InspectClusterCertificates(*cluster) {
+			if err := checkCertificateValidity(certificate); err != nil {
+				errors = append(errors, fmt.Errorf("the certificate authority of the cluster \"%s\" %v", cluster.Name, err.Error()))
+			}
+		}
+	}
+
+	return errors
+}
+
+// checkCertificateValidity checks that a certificate is readable and in its validity period
+func checkCertificateValidity(certificate CertificateInfo) error {
+
+	if len(certificate.Error) > 0 {
+		return fmt.Errorf("is not readable: %v", certificate.Error)
+	}
+
+	if certificate.Expired {
+		return fmt.Errorf("(%s) expired on %s", certificate.Subject, certificate.NotAfter.Format(time.RFC3339))
+	}
+
+	if time.Now().Before(*certificate.NotBefore) {
+		return fmt.Errorf("(%s) is not valid before %s", certificate.Subject, certificate.NotBefore.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// inspectCertificates parses all the certificates of a PEM content. If the content could not be read, the error is
+// given instead
+func inspectCertificates(source string, data []byte, err error) []CertificateInfo {
+
+	if err != nil {
+		return []CertificateInfo{{Source: source, Error: err.Error()}}
+	}
+
+	certificates := make([]CertificateInfo, 0)
+
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			certificates = append(certificates, CertificateInfo{Source: source, Error: fmt.Sprintf("unable to parse the certificate due to: %v", err.Error())})
+			continue
+		}
+
+		certificates = append(certificates, describeCertificate(source, certificate))
+	}
+
+	if len(certificates) == 0 {
+		return []CertificateInfo{{Source: source, Error: "no certificate found in PEM format"}}
+	}
+
+	return certificates
+}
+
+// describeCertificate converts a certificate
+func describeCertificate(source string, certificate *x509.Certificate) CertificateInfo {
+
+	remaining := time.Until(certificate.NotAfter)
+
+	info := CertificateInfo{
+		Source:         source,
+		Subject:        certificate.Subject.String(),
+		Issuer:         certificate.Issuer.String(),
+		DNSNames:       certificate.DNSNames,
+		EmailAddresses: certificate.EmailAddresses,
+		IsCA:           certificate.IsCA,
+		NotBefore:      &certificate.NotBefore,
+		NotAfter:       &certificate.NotAfter,
+		DaysRemaining:  int(math.Floor(remaining.Hours() / 24)),
+		Expired:        remaining <= 0,
+	}
+
+	for _, ipAddress := range certificate.IPAddresses {
+		info.IPAddresses = append(info.IPAddresses, ipAddress.String())
+	}
+
+	return info
+}
@@ -3,105 +3,139 @@ package context
 import (
 	"fmt"
 	"github.com/twuillemin/kuboxy/internal/configuration"
-	"gopkg.in/yaml.v2"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/rest"
 	metrics "k8s.io/metrics/pkg/client/clientset/versioned"
-	"os"
-	"path"
-	// Uncomment the following line to load the gcp plugin (only required to authenticate against GKE clusters).
-	// _ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"path/filepath"
+	// Load the authentication provider plugins (gcp, azure, oidc, openstack) used by the auth-provider users
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
 )
 
-// The known contextNames (bool is not used)
-var contextNames = make(map[string]bool)
+// The registry of all the known contexts and their connection
+var registry = NewRegistry()
 
-// The relations between all the known configuration and their connection
-var clientsets = make(map[string]*kubernetes.Clientset)
+// The store of the context configuration
+var configStore ConfigStore
 
-// The relations between all the known configuration and their connection
-var versionedClientsets = make(map[string]*metrics.Clientset)
-
-// Internal copy of the context configuration file name
+// Internal copy of the context configuration file name, empty if the configuration is not kept in a file
 var contextConfigurationFileName = ""
 
-// LoadContexts loads all the possible configuration from a Kubectl config file. If there are no configuration, try to default
-// to the InCluster mode
+// LoadContexts loads all the possible configuration from the store defined in the configuration of the application,
+// by default a Kubectl config file. If the application is running in a Kubernetes cluster, a context giving access to
+// the hosting cluster is also declared
 func LoadContexts(applicationConfiguration configuration.ApplicationConfiguration) error {
 
-	// Keep the name of context configuration file (could be something like ~/.kube/config) as it could be used multiple
-	// times
-	contextConfigurationFileName = applicationConfiguration.KubeContextConfigurationFile
+	store, err := NewConfigStore(applicationConfiguration)
+	if err != nil {
+		return err
+	}
+
+	return LoadContextsFromStore(store, applicationConfiguration)
+}
+
+// LoadContextsFromStore loads all the possible configuration from the given store
+func LoadContextsFromStore(store ConfigStore, applicationConfiguration configuration.ApplicationConfiguration) error {
+
+	configStore = store
 
-	// Ensure there is a context file (even empty)
-	if err := ensureContextConfigFile(); err != nil {
+	registry.SetImpersonationCacheSize(applicationConfiguration.ImpersonationCacheSize)
+	setReadOnly(applicationConfiguration)
+
+	// Keep the name of context configuration file (could be something like ~/.kube/config) as it is used for resolving
+	// the relative paths
+	contextConfigurationFileName = ""
+	if fileStore, ok := store.(*fileConfigStore); ok {
+		contextConfigurationFileName = fileStore.fileName
+	}
+
+	// Read the key for encrypting the credentials if any
+	key, err := LoadCredentialsKey(applicationConfiguration.CredentialsKeyFile)
+	if err != nil {
+		return err
+	}
+	credentialsKey = key
+
+	// Ensure there is a context configuration (even empty)
+	if err = ensureContextConfig(); err != nil {
 		return err
 	}
 
+	// Import the kubectl configuration if asked
+	if applicationConfiguration.SeedKubeConfig {
+		if err = seedKubeConfig(); err != nil {
+			return err
+		}
+	}
+
 	config, err := GetKubeConfig()
 	if err != nil {
 		return err
 	}
 
+	// Only the certificate files known at startup, or kept in an allowed directory, can be embedded when exporting
+	setInlineableCertificateFiles(config, applicationConfiguration)
+
 	// Otherwise declare the configuration found (but do not make the client set)
 	for i := 0; i < len(config.Contexts); i++ {
-		contextNames[config.Contexts[i].Name] = true
+		registry.Register(config.Contexts[i].Name)
 	}
 
-	return nil
+	// Declare the hosting cluster if running in a pod. A context of the file with the same name takes precedence
+	inClusterContextName = ""
+	if len(applicationConfiguration.InClusterContextName) > 0 && isInClusterAvailable() {
+		inClusterContextName = applicationConfiguration.InClusterContextName
+		if findContext(config, inClusterContextName) == nil {
+			registry.Register(inClusterContextName)
+		}
+	}
+
+	// Keep the current state of the file, so that only the later modifications are reloaded
+	return initWatchState()
 }
 
-// ensureContextConfigFile ensures that the given context configuration file exist and is readable. If needed create
-// an empty one
-func ensureContextConfigFile() (err error) {
+// seedKubeConfig imports the clusters, users and contexts of the kubectl configuration files. Existing objects are
+// never overwritten
+func seedKubeConfig() error {
 
-	// If the context file exists, just return
-	if _, err = os.Stat(contextConfigurationFileName); err == nil {
-		return nil
-	} else if !os.IsNotExist(err) {
-		return fmt.Errorf("unable to access to the context configuration file due to: %v", err.Error())
-	}
-
-	// Get the directory for the configuration files
-	directory := path.Dir(contextConfigurationFileName)
+	for _, fileName := range getDefaultKubeConfigFileNames() {
 
-	// If the context file does not exist, create it
-	if _, err = os.Stat(directory); err != nil {
-		if !os.IsNotExist(err) {
-			return fmt.Errorf("unable to access to the directory for context configuration file due to: %v", err.Error())
+		// Don't import the context configuration file into itself
+		if absFileName, err := filepath.Abs(fileName); err == nil {
+			if absContextFileName, err := filepath.Abs(contextConfigurationFileName); err == nil && absFileName == absContextFileName {
+				continue
+			}
 		}
-		if err = os.MkdirAll(directory, 0700); err != nil {
-			return fmt.Errorf("unable to create to the directory for context configuration file due to: %v", err.Error())
+
+		if _, err := ImportKubeConfigFile(fileName, ImportOptions{Strategy: Skip}); err != nil {
+			return err
 		}
 	}
 
-	// At this point the file does not exist, so just create an empty one
-	newContextFile, err := os.Create(contextConfigurationFileName)
-	if err != nil {
-		return fmt.Errorf("unable to create a new context configuration file due to: %v", err.Error())
-	}
+	return nil
+}
 
-	defer func() {
-		err = newContextFile.Close()
-	}()
+// ensureContextConfig ensures that the context configuration exists in its store. If needed create an empty one
+func ensureContextConfig() error {
 
-	if err = os.Chmod(contextConfigurationFileName, 0600); err != nil {
-		return fmt.Errorf("unable to define access rights to the new context configuration file due to: %v", err.Error())
+	configLock.Lock()
+	defer configLock.Unlock()
+
+	// If the configuration exists, just return
+	data, err := configStore.Load()
+	if err != nil {
+		return err
+	}
+	if data != nil {
+		return nil
 	}
 
-	// Create a default configuration file content
+	// Create a default configuration content
 	emptyConfig := KubeConfig{
 		APIVersion:  "v1",
 		Kind:        "Config",
-		Preferences: make(map[string]string),
+		Preferences: make(map[string]interface{}),
 	}
-	data, err := yaml.Marshal(&emptyConfig)
-	if err != nil {
-		return fmt.Errorf("unable to create default context content due to: %v", err.Error())
-	}
-
-	// Write the default content to the config file
-	if _, err = newContextFile.Write(data); err != nil {
+	if err = writeKubeConfig(configStore, &emptyConfig); err != nil {
 		return fmt.Errorf("unable to write the default context content due to: %v", err.Error())
 	}
 
@@ -110,93 +144,86 @@ func ensureContextConfigFile() (err error) {
 
 // GetContextNames gives the list of all contextNames known by the application
 func GetContextNames() []string {
-	result := make([]string, 0, len(contextNames))
-	for contextName := range contextNames {
-		result = append(result, contextName)
-	}
-	return result
+	return registry.Names()
+}
+
+// GetContextStates gives the list of all contexts known by the application along with their connection state
+func GetContextStates() []ContextState {
+	return registry.States()
 }
 
 // GetClientset gives a clientset for the given contextName
 func GetClientset(contextName string) (*kubernetes.Clientset, error) {
+	return registry.GetClientset(contextName)
+}
 
-	// Try to get it from the cache
-	existingClientset := clientsets[contextName]
-	if existingClientset != nil {
-		return existingClientset, nil
-	}
-
-	// Check if the contextName exists in the list of possible contextNames
-	if _, ok := contextNames[contextName]; !ok {
-		return nil, &NotFoundError{contextName}
-	}
+// GetMetrics gives a clientset for the given contextName
+func GetMetrics(contextName string) (*metrics.Clientset, error) {
+	return registry.GetMetrics(contextName)
+}
 
-	// Update the configuration
-	err := UseContext(contextName)
-	if err != nil {
-		return nil, err
-	}
+// GetImpersonatedClientset gives a clientset for the given contextName impersonating the given identity. Without
+// impersonation, the clientset uses the user of the context
+func GetImpersonatedClientset(contextName string, impersonation *Impersonation) (*kubernetes.Clientset, error) {
+	return registry.GetImpersonatedClientset(contextName, impersonation)
+}
 
-	// Try to build an empty config, that should default to in cluster mode
-	config, err := clientcmd.BuildConfigFromFlags("", contextConfigurationFileName)
-	if err != nil {
-		return nil, err
-	}
+// GetImpersonatedMetrics gives a metrics clientset for the given contextName impersonating the given identity.
+// Without impersonation, the clientset uses the user of the context
+func GetImpersonatedMetrics(contextName string, impersonation *Impersonation) (*metrics.Clientset, error) {
+	return registry.GetImpersonatedMetrics(contextName, impersonation)
+}
 
-	// Set High QPS and Burst because we may query intensively when doing the report
-	config.QPS = 1e6
-	config.Burst = 1e6
+// getImpersonatedRestConfig builds the configuration for connecting to the given contextName with the given identity
+func getImpersonatedRestConfig(contextName string, impersonation *Impersonation) (*rest.Config, error) {
 
-	// Try to connect with the update config
-	clientset, err := kubernetes.NewForConfig(config)
+	config, err := getRestConfig(contextName)
 	if err != nil {
 		return nil, err
 	}
 
-	// Keep the client set
-	clientsets[contextName] = clientset
+	if impersonation != nil {
+		config.Impersonate = rest.ImpersonationConfig{
+			UserName: impersonation.UserName,
+			Groups:   impersonation.Groups,
+		}
+	}
 
-	return clientset, nil
+	return config, nil
 }
 
-// GetMetrics gives a clientset for the given contextName
-func GetMetrics(contextName string) (*metrics.Clientset, error) {
+// getRestConfig builds the configuration for connecting to the given contextName
+func getRestConfig(contextName string) (*rest.Config, error) {
 
-	// Try to get it from the cache
-	existingClientset := versionedClientsets[contextName]
-	if existingClientset != nil {
-		return existingClientset, nil
-	}
+	config, _, err := getRestConfigAndNamespace(contextName)
+	return config, err
+}
 
-	// Check if the contextName exists in the list of possible contextNames
-	if _, ok := contextNames[contextName]; !ok {
-		return nil, &NotFoundError{contextName}
-	}
+// getRestConfigAndNamespace builds the configuration for connecting to the given contextName, along with its default
+// namespace
+func getRestConfigAndNamespace(contextName string) (*rest.Config, string, error) {
 
-	// Update the configuration
-	err := UseContext(contextName)
+	// The configuration is read once, as the store is loaded and decoded at each read
+	kubeConfig, err := GetKubeConfig()
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	// Try to build an empty config, that should default to in cluster mode
-	config, err := clientcmd.BuildConfigFromFlags("", contextConfigurationFileName)
+	config, namespace, err := buildContextRestConfig(kubeConfig, contextName)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Set High QPS and Burst because we may query intensively when doing the report
 	config.QPS = 1e6
 	config.Burst = 1e6
 
-	// Try to connect with the update config
-	versioned, err := metrics.NewForConfig(config)
-	if err != nil {
-		return nil, err
+	// Apply the settings specific to the context if any
+	if settings := findClientSettings(kubeConfig, contextName); settings != nil {
+		if err = applyClientSettings(config, settings.DefinitionClientSettings); err != nil {
+			return nil, "", fmt.Errorf("unable to apply the client settings of the context \"%s\" due to: %v", contextName, err.Error())
+		}
 	}
 
-	// Keep the client set
-	versionedClientsets[contextName] = versioned
-
-	return versioned, nil
+	return config, namespace, nil
 }
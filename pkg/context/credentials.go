@@ -0,0 +1,320 @@
+package context
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// CredentialsKeyEnvironmentVariable is the environment variable giving the key used to encrypt the credentials, encoded
+// in base64. It takes precedence over the key file
+const CredentialsKeyEnvironmentVariable = "KUBOXY_CREDENTIALS_KEY"
+
+// The prefix of the encrypted values, followed by the nonce and the encrypted value encoded in base64
+const encryptedValuePrefix = "kuboxy-encrypted:v1:"
+
+// The size of the AES-256 keys
+const credentialsKeySize = 32
+
+// The keys of the configuration of the authentication providers holding credentials
+var secretAuthProviderKeys = map[string]bool{
+	"client-secret": true,
+	"id-token":      true,
+	"refresh-token": true,
+	"access-token":  true,
+}
+
+// IsSecretAuthProviderKey checks if a key of the configuration of the authentication providers holds credentials
+func IsSecretAuthProviderKey(key string) bool {
+	return secretAuthProviderKeys[key]
+}
+
+// The key used to encrypt the credentials, nil if the credentials are kept in clear text
+var credentialsKey []byte
+
+// LoadCredentialsKey reads the key used to encrypt the credentials from the environment variable or from the given
+// file. The key is a 32 bytes value, encoded in base64 or given raw in the file. If no key is defined, nil is returned
+func LoadCredentialsKey(keyFileName string) ([]byte, error) {
+
+	if value := os.Getenv(CredentialsKeyEnvironmentVariable); len(value) > 0 {
+		return decodeCredentialsKey([]byte(value), CredentialsKeyEnvironmentVariable)
+	}
+
+	if len(keyFileName) == 0 {
+		return nil, nil
+	}
+
+	return ReadCredentialsKeyFile(keyFileName)
+}
+
+// ReadCredentialsKeyFile reads the key used to encrypt the credentials from a file. The key is a 32 bytes value,
+// encoded in base64 or given raw
+func ReadCredentialsKeyFile(keyFileName string) ([]byte, error) {
+
+	data, err := ioutil.ReadFile(keyFileName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the credentials key file due to: %v", err.Error())
+	}
+
+	return decodeCredentialsKey(data, keyFileName)
+}
+
+// GenerateCredentialsKey generates a new random key for encrypting the credentials, encoded in base64
+func GenerateCredentialsKey() (string, error) {
+
+	key := make([]byte, credentialsKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// decodeCredentialsKey decodes a key given in base64 or raw
+func decodeCredentialsKey(data []byte, source string) ([]byte, error) {
+
+	if len(data) == credentialsKeySize {
+		return data, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != credentialsKeySize {
+		return nil, fmt.Errorf("the credentials key from %s must be %d bytes, given raw or encoded in base64", source, credentialsKeySize)
+	}
+
+	return key, nil
+}
+
+// EncryptCredentials encrypts all the credentials of a configuration kept in a store. The credentials already
+// encrypted are decrypted with the key, or with oldKey when the key is rotated, and encrypted again with the key. If a
+// credential can't be decrypted with any of these keys, nothing is modified. Returns the number of values encrypted
+func EncryptCredentials(store ConfigStore, key []byte, oldKey []byte) (int, error) {
+
+	if len(key) != credentialsKeySize {
+		return 0, fmt.Errorf("the credentials key must be %d bytes", credentialsKeySize)
+	}
+
+	source, err := store.Load()
+	if err != nil {
+		return 0, err
+	}
+	if source == nil {
+		return 0, fmt.Errorf("the context configuration does not exist")
+	}
+
+	config, err := unmarshalKubeConfig(source)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for i := range config.Users {
+
+		user := &config.Users[i]
+
+		user.DefinitionUser, err = transformUserCredentials(user.Name, user.DefinitionUser, func(path string, value string) (string, error) {
+			clearValue, e := decryptRotatedValue(key, oldKey, path, value)
+			if e != nil {
+				return "", e
+			}
+			count++
+			return encryptValue(key, path, clearValue)
+		})
+		if err != nil {
+			return 0, fmt.Errorf("unable to encrypt the credentials of the user \"%s\" due to: %v", user.Name, err.Error())
+		}
+	}
+
+	return count, writeKubeConfig(store, config)
+}
+
+// decryptRotatedValue decrypts a value of the configuration with the key or with the old key. Values in clear text
+// are returned as they are
+func decryptRotatedValue(key []byte, oldKey []byte, path string, value string) (string, error) {
+
+	if !isEncryptedValue(value) {
+		return value, nil
+	}
+
+	if oldKey != nil {
+		if clearValue, err := decryptValue(oldKey, path, value); err == nil {
+			return clearValue, nil
+		}
+	}
+
+	if clearValue, err := decryptValue(key, path, value); err == nil {
+		return clearValue, nil
+	}
+
+	if oldKey == nil {
+		return "", fmt.Errorf("the value of %s is encrypted with another key, which must be given for rotating it", path)
+	}
+
+	return "", fmt.Errorf("the value of %s can't be decrypted with the given keys", path)
+}
+
+// DecryptUserCredentials decrypts in memory the credentials of a user read from the configuration
+func DecryptUserCredentials(user *NamedUser) error {
+
+	definition, err := transformUserCredentials(user.Name, user.DefinitionUser, func(path string, value string) (string, error) {
+		return decryptValue(credentialsKey, path, value)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to decrypt the credentials of the user \"%s\" due to: %v", user.Name, err.Error())
+	}
+
+	user.DefinitionUser = definition
+
+	return nil
+}
+
+// encryptUsersCredentials returns a copy of the users in which the credentials are encrypted, if the encryption is
+// enabled
+func encryptUsersCredentials(users []NamedUser) ([]NamedUser, error) {
+
+	if credentialsKey == nil {
+		return users, nil
+	}
+
+	result := make([]NamedUser, 0, len(users))
+	for _, user := range users {
+
+		definition, err := transformUserCredentials(user.Name, user.DefinitionUser, func(path string, value string) (string, error) {
+			// A value looking encrypted is only kept if it really is, otherwise it is a value in clear text
+			if isEncryptedValue(value) {
+				if _, e := decryptValue(credentialsKey, path, value); e == nil {
+					return value, nil
+				}
+			}
+			return encryptValue(credentialsKey, path, value)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to encrypt the credentials of the user \"%s\" due to: %v", user.Name, err.Error())
+		}
+
+		user.DefinitionUser = definition
+		result = append(result, user)
+	}
+
+	return result, nil
+}
+
+// transformUserCredentials returns a copy of the user in which each non empty credential is transformed by the given
+// function, which also receives the path of the credential in the configuration. The credentials are the password, the
+// token, the client key, the values of the exec environment and the tokens and secrets of the auth-provider
+func transformUserCredentials(userName string, user DefinitionUser, transform func(path string, value string) (string, error)) (DefinitionUser, error) {
+
+	var err error
+
+	transformValue := func(field string, value string) string {
+		if err != nil || len(value) == 0 {
+			return value
+		}
+		var result string
+		result, err = transform("users/"+userName+"/"+field, value)
+		return result
+	}
+
+	user.Password = transformValue("password", user.Password)
+	user.Token = transformValue("token", user.Token)
+	user.ClientKeyData = transformValue("client-key-data", user.ClientKeyData)
+
+	if user.Exec != nil {
+		exec := *user.Exec
+		exec.Env = make([]ExecEnvVar, 0, len(user.Exec.Env))
+		for _, env := range user.Exec.Env {
+			env.Value = transformValue("exec/env/"+env.Name, env.Value)
+			exec.Env = append(exec.Env, env)
+		}
+		user.Exec = &exec
+	}
+
+	if user.AuthProvider != nil {
+		authProvider := *user.AuthProvider
+		authProvider.Config = make(map[string]string, len(user.AuthProvider.Config))
+		for key, value := range user.AuthProvider.Config {
+			if secretAuthProviderKeys[key] {
+				value = transformValue("auth-provider/config/"+key, value)
+			}
+			authProvider.Config[key] = value
+		}
+		user.AuthProvider = &authProvider
+	}
+
+	return user, err
+}
+
+// isEncryptedValue checks if a value of the configuration is encrypted
+func isEncryptedValue(value string) bool {
+	return strings.HasPrefix(value, encryptedValuePrefix)
+}
+
+// encryptValue encrypts a value with AES-GCM. The path of the value is authenticated along the value, so that an
+// encrypted value can't be moved to another credential
+func encryptValue(key []byte, path string, value string) (string, error) {
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(value), []byte(path))
+
+	return encryptedValuePrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptValue decrypts a value encrypted by encryptValue for the same path. Values in clear text are returned as they
+// are
+func decryptValue(key []byte, path string, value string) (string, error) {
+
+	if !isEncryptedValue(value) {
+		return value, nil
+	}
+
+	if key == nil {
+		return "", fmt.Errorf("the credentials are encrypted but no key is defined")
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, encryptedValuePrefix))
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return "", fmt.Errorf("the encrypted value is too short")
+	}
+
+	data, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], []byte(path))
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt the value of %s, the key is probably not the one used for encrypting it", path)
+	}
+
+	return string(data), nil
+}
+
+// newGCM creates the AES-GCM cipher for a key
+func newGCM(key []byte) (cipher.AEAD, error) {
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,198 @@
+package context
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// newTestCredentialsKey returns a key made of the given byte
+func newTestCredentialsKey(value byte) []byte {
+	return bytes.Repeat([]byte{value}, credentialsKeySize)
+}
+
+// encryptTestValue encrypts a value for the tests, failing them if the encryption is not possible
+func encryptTestValue(t *testing.T, key []byte, path string, value string) string {
+
+	encrypted, err := encryptValue(key, path, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return encrypted
+}
+
+func TestEncryptCredentials(t *testing.T) {
+
+	key := newTestCredentialsKey(1)
+	oldKey := newTestCredentialsKey(2)
+	otherKey := newTestCredentialsKey(3)
+
+	tests := []struct {
+		name          string
+		token         string
+		password      string
+		oldKey        []byte
+		expectedCount int
+		expectError   bool
+	}{
+		{
+			name:          "clear text",
+			token:         "admin-token",
+			password:      "admin-password",
+			expectedCount: 2,
+		},
+		{
+			name:          "already encrypted with the key",
+			token:         encryptTestValue(t, key, "users/admin/token", "admin-token"),
+			password:      "admin-password",
+			expectedCount: 2,
+		},
+		{
+			name:          "rotation",
+			token:         encryptTestValue(t, oldKey, "users/admin/token", "admin-token"),
+			password:      encryptTestValue(t, oldKey, "users/admin/password", "admin-password"),
+			oldKey:        oldKey,
+			expectedCount: 2,
+		},
+		{
+			name:        "rotation without the old key",
+			token:       encryptTestValue(t, oldKey, "users/admin/token", "admin-token"),
+			password:    "admin-password",
+			expectError: true,
+		},
+		{
+			name:        "value encrypted with an unknown key",
+			token:       encryptTestValue(t, otherKey, "users/admin/token", "admin-token"),
+			password:    encryptTestValue(t, oldKey, "users/admin/password", "admin-password"),
+			oldKey:      oldKey,
+			expectError: true,
+		},
+		{
+			name:        "clear text looking encrypted",
+			token:       encryptedValuePrefix + "admin-token",
+			password:    "admin-password",
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			original := "users:\n- name: admin\n  user:\n    token: " + test.token + "\n    password: " + test.password + "\n"
+			store := NewMemoryConfigStore([]byte(original))
+
+			count, err := EncryptCredentials(store, key, test.oldKey)
+
+			if test.expectError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				if data, _ := store.Load(); string(data) != original {
+					t.Errorf("the configuration was modified:\n%s", data)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if count != test.expectedCount {
+				t.Errorf("expected %d values encrypted, got %d", test.expectedCount, count)
+			}
+
+			data, _ := store.Load()
+			config, err := unmarshalKubeConfig(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			user := config.Users[0]
+			if !isEncryptedValue(user.DefinitionUser.Token) || !isEncryptedValue(user.DefinitionUser.Password) {
+				t.Fatalf("the credentials are not encrypted: %+v", user.DefinitionUser)
+			}
+
+			credentialsKey = key
+			defer func() { credentialsKey = nil }()
+
+			if err = DecryptUserCredentials(&user); err != nil {
+				t.Fatalf("unable to decrypt with the key: %v", err)
+			}
+			if user.DefinitionUser.Token != "admin-token" || user.DefinitionUser.Password != "admin-password" {
+				t.Errorf("unexpected decrypted credentials: %+v", user.DefinitionUser)
+			}
+		})
+	}
+}
+
+func TestEncryptUsersCredentials(t *testing.T) {
+
+	credentialsKey = newTestCredentialsKey(1)
+	defer func() { credentialsKey = nil }()
+
+	encrypted := encryptTestValue(t, credentialsKey, "users/admin/token", "admin-token")
+
+	tests := []struct {
+		name        string
+		token       string
+		expectKept  bool
+		expectClear string
+	}{
+		{name: "clear text", token: "admin-token", expectClear: "admin-token"},
+		{name: "encrypted", token: encrypted, expectKept: true, expectClear: "admin-token"},
+		{name: "clear text looking encrypted", token: encryptedValuePrefix + "admin-token", expectClear: encryptedValuePrefix + "admin-token"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			users, err := encryptUsersCredentials([]NamedUser{{Name: "admin", DefinitionUser: DefinitionUser{Token: test.token}}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			user := users[0]
+			if test.expectKept != (user.DefinitionUser.Token == test.token) {
+				t.Errorf("unexpected encrypted value %s", user.DefinitionUser.Token)
+			}
+
+			if err = DecryptUserCredentials(&user); err != nil {
+				t.Fatalf("unable to decrypt: %v", err)
+			}
+			if user.DefinitionUser.Token != test.expectClear {
+				t.Errorf("expected the token %s, got %s", test.expectClear, user.DefinitionUser.Token)
+			}
+		})
+	}
+}
+
+func TestDecryptValueBoundToPath(t *testing.T) {
+
+	key := newTestCredentialsKey(1)
+	encrypted := encryptTestValue(t, key, "users/admin/token", "admin-token")
+
+	tests := []struct {
+		name        string
+		path        string
+		expectError bool
+	}{
+		{name: "same path", path: "users/admin/token"},
+		{name: "other field", path: "users/admin/password", expectError: true},
+		{name: "other user", path: "users/viewer/token", expectError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			value, err := decryptValue(key, test.path, encrypted)
+			if test.expectError {
+				if err == nil || !strings.Contains(err.Error(), test.path) {
+					t.Errorf("expected an error for %s, got %v", test.path, err)
+				}
+				return
+			}
+			if err != nil || value != "admin-token" {
+				t.Errorf("unexpected value %s, error %v", value, err)
+			}
+		})
+	}
+}
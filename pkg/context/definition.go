@@ -1,62 +1,123 @@
 package context
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // NamedContext is a Kubectl configuration, the association of a user and a cluster. This struct holds only a name and the
 // actual definition structure
 type NamedContext struct {
-	Name              string            `yaml:"name" json:"name"`
-	DefinitionContext DefinitionContext `yaml:"context,omitempty" json:"context,omitempty"`
+	Name              string                 `yaml:"name" json:"name"`
+	DefinitionContext DefinitionContext      `yaml:"context,omitempty" json:"context,omitempty"`
+	Extra             map[string]interface{} `yaml:",inline" json:"-"`
 }
 
 // DefinitionContext is the actual definition of a Kubectl configuration
 type DefinitionContext struct {
-	User      string `yaml:"user,omitempty" json:"user"`
-	Cluster   string `yaml:"cluster,omitempty" json:"cluster"`
-	Namespace string `yaml:"namespace,omitempty" json:"namespace,omitempty"`
+	User      string                 `yaml:"user,omitempty" json:"user"`
+	Cluster   string                 `yaml:"cluster,omitempty" json:"cluster"`
+	Namespace string                 `yaml:"namespace,omitempty" json:"namespace,omitempty"`
+	Extra     map[string]interface{} `yaml:",inline" json:"-"`
 }
 
-// NamedCluster is a Kubernetes cluster. This struct holds only a name and the
-//// actual definition structure
+// NamedCluster is a Kubernetes cluster. This struct holds only a name and the actual definition structure. The
+// description of the certificates is only given in the responses of the configuration endpoints
 type NamedCluster struct {
-	Name              string            `yaml:"name" json:"name"`
-	DefinitionCluster DefinitionCluster `yaml:"cluster,omitempty" json:"cluster,omitempty"`
+	Name              string                 `yaml:"name" json:"name"`
+	DefinitionCluster DefinitionCluster      `yaml:"cluster,omitempty" json:"cluster,omitempty"`
+	Certificates      []CertificateInfo      `yaml:"-" json:"certificates,omitempty"`
+	Extra             map[string]interface{} `yaml:",inline" json:"-"`
 }
 
 // DefinitionCluster is the actual definition of a Kubernetes cluster
 type DefinitionCluster struct {
-	Server                   string `yaml:"server,omitempty" json:"server,omitempty"`
-	InsecureSkipTLSVerify    bool   `yaml:"insecure-skip-tls-verify,omitempty" json:"insecure-skip-tls-verify,omitempty"`
-	CertificateAuthority     string `yaml:"certificate-authority,omitempty" json:"certificate-authority,omitempty"`
-	CertificateAuthorityData string `yaml:"certificate-authority-data,omitempty" json:"certificate-authority-data,omitempty"`
+	Server                   string                 `yaml:"server,omitempty" json:"server,omitempty"`
+	InsecureSkipTLSVerify    bool                   `yaml:"insecure-skip-tls-verify,omitempty" json:"insecure-skip-tls-verify,omitempty"`
+	CertificateAuthority     string                 `yaml:"certificate-authority,omitempty" json:"certificate-authority,omitempty"`
+	CertificateAuthorityData string                 `yaml:"certificate-authority-data,omitempty" json:"certificate-authority-data,omitempty"`
+	Extra                    map[string]interface{} `yaml:",inline" json:"-"`
 }
 
-// NamedUser is a Kubernetes user. This struct holds only a name and the
-//// actual definition structure
+// NamedUser is a Kubernetes user. This struct holds only a name and the actual definition structure. The description
+// of the certificates is only given in the responses of the configuration endpoints
 type NamedUser struct {
-	Name           string         `yaml:"name" json:"name"`
-	DefinitionUser DefinitionUser `yaml:"user,omitempty" json:"user,omitempty"`
+	Name           string                 `yaml:"name" json:"name"`
+	DefinitionUser DefinitionUser         `yaml:"user,omitempty" json:"user,omitempty"`
+	Certificates   []CertificateInfo      `yaml:"-" json:"certificates,omitempty"`
+	Extra          map[string]interface{} `yaml:",inline" json:"-"`
 }
 
-// DefinitionUser is the actual definition of a Kubernetes user
+// DefinitionUser is the actual definition of a Kubernetes user. In the responses of the configuration endpoints, the
+// password, the token, the embedded certificate and key, the values of the exec environment and the tokens and
+// secrets of the auth-provider are replaced by "REDACTED", unless they are explicitly revealed
 type DefinitionUser struct {
-	UserName              string `yaml:"username,omitempty" json:"username,omitempty"`
-	Password              string `yaml:"password,omitempty" json:"password,omitempty"`
-	ClientCertificate     string `yaml:"client-certificate,omitempty" json:"client-certificate,omitempty"`
-	ClientKey             string `yaml:"client-key,omitempty" json:"client-key,omitempty"`
-	ClientCertificateData string `yaml:"client-certificate-data,omitempty" json:"client-certificate-data,omitempty"`
-	ClientKeyData         string `yaml:"client-key-data,omitempty" json:"client-key-data,omitempty"`
+	UserName              string                 `yaml:"username,omitempty" json:"username,omitempty"`
+	Password              string                 `yaml:"password,omitempty" json:"password,omitempty"`
+	ClientCertificate     string                 `yaml:"client-certificate,omitempty" json:"client-certificate,omitempty"`
+	ClientKey             string                 `yaml:"client-key,omitempty" json:"client-key,omitempty"`
+	ClientCertificateData string                 `yaml:"client-certificate-data,omitempty" json:"client-certificate-data,omitempty"`
+	ClientKeyData         string                 `yaml:"client-key-data,omitempty" json:"client-key-data,omitempty"`
+	Token                 string                 `yaml:"token,omitempty" json:"token,omitempty"`
+	TokenFile             string                 `yaml:"tokenFile,omitempty" json:"tokenFile,omitempty"`
+	Exec                  *ExecConfig            `yaml:"exec,omitempty" json:"exec,omitempty"`
+	AuthProvider          *AuthProviderConfig    `yaml:"auth-provider,omitempty" json:"auth-provider,omitempty"`
+	Extra                 map[string]interface{} `yaml:",inline" json:"-"`
 }
 
-// KubeConfig is the complete configuration of a kubectl config file
+// ExecConfig is the definition of an external command providing the credentials of a user
+type ExecConfig struct {
+	Command    string                 `yaml:"command" json:"command"`
+	Args       []string               `yaml:"args,omitempty" json:"args,omitempty"`
+	Env        []ExecEnvVar           `yaml:"env,omitempty" json:"env,omitempty"`
+	APIVersion string                 `yaml:"apiVersion,omitempty" json:"apiVersion,omitempty"`
+	Extra      map[string]interface{} `yaml:",inline" json:"-"`
+}
+
+// ExecEnvVar is an environment variable given to the external command providing the credentials of a user
+type ExecEnvVar struct {
+	Name  string                 `yaml:"name" json:"name"`
+	Value string                 `yaml:"value" json:"value"`
+	Extra map[string]interface{} `yaml:",inline" json:"-"`
+}
+
+// AuthProviderConfig is the definition of an authentication provider (gcp, oidc, azure, ...) and of its configuration
+type AuthProviderConfig struct {
+	Name   string                 `yaml:"name" json:"name"`
+	Config map[string]string      `yaml:"config,omitempty" json:"config,omitempty"`
+	Extra  map[string]interface{} `yaml:",inline" json:"-"`
+}
+
+// KubeConfig is the complete configuration of a kubectl config file. The Extra fields of the structures keep the
+// entries not modelled by the application (extensions, proxy-url, ...) so that they are written back untouched
 type KubeConfig struct {
-	APIVersion     string            `yaml:"apiVersion" json:"apiVersion"`
-	Kind           string            `yaml:"kind" json:"kind"`
-	Preferences    map[string]string `yaml:"preferences" json:"preferences"`
-	Clusters       []NamedCluster    `yaml:"clusters,omitempty" json:"clusters,omitempty"`
-	Contexts       []NamedContext    `yaml:"contexts,omitempty" json:"contexts,omitempty"`
-	CurrentContext string            `yaml:"current-context,omitempty" json:"current-context,omitempty"`
-	Users          []NamedUser       `yaml:"users,omitempty" json:"users,omitempty"`
+	APIVersion     string                 `yaml:"apiVersion" json:"apiVersion"`
+	Kind           string                 `yaml:"kind" json:"kind"`
+	Preferences    map[string]interface{} `yaml:"preferences" json:"preferences"`
+	Clusters       []NamedCluster         `yaml:"clusters,omitempty" json:"clusters,omitempty"`
+	Contexts       []NamedContext         `yaml:"contexts,omitempty" json:"contexts,omitempty"`
+	CurrentContext string                 `yaml:"current-context,omitempty" json:"current-context,omitempty"`
+	Users          []NamedUser            `yaml:"users,omitempty" json:"users,omitempty"`
+	ClientSettings []NamedClientSettings  `yaml:"kuboxy-client-settings,omitempty" json:"kuboxy-client-settings,omitempty"`
+	Extra          map[string]interface{} `yaml:",inline" json:"-"`
+}
+
+// NamedClientSettings are the settings of the clients used by the application for a context. These settings are
+// specific to the application and ignored by kubectl. This struct holds only the name of the context and the actual
+// definition structure
+type NamedClientSettings struct {
+	Name                     string                   `yaml:"name" json:"name"`
+	DefinitionClientSettings DefinitionClientSettings `yaml:"settings,omitempty" json:"settings,omitempty"`
+}
+
+// DefinitionClientSettings is the actual definition of the settings of the clients of a context. Empty values keep
+// the default behaviour of the application
+type DefinitionClientSettings struct {
+	QPS             float32 `yaml:"qps,omitempty" json:"qps,omitempty"`
+	Burst           int     `yaml:"burst,omitempty" json:"burst,omitempty"`
+	Timeout         string  `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+	UserAgentSuffix string  `yaml:"user-agent-suffix,omitempty" json:"user-agent-suffix,omitempty"`
+	ProxyURL        string  `yaml:"proxy-url,omitempty" json:"proxy-url,omitempty"`
 }
 
 // ParamCredentialsUserNamePassword  is the definition of a credential with a username and a password
@@ -77,6 +138,30 @@ type ParamCredentialsCertificateEmbedded struct {
 	ClientKeyData         string `json:"clientKeyData"`
 }
 
+// ParamCredentialsToken  is the definition of a credential with a bearer token
+type ParamCredentialsToken struct {
+	Token string `json:"token"`
+}
+
+// ParamCredentialsTokenFile  is the definition of a credential with a bearer token kept as a local file
+type ParamCredentialsTokenFile struct {
+	TokenFile string `json:"tokenFile"`
+}
+
+// ParamCredentialsExec  is the definition of a credential provided by an external command
+type ParamCredentialsExec struct {
+	Command    string       `json:"command"`
+	Args       []string     `json:"args"`
+	Env        []ExecEnvVar `json:"env"`
+	APIVersion string       `json:"apiVersion"`
+}
+
+// ParamCredentialsAuthProvider  is the definition of a credential provided by an authentication provider
+type ParamCredentialsAuthProvider struct {
+	Name   string            `json:"name"`
+	Config map[string]string `json:"config"`
+}
+
 // ParamClusterInsecure  is the definition of a cluster for which the TLS certificate is not verified
 type ParamClusterInsecure struct {
 	Server string `json:"server"`
@@ -101,6 +186,16 @@ type ParamContext struct {
 	Namespace string `json:"namespace"`
 }
 
+// ParamClientSettings  is the definition of the settings of the clients of a context. The timeout is a duration such
+// as "30s" and the proxy an URL such as "http://proxy:3128"
+type ParamClientSettings struct {
+	QPS             float32 `json:"qps"`
+	Burst           int     `json:"burst"`
+	Timeout         string  `json:"timeout"`
+	UserAgentSuffix string  `json:"userAgentSuffix"`
+	ProxyURL        string  `json:"proxyURL"`
+}
+
 // NotFoundError is a trivial implementation of error.
 type NotFoundError struct {
 	contextName string
@@ -114,3 +209,58 @@ func (e *NotFoundError) Error() string {
 func (e *NotFoundError) ContextName() string {
 	return e.contextName
 }
+
+// ObjectNotFoundError is returned when trying to delete a user or a cluster that does not exist
+type ObjectNotFoundError struct {
+	objectType string
+	name       string
+}
+
+func (e *ObjectNotFoundError) Error() string {
+	return fmt.Sprintf("the %s \"%s\" does not exist", e.objectType, e.name)
+}
+
+// ReferencedError is returned when trying to delete a user or a cluster still used by some contexts
+type ReferencedError struct {
+	objectType   string
+	name         string
+	contextNames []string
+}
+
+func (e *ReferencedError) Error() string {
+	return fmt.Sprintf("the %s \"%s\" is still used by the contexts: %s", e.objectType, e.name, strings.Join(e.contextNames, ", "))
+}
+
+// ContextNames returns the name of the contexts still using the object
+func (e *ReferencedError) ContextNames() []string {
+	return e.contextNames
+}
+
+// ReadOnlyError is returned when trying to modify the objects of a read-only context
+type ReadOnlyError struct {
+	contextName string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("the context \"%s\" is read-only", e.contextName)
+}
+
+// ContextName returns the name of the read-only context
+func (e *ReadOnlyError) ContextName() string {
+	return e.contextName
+}
+
+// InlineNotAllowedError is returned when trying to embed in an exported configuration a file that was neither in the
+// configuration when the application started nor in an allowed directory
+type InlineNotAllowedError struct {
+	fileName string
+}
+
+func (e *InlineNotAllowedError) Error() string {
+	return fmt.Sprintf("the file \"%s\" can't be embedded as it was not in the configuration when the application started nor in an allowed directory", e.fileName)
+}
+
+// FileName returns the name of the file that can't be embedded
+func (e *InlineNotAllowedError) FileName() string {
+	return e.fileName
+}
@@ -0,0 +1,284 @@
+package context
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/twuillemin/kuboxy/internal/configuration"
+)
+
+// The certificate files referenced by the configuration when the application started, which can be embedded in the
+// exported configurations
+var startupCertificateFiles = make(map[string]bool)
+
+// The directories whose files can be embedded in the exported configurations
+var inlineCertificateDirectories []string
+
+// ExportOptions are the options for exporting a kubeconfig
+type ExportOptions struct {
+	// The contexts to export
+	ContextNames []string
+	// If true, the certificates given as local files are embedded in the exported configuration
+	InlineCertificates bool
+	// The current context of the exported configuration. If empty, the first exported context is used
+	CurrentContext string
+}
+
+// ExportKubeConfig builds a self-contained configuration holding only the requested contexts, along with the clusters
+// and the users they are referencing
+func ExportKubeConfig(options ExportOptions) (*KubeConfig, error) {
+
+	if len(options.ContextNames) == 0 {
+		return nil, fmt.Errorf("at least one context must be exported")
+	}
+
+	config, err := GetKubeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	exported := &KubeConfig{
+		APIVersion:  "v1",
+		Kind:        "Config",
+		Preferences: make(map[string]interface{}),
+	}
+
+	exportedClusters := make(map[string]bool)
+	exportedUsers := make(map[string]bool)
+	exportedContexts := make(map[string]bool)
+
+	for _, contextName := range options.ContextNames {
+
+		if exportedContexts[contextName] {
+			continue
+		}
+
+		context := findContext(config, contextName)
+		if context == nil {
+			return nil, &NotFoundError{contextName}
+		}
+		exported.Contexts = append(exported.Contexts, *context)
+		exportedContexts[contextName] = true
+
+		clusterName := context.DefinitionContext.Cluster
+		if !exportedClusters[clusterName] {
+			cluster := findCluster(config, clusterName)
+			if cluster == nil {
+				return nil, fmt.Errorf("the cluster %s used by the context %s does not exist", clusterName, contextName)
+			}
+			if options.InlineCertificates {
+				if err = inlineClusterCertificates(cluster); err != nil {
+					return nil, err
+				}
+			}
+			exported.Clusters = append(exported.Clusters, *cluster)
+			exportedClusters[clusterName] = true
+		}
+
+		userName := context.DefinitionContext.User
+		if !exportedUsers[userName] {
+			user := findUser(config, userName)
+			if user == nil {
+				return nil, fmt.Errorf("the user %s used by the context %s does not exist", userName, contextName)
+			}
+			if options.InlineCertificates {
+				if err = inlineUserCertificates(user); err != nil {
+					return nil, err
+				}
+			}
+			// The exported configuration is used by other tools, so the credentials are given in clear text
+			if err = DecryptUserCredentials(user); err != nil {
+				return nil, err
+			}
+			exported.Users = append(exported.Users, *user)
+			exportedUsers[userName] = true
+		}
+	}
+
+	// Define the current context
+	exported.CurrentContext = options.CurrentContext
+	if len(exported.CurrentContext) == 0 {
+		exported.CurrentContext = options.ContextNames[0]
+	}
+	if !exportedContexts[exported.CurrentContext] {
+		return nil, fmt.Errorf("the current context %s is not one of the exported contexts", exported.CurrentContext)
+	}
+
+	return exported, nil
+}
+
+// inlineClusterCertificates replaces the certificate authority file of the cluster by its content
+func inlineClusterCertificates(cluster *NamedCluster) error {
+
+	definition := &cluster.DefinitionCluster
+
+	if len(definition.CertificateAuthority) > 0 {
+		data, err := readFileAsBase64(definition.CertificateAuthority)
+		if _, ok := err.(*InlineNotAllowedError); ok {
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("unable to inline the certificate authority of the cluster %s due to: %v", cluster.Name, err.Error())
+		}
+		definition.CertificateAuthorityData = data
+		definition.CertificateAuthority = ""
+	}
+
+	return nil
+}
+
+// inlineUserCertificates replaces the client certificate and key files of the user by their content
+func inlineUserCertificates(user *NamedUser) error {
+
+	definition := &user.DefinitionUser
+
+	if len(definition.ClientCertificate) > 0 {
+		data, err := readFileAsBase64(definition.ClientCertificate)
+		if _, ok := err.(*InlineNotAllowedError); ok {
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("unable to inline the client certificate of the user %s due to: %v", user.Name, err.Error())
+		}
+		definition.ClientCertificateData = data
+		definition.ClientCertificate = ""
+	}
+
+	if len(definition.ClientKey) > 0 {
+		data, err := readFileAsBase64(definition.ClientKey)
+		if _, ok := err.(*InlineNotAllowedError); ok {
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("unable to inline the client key of the user %s due to: %v", user.Name, err.Error())
+		}
+		definition.ClientKeyData = data
+		definition.ClientKey = ""
+	}
+
+	return nil
+}
+
+// readFileAsBase64 reads a file referenced by the configuration and returns its content encoded in base64, as
+// expected by the *-data fields. As the files can be referenced by any caller allowed to modify the configuration,
+// only the files referenced when the application started or kept in an allowed directory are read
+func readFileAsBase64(fileName string) (string, error) {
+
+	path, err := getCanonicalPath(fileName)
+	if err != nil {
+		return "", err
+	}
+
+	if !isInlineAllowed(path) {
+		return "", &InlineNotAllowedError{fileName}
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// setInlineableCertificateFiles keeps the certificate files referenced by the configuration when the application
+// starts and the directories allowed by the configuration of the application
+func setInlineableCertificateFiles(config *KubeConfig, applicationConfiguration configuration.ApplicationConfiguration) {
+
+	startupCertificateFiles = make(map[string]bool)
+
+	fileNames := make([]string, 0)
+	for _, cluster := range config.Clusters {
+		fileNames = append(fileNames, cluster.DefinitionCluster.CertificateAuthority)
+	}
+	for _, user := range config.Users {
+		fileNames = append(fileNames, user.DefinitionUser.ClientCertificate, user.DefinitionUser.ClientKey)
+	}
+
+	for _, fileName := range fileNames {
+		if len(fileName) == 0 {
+			continue
+		}
+		if path, err := getCanonicalPath(fileName); err == nil {
+			startupCertificateFiles[path] = true
+		}
+	}
+
+	inlineCertificateDirectories = make([]string, 0, len(applicationConfiguration.InlineCertificateDirectories))
+	for _, directory := range applicationConfiguration.InlineCertificateDirectories {
+		if path, err := getCanonicalPath(directory); err == nil {
+			inlineCertificateDirectories = append(inlineCertificateDirectories, path)
+		}
+	}
+}
+
+// isInlineAllowed checks if a file, given by its canonical path, can be embedded in an exported configuration
+func isInlineAllowed(path string) bool {
+
+	if startupCertificateFiles[path] {
+		return true
+	}
+
+	for _, directory := range inlineCertificateDirectories {
+		if relative, err := filepath.Rel(directory, path); err == nil && relative != ".." && !strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getCanonicalPath returns the absolute path of a file referenced by the configuration, the symbolic links being
+// resolved
+func getCanonicalPath(fileName string) (string, error) {
+
+	path, err := filepath.Abs(resolvePath(fileName))
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.EvalSymlinks(path)
+}
+
+// findContext returns the context with the given name, nil if not present
+func findContext(config *KubeConfig, name string) *NamedContext {
+	for i := 0; i < len(config.Contexts); i++ {
+		if config.Contexts[i].Name == name {
+			return &(config.Contexts[i])
+		}
+	}
+	return nil
+}
+
+// findCluster returns the cluster with the given name, nil if not present
+func findCluster(config *KubeConfig, name string) *NamedCluster {
+	for i := 0; i < len(config.Clusters); i++ {
+		if config.Clusters[i].Name == name {
+			return &(config.Clusters[i])
+		}
+	}
+	return nil
+}
+
+// findUser returns the user with the given name, nil if not present
+func findUser(config *KubeConfig, name string) *NamedUser {
+	for i := 0; i < len(config.Users); i++ {
+		if config.Users[i].Name == name {
+			return &(config.Users[i])
+		}
+	}
+	return nil
+}
+
+// findClientSettings returns the settings of the clients of the context with the given name, nil if not present
+func findClientSettings(config *KubeConfig, name string) *NamedClientSettings {
+	for i := 0; i < len(config.ClientSettings); i++ {
+		if config.ClientSettings[i].Name == name {
+			return &(config.ClientSettings[i])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,389 @@
+package context
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+)
+
+// ConflictStrategy defines what to do when an imported user, cluster or context has the same name than an existing one
+type ConflictStrategy string
+
+const (
+	// Skip keeps the existing object and ignores the imported one
+	Skip ConflictStrategy = "skip"
+	// Overwrite replaces the existing object by the imported one
+	Overwrite ConflictStrategy = "overwrite"
+	// Rename adds the imported object under a new name, made of its name and a suffix
+	Rename ConflictStrategy = "rename"
+)
+
+// DefaultRenameSuffix is the suffix used by the Rename strategy when none is given
+const DefaultRenameSuffix = "-imported"
+
+// ImportAction is the action done on an imported object
+type ImportAction string
+
+const (
+	// Added is used when the imported object did not exist
+	Added ImportAction = "added"
+	// Overwritten is used when the imported object replaced an existing one
+	Overwritten ImportAction = "overwritten"
+	// Renamed is used when the imported object was added under a new name
+	Renamed ImportAction = "renamed"
+	// Skipped is used when the imported object was ignored
+	Skipped ImportAction = "skipped"
+)
+
+// ImportOptions are the options for importing a kubeconfig
+type ImportOptions struct {
+	Strategy ConflictStrategy
+	Suffix   string
+	DryRun   bool
+}
+
+// ImportChange is the change done, or that would be done, for a single imported object
+type ImportChange struct {
+	ObjectType string       `json:"objectType"`
+	Name       string       `json:"name"`
+	Action     ImportAction `json:"action"`
+	NewName    string       `json:"newName,omitempty"`
+	Reason     string       `json:"reason,omitempty"`
+}
+
+// ImportReport is the result of an import
+type ImportReport struct {
+	DryRun  bool           `json:"dryRun"`
+	Changes []ImportChange `json:"changes"`
+}
+
+// ParseKubeConfig reads a kubeconfig document, either in YAML or in JSON
+func ParseKubeConfig(data []byte) (*KubeConfig, error) {
+
+	config, err := unmarshalKubeConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the kubeconfig due to: %v", err.Error())
+	}
+
+	if len(config.Kind) > 0 && config.Kind != "Config" {
+		return nil, fmt.Errorf("the document is of kind %s and not a kubeconfig", config.Kind)
+	}
+
+	return config, nil
+}
+
+// ImportKubeConfig merges the clusters, users and contexts of the given kubeconfig document (YAML or JSON) into the
+// context configuration file
+func ImportKubeConfig(data []byte, options ImportOptions) (*ImportReport, error) {
+
+	imported, err := ParseKubeConfig(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return MergeKubeConfig(imported, options)
+}
+
+// ImportKubeConfigFile merges the clusters, users and contexts of the given kubeconfig file into the context
+// configuration file. As for kubectl, the relative paths of the file are relative to its directory
+func ImportKubeConfigFile(fileName string, options ImportOptions) (*ImportReport, error) {
+
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the kubeconfig file %s due to: %v", fileName, err.Error())
+	}
+
+	imported, err := ParseKubeConfig(data)
+	if err != nil {
+		return nil, err
+	}
+
+	directory, err := filepath.Abs(filepath.Dir(fileName))
+	if err != nil {
+		return nil, err
+	}
+	rebaseRelativePaths(imported, directory)
+
+	return MergeKubeConfig(imported, options)
+}
+
+// rebaseRelativePaths makes the relative paths of the certificates and of the token files of a configuration relative
+// to the given directory, so that they are still valid once merged in the context configuration file
+func rebaseRelativePaths(config *KubeConfig, directory string) {
+
+	rebase := func(fileName string) string {
+		if len(fileName) == 0 || filepath.IsAbs(fileName) {
+			return fileName
+		}
+		return filepath.Join(directory, fileName)
+	}
+
+	for i := range config.Clusters {
+		definition := &config.Clusters[i].DefinitionCluster
+		definition.CertificateAuthority = rebase(definition.CertificateAuthority)
+	}
+
+	for i := range config.Users {
+		definition := &config.Users[i].DefinitionUser
+		definition.ClientCertificate = rebase(definition.ClientCertificate)
+		definition.ClientKey = rebase(definition.ClientKey)
+		definition.TokenFile = rebase(definition.TokenFile)
+	}
+}
+
+// MergeKubeConfig merges the clusters, users and contexts of the given configuration into the context
+// configuration file
+func MergeKubeConfig(imported *KubeConfig, options ImportOptions) (*ImportReport, error) {
+
+	switch options.Strategy {
+	case Skip, Overwrite, Rename:
+	case "":
+		options.Strategy = Skip
+	default:
+		return nil, fmt.Errorf("the conflict strategy %s is not valid", options.Strategy)
+	}
+
+	if len(options.Suffix) == 0 {
+		options.Suffix = DefaultRenameSuffix
+	}
+
+	configLock.Lock()
+	defer configLock.Unlock()
+
+	config, err := GetKubeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ImportReport{
+		DryRun:  options.DryRun,
+		Changes: make([]ImportChange, 0),
+	}
+
+	// The names given to the imported clusters and users, so that contexts can follow the renaming
+	clusterNames := make(map[string]string)
+	userNames := make(map[string]string)
+
+	// The imported clusters and users skipped while differing from the existing ones, which can't be used by the
+	// imported contexts
+	conflictingClusters := make(map[string]bool)
+	conflictingUsers := make(map[string]bool)
+
+	// The contexts for which the connection has to be rebuilt
+	invalidatedUsers := make(map[string]bool)
+	invalidatedClusters := make(map[string]bool)
+	updatedContexts := make([]string, 0)
+
+	for _, cluster := range imported.Clusters {
+
+		existingNames := make(map[string]bool)
+		for _, c := range config.Clusters {
+			existingNames[c.Name] = true
+		}
+
+		change := resolveImportChange("cluster", cluster.Name, existingNames, options)
+		report.Changes = append(report.Changes, change)
+
+		switch change.Action {
+		case Added:
+			config.Clusters = append(config.Clusters, cluster)
+		case Overwritten:
+			for i := range config.Clusters {
+				if config.Clusters[i].Name == cluster.Name {
+					config.Clusters[i] = cluster
+				}
+			}
+			invalidatedClusters[cluster.Name] = true
+		case Renamed:
+			cluster.Name = change.NewName
+			config.Clusters = append(config.Clusters, cluster)
+		case Skipped:
+			if existing := findCluster(config, cluster.Name); existing != nil && !reflect.DeepEqual(existing.DefinitionCluster, cluster.DefinitionCluster) {
+				conflictingClusters[cluster.Name] = true
+			}
+		}
+		clusterNames[change.Name] = cluster.Name
+	}
+
+	for _, user := range imported.Users {
+
+		existingNames := make(map[string]bool)
+		for _, u := range config.Users {
+			existingNames[u.Name] = true
+		}
+
+		change := resolveImportChange("user", user.Name, existingNames, options)
+		report.Changes = append(report.Changes, change)
+
+		switch change.Action {
+		case Added:
+			config.Users = append(config.Users, user)
+		case Overwritten:
+			for i := range config.Users {
+				if config.Users[i].Name == user.Name {
+					config.Users[i] = user
+				}
+			}
+			invalidatedUsers[user.Name] = true
+		case Renamed:
+			user.Name = change.NewName
+			config.Users = append(config.Users, user)
+		case Skipped:
+			if existing := findUser(config, user.Name); existing != nil && !isSameUser(*existing, user) {
+				conflictingUsers[user.Name] = true
+			}
+		}
+		userNames[change.Name] = user.Name
+	}
+
+	for _, context := range imported.Contexts {
+
+		existingNames := make(map[string]bool)
+		for _, c := range config.Contexts {
+			existingNames[c.Name] = true
+		}
+
+		// A context can't be bound to an existing cluster or user that is not the one it was defined with
+		if reason := getContextConflict(context, conflictingClusters, conflictingUsers); len(reason) > 0 {
+			report.Changes = append(report.Changes, ImportChange{
+				ObjectType: "context",
+				Name:       context.Name,
+				Action:     Skipped,
+				Reason:     reason,
+			})
+			continue
+		}
+
+		// Follow the renaming of the cluster and of the user
+		if newName, ok := clusterNames[context.DefinitionContext.Cluster]; ok {
+			context.DefinitionContext.Cluster = newName
+		}
+		if newName, ok := userNames[context.DefinitionContext.User]; ok {
+			context.DefinitionContext.User = newName
+		}
+
+		change := resolveImportChange("context", context.Name, existingNames, options)
+		report.Changes = append(report.Changes, change)
+
+		switch change.Action {
+		case Added:
+			config.Contexts = append(config.Contexts, context)
+			updatedContexts = append(updatedContexts, context.Name)
+		case Overwritten:
+			for i := range config.Contexts {
+				if config.Contexts[i].Name == context.Name {
+					config.Contexts[i] = context
+				}
+			}
+			updatedContexts = append(updatedContexts, context.Name)
+		case Renamed:
+			context.Name = change.NewName
+			config.Contexts = append(config.Contexts, context)
+			updatedContexts = append(updatedContexts, context.Name)
+		}
+	}
+
+	if options.DryRun {
+		return report, nil
+	}
+
+	if err = writeConfigFile(config); err != nil {
+		return nil, err
+	}
+
+	// Update the known contexts
+	for _, contextName := range updatedContexts {
+		registry.Register(contextName)
+	}
+	registry.Invalidate(getContextNamesUsing(config, func(context DefinitionContext) bool {
+		return invalidatedUsers[context.User] || invalidatedClusters[context.Cluster]
+	})...)
+
+	return report, nil
+}
+
+// isSameUser checks if an imported user is the same as an existing one, whose credentials may be encrypted
+func isSameUser(existing NamedUser, imported NamedUser) bool {
+
+	if err := DecryptUserCredentials(&existing); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(existing.DefinitionUser, imported.DefinitionUser)
+}
+
+// getContextConflict returns why an imported context can't be imported, its cluster or its user having been skipped
+// while differing from the existing one. If the context can be imported, an empty string is returned
+func getContextConflict(context NamedContext, conflictingClusters map[string]bool, conflictingUsers map[string]bool) string {
+
+	if conflictingClusters[context.DefinitionContext.Cluster] {
+		return fmt.Sprintf("the cluster %s was skipped and differs from the existing one", context.DefinitionContext.Cluster)
+	}
+
+	if conflictingUsers[context.DefinitionContext.User] {
+		return fmt.Sprintf("the user %s was skipped and differs from the existing one", context.DefinitionContext.User)
+	}
+
+	return ""
+}
+
+// resolveImportChange determines what to do with an imported object, depending on the existing objects
+func resolveImportChange(objectType string, name string, existingNames map[string]bool, options ImportOptions) ImportChange {
+
+	change := ImportChange{
+		ObjectType: objectType,
+		Name:       name,
+		Action:     Added,
+	}
+
+	if !existingNames[name] {
+		return change
+	}
+
+	switch options.Strategy {
+	case Overwrite:
+		change.Action = Overwritten
+	case Rename:
+		change.Action = Renamed
+		change.NewName = name + options.Suffix
+		for i := 2; existingNames[change.NewName]; i++ {
+			change.NewName = fmt.Sprintf("%s%s-%d", name, options.Suffix, i)
+		}
+	default:
+		change.Action = Skipped
+	}
+
+	return change
+}
+
+// getDefaultKubeConfigFileNames returns the kubeconfig files used by kubectl: the files listed in the KUBECONFIG
+// environment variable if defined, ~/.kube/config otherwise. Only existing files are returned
+func getDefaultKubeConfigFileNames() []string {
+
+	fileNames := filepath.SplitList(os.Getenv("KUBECONFIG"))
+	if len(fileNames) == 0 {
+		fileNames = []string{filepath.Join(homeDir(), ".kube", "config")}
+	}
+
+	result := make([]string, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		if len(fileName) == 0 {
+			continue
+		}
+		if _, err := os.Stat(fileName); err == nil {
+			result = append(result, fileName)
+		}
+	}
+
+	return result
+}
+
+// homeDir returns the home directory of the user depending on the OS
+func homeDir() string {
+	if h := os.Getenv("HOME"); h != "" {
+		return h
+	}
+	return os.Getenv("USERPROFILE") // windows
+}
@@ -0,0 +1,283 @@
+package context
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/twuillemin/kuboxy/internal/configuration"
+)
+
+// The configuration in which the documents are imported
+const existingKubeConfig = `apiVersion: v1
+kind: Config
+preferences: {}
+clusters:
+- name: production
+  cluster:
+    server: https://production.example.com
+contexts:
+- name: production
+  context:
+    cluster: production
+    user: admin
+users:
+- name: admin
+  user:
+    token: admin-token
+`
+
+// loadTestContexts initializes the package with a configuration kept in memory
+func loadTestContexts(t *testing.T, content string) {
+
+	store := NewMemoryConfigStore([]byte(content))
+	if err := LoadContextsFromStore(store, configuration.ApplicationConfiguration{ImpersonationCacheSize: 10}); err != nil {
+		t.Fatalf("unable to load the contexts: %v", err)
+	}
+}
+
+func TestMergeKubeConfig(t *testing.T) {
+
+	tests := []struct {
+		name              string
+		imported          string
+		options           ImportOptions
+		expectedChanges   []ImportChange
+		expectedContexts  map[string]DefinitionContext
+		expectedUnchanged bool
+	}{
+		{
+			name: "new objects",
+			imported: `clusters:
+- name: staging
+  cluster:
+    server: https://staging.example.com
+contexts:
+- name: staging
+  context:
+    cluster: staging
+    user: admin
+`,
+			options: ImportOptions{Strategy: Skip},
+			expectedChanges: []ImportChange{
+				{ObjectType: "cluster", Name: "staging", Action: Added},
+				{ObjectType: "context", Name: "staging", Action: Added},
+			},
+			expectedContexts: map[string]DefinitionContext{
+				"staging": {Cluster: "staging", User: "admin"},
+			},
+		},
+		{
+			name: "skipped identical cluster is shared",
+			imported: `clusters:
+- name: production
+  cluster:
+    server: https://production.example.com
+contexts:
+- name: production-viewer
+  context:
+    cluster: production
+    user: admin
+`,
+			options: ImportOptions{Strategy: Skip},
+			expectedChanges: []ImportChange{
+				{ObjectType: "cluster", Name: "production", Action: Skipped},
+				{ObjectType: "context", Name: "production-viewer", Action: Added},
+			},
+			expectedContexts: map[string]DefinitionContext{
+				"production-viewer": {Cluster: "production", User: "admin"},
+			},
+		},
+		{
+			name: "skipped different cluster rejects its contexts",
+			imported: `clusters:
+- name: production
+  cluster:
+    server: https://other.example.com
+contexts:
+- name: other
+  context:
+    cluster: production
+    user: admin
+`,
+			options: ImportOptions{Strategy: Skip},
+			expectedChanges: []ImportChange{
+				{ObjectType: "cluster", Name: "production", Action: Skipped},
+				{ObjectType: "context", Name: "other", Action: Skipped, Reason: "the cluster production was skipped and differs from the existing one"},
+			},
+			expectedUnchanged: true,
+		},
+		{
+			name: "skipped different user rejects its contexts",
+			imported: `users:
+- name: admin
+  user:
+    token: other-token
+contexts:
+- name: other
+  context:
+    cluster: production
+    user: admin
+`,
+			options: ImportOptions{Strategy: Skip},
+			expectedChanges: []ImportChange{
+				{ObjectType: "user", Name: "admin", Action: Skipped},
+				{ObjectType: "context", Name: "other", Action: Skipped, Reason: "the user admin was skipped and differs from the existing one"},
+			},
+			expectedUnchanged: true,
+		},
+		{
+			name: "renamed objects are followed by the contexts",
+			imported: `clusters:
+- name: production
+  cluster:
+    server: https://other.example.com
+contexts:
+- name: production
+  context:
+    cluster: production
+    user: admin
+`,
+			options: ImportOptions{Strategy: Rename},
+			expectedChanges: []ImportChange{
+				{ObjectType: "cluster", Name: "production", Action: Renamed, NewName: "production-imported"},
+				{ObjectType: "context", Name: "production", Action: Renamed, NewName: "production-imported"},
+			},
+			expectedContexts: map[string]DefinitionContext{
+				"production":          {Cluster: "production", User: "admin"},
+				"production-imported": {Cluster: "production-imported", User: "admin"},
+			},
+		},
+		{
+			name: "overwritten objects",
+			imported: `contexts:
+- name: production
+  context:
+    cluster: production
+    user: admin
+    namespace: kube-system
+`,
+			options: ImportOptions{Strategy: Overwrite},
+			expectedChanges: []ImportChange{
+				{ObjectType: "context", Name: "production", Action: Overwritten},
+			},
+			expectedContexts: map[string]DefinitionContext{
+				"production": {Cluster: "production", User: "admin", Namespace: "kube-system"},
+			},
+		},
+		{
+			name: "dry run",
+			imported: `clusters:
+- name: staging
+  cluster:
+    server: https://staging.example.com
+`,
+			options: ImportOptions{Strategy: Skip, DryRun: true},
+			expectedChanges: []ImportChange{
+				{ObjectType: "cluster", Name: "staging", Action: Added},
+			},
+			expectedUnchanged: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			loadTestContexts(t, existingKubeConfig)
+			before, err := GetKubeConfig()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			report, err := ImportKubeConfig([]byte(test.imported), test.options)
+			if err != nil {
+				t.Fatalf("unable to import: %v", err)
+			}
+
+			if !reflect.DeepEqual(report.Changes, test.expectedChanges) {
+				t.Errorf("expected the changes %+v, got %+v", test.expectedChanges, report.Changes)
+			}
+
+			config, err := GetKubeConfig()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.expectedUnchanged && !reflect.DeepEqual(before, config) {
+				t.Errorf("the configuration was modified: %+v", config)
+			}
+
+			for contextName, expected := range test.expectedContexts {
+				context := findContext(config, contextName)
+				if context == nil {
+					t.Errorf("the context %s is missing", contextName)
+					continue
+				}
+				if !reflect.DeepEqual(context.DefinitionContext, expected) {
+					t.Errorf("expected the context %s to be %+v, got %+v", contextName, expected, context.DefinitionContext)
+				}
+			}
+		})
+	}
+}
+
+func TestImportKubeConfigFileRebasesRelativePaths(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "kuboxy-import")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	fileName := filepath.Join(directory, "config")
+	content := `clusters:
+- name: local
+  cluster:
+    server: https://127.0.0.1:6443
+    certificate-authority: certs/ca.crt
+users:
+- name: local
+  user:
+    client-certificate: certs/user.crt
+    client-key: /etc/kuboxy/user.key
+    tokenFile: token
+`
+	if err = ioutil.WriteFile(fileName, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	loadTestContexts(t, existingKubeConfig)
+
+	if _, err = ImportKubeConfigFile(fileName, ImportOptions{Strategy: Skip}); err != nil {
+		t.Fatalf("unable to import: %v", err)
+	}
+
+	config, err := GetKubeConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cluster := findCluster(config, "local")
+	user := findUser(config, "local")
+	if cluster == nil || user == nil {
+		t.Fatal("the cluster or the user was not imported")
+	}
+
+	tests := []struct {
+		name     string
+		actual   string
+		expected string
+	}{
+		{"certificate authority", cluster.DefinitionCluster.CertificateAuthority, filepath.Join(directory, "certs", "ca.crt")},
+		{"client certificate", user.DefinitionUser.ClientCertificate, filepath.Join(directory, "certs", "user.crt")},
+		{"absolute client key", user.DefinitionUser.ClientKey, "/etc/kuboxy/user.key"},
+		{"token file", user.DefinitionUser.TokenFile, filepath.Join(directory, "token")},
+	}
+
+	for _, test := range tests {
+		if test.actual != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.name, test.expected, test.actual)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package context
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/client-go/rest"
+)
+
+// The environment variables giving the address of the API server, set by Kubernetes in all the pods
+const (
+	serviceHostEnvironmentVariable = "KUBERNETES_SERVICE_HOST"
+	servicePortEnvironmentVariable = "KUBERNETES_SERVICE_PORT"
+)
+
+// The environment variables allowing to override the location of the service account files
+const (
+	tokenFileEnvironmentVariable     = "KUBOXY_SERVICE_ACCOUNT_TOKEN_FILE"
+	caFileEnvironmentVariable        = "KUBOXY_SERVICE_ACCOUNT_CA_FILE"
+	namespaceFileEnvironmentVariable = "KUBOXY_SERVICE_ACCOUNT_NAMESPACE_FILE"
+)
+
+// The default location of the service account files mounted by Kubernetes in the pods
+const serviceAccountDirectory = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+// The name of the in-cluster context, empty if the application is not running in a cluster
+var inClusterContextName = ""
+
+// isInClusterAvailable checks if the application is running in a Kubernetes cluster, that is if the address of the
+// API server is given and the service account token and certificate authority are mounted
+func isInClusterAvailable() bool {
+
+	if len(os.Getenv(serviceHostEnvironmentVariable)) == 0 || len(os.Getenv(servicePortEnvironmentVariable)) == 0 {
+		return false
+	}
+
+	return isRegularFile(getServiceAccountFile(tokenFileEnvironmentVariable, "token")) &&
+		isRegularFile(getServiceAccountFile(caFileEnvironmentVariable, "ca.crt"))
+}
+
+// isRegularFile checks if a file exists and is not a directory
+func isRegularFile(fileName string) bool {
+	info, err := os.Stat(fileName)
+	return err == nil && !info.IsDir()
+}
+
+// buildInClusterRestConfig builds the configuration for connecting to the cluster hosting the application with its
+// service account
+func buildInClusterRestConfig() (*rest.Config, error) {
+
+	host := os.Getenv(serviceHostEnvironmentVariable)
+	port := os.Getenv(servicePortEnvironmentVariable)
+	if len(host) == 0 || len(port) == 0 {
+		return nil, fmt.Errorf("unable to connect to the hosting cluster as %s and %s are not defined", serviceHostEnvironmentVariable, servicePortEnvironmentVariable)
+	}
+
+	tokenFile := getServiceAccountFile(tokenFileEnvironmentVariable, "token")
+	token, err := ioutil.ReadFile(tokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the service account token due to: %v", err.Error())
+	}
+
+	caFile := getServiceAccountFile(caFileEnvironmentVariable, "ca.crt")
+	if _, err = os.Stat(caFile); err != nil {
+		return nil, fmt.Errorf("unable to read the service account certificate authority due to: %v", err.Error())
+	}
+
+	return &rest.Config{
+		Host: "https://" + net.JoinHostPort(host, port),
+		TLSClientConfig: rest.TLSClientConfig{
+			CAFile: caFile,
+		},
+		BearerToken: strings.TrimSpace(string(token)),
+		// Keep the file so that the rotated tokens are reloaded
+		BearerTokenFile: tokenFile,
+	}, nil
+}
+
+// getInClusterNamespace returns the namespace of the service account, default if not available
+func getInClusterNamespace() string {
+
+	data, err := ioutil.ReadFile(getServiceAccountFile(namespaceFileEnvironmentVariable, "namespace"))
+	if err != nil {
+		return "default"
+	}
+
+	if namespace := strings.TrimSpace(string(data)); len(namespace) > 0 {
+		return namespace
+	}
+
+	return "default"
+}
+
+// getServiceAccountFile returns the location of a service account file, which can be overridden by an environment
+// variable
+func getServiceAccountFile(environmentVariable string, fileName string) string {
+	if value := os.Getenv(environmentVariable); len(value) > 0 {
+		return value
+	}
+	return filepath.Join(serviceAccountDirectory, fileName)
+}
@@ -0,0 +1,193 @@
+package context
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/twuillemin/kuboxy/internal/configuration"
+)
+
+// setEnvironment sets environment variables and returns a function restoring their previous values
+func setEnvironment(t *testing.T, values map[string]string) func() {
+
+	previous := make(map[string]*string)
+	for name, value := range values {
+		if current, ok := os.LookupEnv(name); ok {
+			previous[name] = &current
+		} else {
+			previous[name] = nil
+		}
+		if err := os.Setenv(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return func() {
+		for name, value := range previous {
+			if value == nil {
+				os.Unsetenv(name)
+			} else {
+				os.Setenv(name, *value)
+			}
+		}
+	}
+}
+
+// writeServiceAccount writes the service account files of a pod in a directory, the certificate authority being the
+// one of the given server
+func writeServiceAccount(t *testing.T, directory string, server *httptest.Server) {
+
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	files := map[string][]byte{
+		"token":     []byte("service-account-token\n"),
+		"ca.crt":    certificate,
+		"namespace": []byte("kuboxy\n"),
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(directory, name), content, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestIsInClusterAvailable(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "kuboxy-in-cluster")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	token := filepath.Join(directory, "token")
+	ca := filepath.Join(directory, "ca.crt")
+	for _, fileName := range []string{token, ca} {
+		if err = ioutil.WriteFile(fileName, []byte("content"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	missing := filepath.Join(directory, "missing")
+
+	tests := []struct {
+		name      string
+		host      string
+		tokenFile string
+		caFile    string
+		expected  bool
+	}{
+		{name: "all available", host: "127.0.0.1", tokenFile: token, caFile: ca, expected: true},
+		{name: "no host", host: "", tokenFile: token, caFile: ca, expected: false},
+		{name: "no token", host: "127.0.0.1", tokenFile: missing, caFile: ca, expected: false},
+		{name: "no certificate authority", host: "127.0.0.1", tokenFile: token, caFile: missing, expected: false},
+		{name: "token is a directory", host: "127.0.0.1", tokenFile: directory, caFile: ca, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			restore := setEnvironment(t, map[string]string{
+				serviceHostEnvironmentVariable: test.host,
+				servicePortEnvironmentVariable: "443",
+				tokenFileEnvironmentVariable:   test.tokenFile,
+				caFileEnvironmentVariable:      test.caFile,
+			})
+			defer restore()
+
+			if available := isInClusterAvailable(); available != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, available)
+			}
+		})
+	}
+}
+
+func TestInClusterContext(t *testing.T) {
+
+	// A fake API server only answering to the service account
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer service-account-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/version" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"major":"1","minor":"14","gitVersion":"v1.14.0"}`))
+	}))
+	defer server.Close()
+
+	directory, err := ioutil.TempDir("", "kuboxy-in-cluster")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+	writeServiceAccount(t, directory, server)
+
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restore := setEnvironment(t, map[string]string{
+		serviceHostEnvironmentVariable:   host,
+		servicePortEnvironmentVariable:   port,
+		tokenFileEnvironmentVariable:     filepath.Join(directory, "token"),
+		caFileEnvironmentVariable:        filepath.Join(directory, "ca.crt"),
+		namespaceFileEnvironmentVariable: filepath.Join(directory, "namespace"),
+	})
+	defer restore()
+
+	// The in-cluster context is available without any context in the configuration
+	err = LoadContextsFromStore(NewMemoryConfigStore(nil), configuration.ApplicationConfiguration{
+		InClusterContextName:   "in-cluster",
+		ImpersonationCacheSize: 10,
+	})
+	if err != nil {
+		t.Fatalf("unable to load the contexts: %v", err)
+	}
+	defer func() {
+		inClusterContextName = ""
+		registry.Unregister("in-cluster")
+	}()
+
+	found := false
+	for _, contextName := range GetContextNames() {
+		found = found || contextName == "in-cluster"
+	}
+	if !found {
+		t.Fatalf("the in-cluster context is not registered: %v", GetContextNames())
+	}
+
+	kubeConfig, err := GetKubeConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, namespace, err := buildContextRestConfig(kubeConfig, "in-cluster")
+	if err != nil {
+		t.Fatalf("unable to build the configuration: %v", err)
+	}
+	if namespace != "kuboxy" {
+		t.Errorf("expected the namespace of the service account, got %s", namespace)
+	}
+
+	// The server is trusted with the certificate authority of the service account
+	clientset, err := GetClientset("in-cluster")
+	if err != nil {
+		t.Fatalf("unable to build the clientset: %v", err)
+	}
+	version, err := clientset.Discovery().ServerVersion()
+	if err != nil {
+		t.Fatalf("unable to query the server: %v", err)
+	}
+	if version.GitVersion != "v1.14.0" {
+		t.Errorf("unexpected version %s", version.GitVersion)
+	}
+}
@@ -0,0 +1,198 @@
+package context
+
+import (
+	"crypto/x509"
+	"strings"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+)
+
+// The maximum duration of each request done by the probe
+const probeTimeout = 10 * time.Second
+
+// The group version served by the metrics-server
+const metricsGroupVersion = "metrics.k8s.io/v1beta1"
+
+// ContextHealth is the result of the probe of a context
+type ContextHealth struct {
+	ContextName      string              `json:"contextName"`
+	Server           string              `json:"server"`
+	Reachable        bool                `json:"reachable"`
+	TLSValid         bool                `json:"tlsValid"`
+	CredentialsValid bool                `json:"credentialsValid"`
+	ServerVersion    string              `json:"serverVersion,omitempty"`
+	LatencyMs        int64               `json:"latencyMs"`
+	MetricsAvailable bool                `json:"metricsAvailable"`
+	Permissions      *ContextPermissions `json:"permissions,omitempty"`
+	Errors           []string            `json:"errors,omitempty"`
+	CheckedAt        time.Time           `json:"checkedAt"`
+}
+
+// ContextPermissions are the actions the identity of a context may do in a namespace
+type ContextPermissions struct {
+	Namespace        string            `json:"namespace"`
+	ResourceRules    []ResourceRule    `json:"resourceRules"`
+	NonResourceRules []NonResourceRule `json:"nonResourceRules"`
+	Incomplete       bool              `json:"incomplete"`
+}
+
+// ResourceRule are the verbs allowed on some resources
+type ResourceRule struct {
+	Verbs         []string `json:"verbs"`
+	APIGroups     []string `json:"apiGroups,omitempty"`
+	Resources     []string `json:"resources,omitempty"`
+	ResourceNames []string `json:"resourceNames,omitempty"`
+}
+
+// NonResourceRule are the verbs allowed on some non resource URLs
+type NonResourceRule struct {
+	Verbs           []string `json:"verbs"`
+	NonResourceURLs []string `json:"nonResourceURLs,omitempty"`
+}
+
+// ProbeContext checks whether a context actually works: the API server is dialed to get its version, then the
+// availability of the metrics-server and the permissions of the identity are retrieved. Failures of the checks are
+// reported in the result, an error is only returned if the context can not be used at all
+func ProbeContext(contextName string) (*ContextHealth, error) {
+
+	// Build a dedicated client, with the settings of the context, so that the probe is not blocked by a slow server
+	config, namespace, err := getRestConfigAndNamespace(contextName)
+	if err != nil {
+		return nil, err
+	}
+	if config.Timeout == 0 || config.Timeout > probeTimeout {
+		config.Timeout = probeTimeout
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	health := &ContextHealth{
+		ContextName: contextName,
+		Server:      config.Host,
+		CheckedAt:   time.Now(),
+	}
+
+	// The client only reports the expired certificates as a TLS failure, so give the actual reason
+	for _, certificateErr := range checkContextCertificates(contextName) {
+		health.addError(certificateErr)
+	}
+
+	// Dial the server
+	start := time.Now()
+	version, err := clientset.Discovery().ServerVersion()
+	health.LatencyMs = time.Since(start).Nanoseconds() / int64(time.Millisecond)
+
+	if err != nil {
+		health.addError(err)
+		health.Reachable, health.TLSValid, health.CredentialsValid = classifyProbeError(err)
+		return health, nil
+	}
+
+	health.Reachable = true
+	health.TLSValid = true
+	health.ServerVersion = version.GitVersion
+
+	// The version endpoint may be anonymous, so check the credentials with an authenticated call
+	if len(namespace) == 0 {
+		namespace = corev1.NamespaceDefault
+	}
+
+	review, err := clientset.AuthorizationV1().SelfSubjectRulesReviews().Create(&authorizationv1.SelfSubjectRulesReview{
+		Spec: authorizationv1.SelfSubjectRulesReviewSpec{
+			Namespace: namespace,
+		},
+	})
+	if err != nil {
+		health.addError(err)
+		_, _, health.CredentialsValid = classifyProbeError(err)
+	} else {
+		health.CredentialsValid = true
+		health.Permissions = convertRulesReview(namespace, review)
+	}
+
+	// Check the metrics-server, used by the report
+	if _, err = clientset.Discovery().ServerResourcesForGroupVersion(metricsGroupVersion); err != nil {
+		health.addError(err)
+	} else {
+		health.MetricsAvailable = true
+	}
+
+	return health, nil
+}
+
+// classifyProbeError determines from an error if the server was reachable and if the TLS handshake and the
+// credentials were valid
+func classifyProbeError(err error) (reachable bool, tlsValid bool, credentialsValid bool) {
+
+	if apierrors.IsUnauthorized(err) {
+		return true, true, false
+	}
+
+	if apierrors.IsForbidden(err) {
+		return true, true, true
+	}
+
+	if _, ok := err.(*apierrors.StatusError); ok {
+		return true, true, true
+	}
+
+	if isTLSError(err) {
+		return true, false, false
+	}
+
+	return false, false, false
+}
+
+// isTLSError checks if an error is due to the verification of the certificate of the server
+func isTLSError(err error) bool {
+
+	switch err.(type) {
+	case x509.UnknownAuthorityError, x509.HostnameError, x509.CertificateInvalidError:
+		return true
+	}
+
+	// The errors are most of the time wrapped by the url package and the client
+	message := err.Error()
+	return strings.Contains(message, "x509:") || strings.Contains(message, "tls:")
+}
+
+// convertRulesReview converts the result of a SelfSubjectRulesReview
+func convertRulesReview(namespace string, review *authorizationv1.SelfSubjectRulesReview) *ContextPermissions {
+
+	permissions := &ContextPermissions{
+		Namespace:        namespace,
+		ResourceRules:    make([]ResourceRule, 0, len(review.Status.ResourceRules)),
+		NonResourceRules: make([]NonResourceRule, 0, len(review.Status.NonResourceRules)),
+		Incomplete:       review.Status.Incomplete,
+	}
+
+	for _, rule := range review.Status.ResourceRules {
+		permissions.ResourceRules = append(permissions.ResourceRules, ResourceRule{
+			Verbs:         rule.Verbs,
+			APIGroups:     rule.APIGroups,
+			Resources:     rule.Resources,
+			ResourceNames: rule.ResourceNames,
+		})
+	}
+
+	for _, rule := range review.Status.NonResourceRules {
+		permissions.NonResourceRules = append(permissions.NonResourceRules, NonResourceRule{
+			Verbs:           rule.Verbs,
+			NonResourceURLs: rule.NonResourceURLs,
+		})
+	}
+
+	return permissions
+}
+
+// addError adds an error to the result of the probe
+func (health *ContextHealth) addError(err error) {
+	health.Errors = append(health.Errors, err.Error())
+}
@@ -0,0 +1,59 @@
+package context
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/twuillemin/kuboxy/internal/configuration"
+)
+
+func TestProbeContextUsesClientSettings(t *testing.T) {
+
+	// The server is only reachable through the proxy of the client settings, which answers itself to the requests
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"major":"1","minor":"14","gitVersion":"v1.14.0"}`))
+	}))
+	defer proxy.Close()
+
+	config := []byte(`apiVersion: v1
+kind: Config
+preferences: {}
+clusters:
+- name: unreachable
+  cluster:
+    server: http://unreachable.invalid:6443
+contexts:
+- name: probed
+  context:
+    cluster: unreachable
+    user: token
+users:
+- name: token
+  user:
+    token: token
+kuboxy-client-settings:
+- name: probed
+  settings:
+    proxy-url: ` + proxy.URL + `
+`)
+
+	err := LoadContextsFromStore(NewMemoryConfigStore(config), configuration.ApplicationConfiguration{ImpersonationCacheSize: 10})
+	if err != nil {
+		t.Fatalf("unable to load the contexts: %v", err)
+	}
+	defer registry.Unregister("probed")
+
+	health, err := ProbeContext("probed")
+	if err != nil {
+		t.Fatalf("unable to probe the context: %v", err)
+	}
+	if !health.Reachable || health.ServerVersion != "v1.14.0" {
+		t.Errorf("the server was not reached through the proxy: %+v", health)
+	}
+}
@@ -0,0 +1,66 @@
+package context
+
+import (
+	"path"
+	"sync"
+
+	"github.com/twuillemin/kuboxy/internal/configuration"
+)
+
+// The contexts whose objects can't be modified
+var readOnly = struct {
+	lock              sync.RWMutex
+	global            bool
+	readOnlyContexts  []string
+	readWriteContexts []string
+}{}
+
+// setReadOnly keeps the read-only settings of the configuration of the application
+func setReadOnly(applicationConfiguration configuration.ApplicationConfiguration) {
+
+	readOnly.lock.Lock()
+	defer readOnly.lock.Unlock()
+
+	readOnly.global = applicationConfiguration.ReadOnly
+	readOnly.readOnlyContexts = applicationConfiguration.ReadOnlyContexts
+	readOnly.readWriteContexts = applicationConfiguration.ReadWriteContexts
+}
+
+// IsReadOnly checks if the objects of a context can't be modified. A context matched by the readOnlyContexts of the
+// application is always read-only. Otherwise, a context matched by the readWriteContexts is never read-only. The
+// other contexts are read-only if the whole application is read-only
+func IsReadOnly(contextName string) bool {
+
+	readOnly.lock.RLock()
+	defer readOnly.lock.RUnlock()
+
+	if matchesAnyGlob(readOnly.readOnlyContexts, contextName) {
+		return true
+	}
+
+	if matchesAnyGlob(readOnly.readWriteContexts, contextName) {
+		return false
+	}
+
+	return readOnly.global
+}
+
+// CheckWritable returns a ReadOnlyError if the objects of a context can't be modified
+func CheckWritable(contextName string) error {
+
+	if IsReadOnly(contextName) {
+		return &ReadOnlyError{contextName}
+	}
+
+	return nil
+}
+
+// matchesAnyGlob checks if a value is matched by one of the globs
+func matchesAnyGlob(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, value); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -2,31 +2,97 @@ package context
 
 import (
 	"errors"
+	"fmt"
 	"gopkg.in/yaml.v2"
-	"io/ioutil"
 )
 
-// GetKubeConfig reads the configuration file
+// GetKubeConfig reads the configuration from its store
 func GetKubeConfig() (*KubeConfig, error) {
 
-	if len(contextConfigurationFileName) == 0 {
+	if configStore == nil {
 		return nil, errors.New("the context was not initialized before use")
 	}
 
-	// Open the config file
-	var config KubeConfig
-	source, err := ioutil.ReadFile(contextConfigurationFileName)
+	// Read the config
+	source, err := configStore.Load()
 	if err != nil {
 		return nil, err
 	}
 
 	// Read the contextNames
-	err = yaml.Unmarshal(source, &config)
-	if err != nil {
+	return unmarshalKubeConfig(source)
+}
+
+// unmarshalKubeConfig reads a configuration given in YAML or in JSON. The values kept in the Extra fields and in the
+// preferences are normalized so that they can also be marshalled in JSON
+func unmarshalKubeConfig(source []byte) (*KubeConfig, error) {
+
+	var config KubeConfig
+	if err := yaml.Unmarshal(source, &config); err != nil {
 		return nil, err
 	}
 
-	return &config, err
+	config.Extra = normalizeMap(config.Extra)
+	config.Preferences = normalizeMap(config.Preferences)
+	for i := range config.Clusters {
+		config.Clusters[i].Extra = normalizeMap(config.Clusters[i].Extra)
+		config.Clusters[i].DefinitionCluster.Extra = normalizeMap(config.Clusters[i].DefinitionCluster.Extra)
+	}
+	for i := range config.Contexts {
+		config.Contexts[i].Extra = normalizeMap(config.Contexts[i].Extra)
+		config.Contexts[i].DefinitionContext.Extra = normalizeMap(config.Contexts[i].DefinitionContext.Extra)
+	}
+	for i := range config.Users {
+		user := &config.Users[i]
+		user.Extra = normalizeMap(user.Extra)
+		user.DefinitionUser.Extra = normalizeMap(user.DefinitionUser.Extra)
+		if user.DefinitionUser.Exec != nil {
+			user.DefinitionUser.Exec.Extra = normalizeMap(user.DefinitionUser.Exec.Extra)
+			for j := range user.DefinitionUser.Exec.Env {
+				user.DefinitionUser.Exec.Env[j].Extra = normalizeMap(user.DefinitionUser.Exec.Env[j].Extra)
+			}
+		}
+		if user.DefinitionUser.AuthProvider != nil {
+			user.DefinitionUser.AuthProvider.Extra = normalizeMap(user.DefinitionUser.AuthProvider.Extra)
+		}
+	}
+
+	return &config, nil
+}
+
+// normalizeMap converts recursively the maps created by the YAML decoder (map[interface{}]interface{}) into maps
+// having string keys
+func normalizeMap(values map[string]interface{}) map[string]interface{} {
+	if values == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		result[key] = normalizeValue(value)
+	}
+	return result
+}
+
+// normalizeValue converts recursively a value created by the YAML decoder
+func normalizeValue(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(typedValue))
+		for key, subValue := range typedValue {
+			result[fmt.Sprint(key)] = normalizeValue(subValue)
+		}
+		return result
+	case map[string]interface{}:
+		return normalizeMap(typedValue)
+	case []interface{}:
+		result := make([]interface{}, len(typedValue))
+		for i, subValue := range typedValue {
+			result[i] = normalizeValue(subValue)
+		}
+		return result
+	default:
+		return value
+	}
 }
 
 // GetKubeUsers returns the users configured
@@ -112,3 +178,25 @@ func GetKubeContext(name string) (*NamedContext, error) {
 
 	return nil, nil
 }
+
+// GetClientSettings returns the settings of the clients of all the contexts having some
+func GetClientSettings() ([]NamedClientSettings, error) {
+
+	config, err := GetKubeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return config.ClientSettings, nil
+}
+
+// GetContextClientSettings return the settings of the clients of a single context if present, nil otherwise
+func GetContextClientSettings(contextName string) (*NamedClientSettings, error) {
+
+	config, err := GetKubeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return findClientSettings(config, contextName), nil
+}
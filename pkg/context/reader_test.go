@@ -0,0 +1,82 @@
+package context
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files")
+
+// TestKubeConfigRoundTrip reads the configurations of testdata, writes them back and compares the result with the
+// golden files. The written configuration must also hold exactly the same entries as the original one
+func TestKubeConfigRoundTrip(t *testing.T) {
+
+	fileNames, err := filepath.Glob(filepath.Join("testdata", "*.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, fileName := range fileNames {
+		name := strings.TrimSuffix(filepath.Base(fileName), ".yaml")
+		t.Run(name, func(t *testing.T) {
+
+			source, err := ioutil.ReadFile(fileName)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			config, err := unmarshalKubeConfig(source)
+			if err != nil {
+				t.Fatalf("unable to read the configuration: %v", err)
+			}
+
+			store := NewMemoryConfigStore(nil)
+			if err = writeKubeConfig(store, config); err != nil {
+				t.Fatalf("unable to write the configuration: %v", err)
+			}
+			written, _ := store.Load()
+
+			goldenFileName := filepath.Join("testdata", name+".golden")
+			if *updateGolden {
+				if err = ioutil.WriteFile(goldenFileName, written, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			golden, err := ioutil.ReadFile(goldenFileName)
+			if err != nil {
+				t.Fatalf("unable to read the golden file: %v", err)
+			}
+			if string(written) != string(golden) {
+				t.Errorf("the written configuration differs from the golden file\nexpected:\n%s\ngot:\n%s", golden, written)
+			}
+
+			// Nothing is lost or added
+			var original, rewritten interface{}
+			if err = yaml.Unmarshal(source, &original); err != nil {
+				t.Fatal(err)
+			}
+			if err = yaml.Unmarshal(written, &rewritten); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(original, rewritten) {
+				t.Errorf("the written configuration does not hold the same entries\noriginal:\n%v\nwritten:\n%v", original, rewritten)
+			}
+
+			// Reading the written configuration again gives the same configuration
+			reread, err := unmarshalKubeConfig(written)
+			if err != nil {
+				t.Fatalf("unable to read the written configuration: %v", err)
+			}
+			if !reflect.DeepEqual(config, reread) {
+				t.Errorf("the configuration read again differs\nexpected:\n%+v\ngot:\n%+v", config, reread)
+			}
+		})
+	}
+}
@@ -0,0 +1,341 @@
+package context
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/client-go/kubernetes"
+	metrics "k8s.io/metrics/pkg/client/clientset/versioned"
+)
+
+// ConnectionState is the state of the connection of a context
+type ConnectionState string
+
+const (
+	// Idle is used when no clientset was built yet for the context
+	Idle ConnectionState = "Idle"
+	// Ready is used when the clientsets of the context are built
+	Ready ConnectionState = "Ready"
+	// Failed is used when the last attempt to build a clientset for the context failed
+	Failed ConnectionState = "Failed"
+)
+
+// ContextState is the state of a context known by the application
+type ContextState struct {
+	Name            string          `json:"name"`
+	ConnectionState ConnectionState `json:"connectionState"`
+	Error           string          `json:"error,omitempty"`
+}
+
+// DefaultImpersonationCacheSize is the number of identities for which the impersonating clientsets are kept, by context
+const DefaultImpersonationCacheSize = 100
+
+// Impersonation is the identity used for the requests to a cluster, instead of the user of the context. It is sent
+// to the cluster with the Impersonate-User and Impersonate-Group headers, so that the RBAC of the cluster applies to
+// this identity. The user of the context must be allowed to impersonate
+type Impersonation struct {
+	UserName string
+	Groups   []string
+}
+
+// key returns the key of the impersonation in the caches, the order of the groups being irrelevant
+func (impersonation Impersonation) key() string {
+
+	groups := append([]string{}, impersonation.Groups...)
+	sort.Strings(groups)
+
+	return impersonation.UserName + "\x00" + strings.Join(groups, "\x00")
+}
+
+// Registry keeps the contexts known by the application along with their cached clientsets. A Registry is safe for
+// concurrent use. The clientsets are built outside of its lock, so that a slow store or cluster doesn't block the
+// other contexts
+type Registry struct {
+	lock                   sync.Mutex
+	entries                map[string]*registryEntry
+	impersonationCacheSize int
+}
+
+// registryEntry is the information kept for a single context
+type registryEntry struct {
+	clientsets
+	lastError error
+
+	// The clientsets impersonating the callers, by key of the impersonation, along with the keys from the least to
+	// the most recently used
+	impersonated       map[string]*clientsets
+	impersonatedUsages []string
+}
+
+// clientsets are the clientsets of a context, built on first use
+type clientsets struct {
+	clientset *kubernetes.Clientset
+	metrics   *metrics.Clientset
+}
+
+// NewRegistry creates a new empty Registry
+func NewRegistry() *Registry {
+	return &Registry{
+		entries:                make(map[string]*registryEntry),
+		impersonationCacheSize: DefaultImpersonationCacheSize,
+	}
+}
+
+// SetImpersonationCacheSize sets the number of identities for which the impersonating clientsets are kept, by
+// context. When the cache of a context is full, the clientsets of the least recently used identity are dropped
+func (r *Registry) SetImpersonationCacheSize(size int) {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if size > 0 {
+		r.impersonationCacheSize = size
+	}
+}
+
+// Register declares a context. If the context is already known, its cached clientsets are dropped
+func (r *Registry) Register(contextName string) {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.entries[contextName] = &registryEntry{}
+}
+
+// Unregister removes a context and its cached clientsets
+func (r *Registry) Unregister(contextName string) {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.entries, contextName)
+}
+
+// Invalidate drops the cached clientsets of the given contexts, so that they are rebuilt on next use. Unknown
+// contexts are ignored
+func (r *Registry) Invalidate(contextNames ...string) {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, contextName := range contextNames {
+		if _, ok := r.entries[contextName]; ok {
+			r.entries[contextName] = &registryEntry{}
+		}
+	}
+}
+
+// Contains checks if a context is known by the registry
+func (r *Registry) Contains(contextName string) bool {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	_, ok := r.entries[contextName]
+	return ok
+}
+
+// Names gives the sorted names of all the contexts known by the registry
+func (r *Registry) Names() []string {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	result := make([]string, 0, len(r.entries))
+	for contextName := range r.entries {
+		result = append(result, contextName)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// States gives the state of all the contexts known by the registry, sorted by name
+func (r *Registry) States() []ContextState {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	result := make([]ContextState, 0, len(r.entries))
+	for contextName, entry := range r.entries {
+
+		state := ContextState{
+			Name:            contextName,
+			ConnectionState: Idle,
+		}
+
+		if entry.lastError != nil {
+			state.ConnectionState = Failed
+			state.Error = entry.lastError.Error()
+		} else if entry.clientset != nil || entry.metrics != nil || len(entry.impersonated) > 0 {
+			state.ConnectionState = Ready
+		}
+
+		result = append(result, state)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	return result
+}
+
+// GetClientset gives a clientset for the given context, building it if needed
+func (r *Registry) GetClientset(contextName string) (*kubernetes.Clientset, error) {
+	return r.GetImpersonatedClientset(contextName, nil)
+}
+
+// GetMetrics gives a metrics clientset for the given context, building it if needed
+func (r *Registry) GetMetrics(contextName string) (*metrics.Clientset, error) {
+	return r.GetImpersonatedMetrics(contextName, nil)
+}
+
+// GetImpersonatedClientset gives a clientset for the given context impersonating the given identity, building it if
+// needed. Without impersonation, the clientset uses the user of the context
+func (r *Registry) GetImpersonatedClientset(contextName string, impersonation *Impersonation) (*kubernetes.Clientset, error) {
+
+	// Try to get it from the cache
+	entry, cached, err := r.getEntry(contextName, impersonation)
+	if err != nil {
+		return nil, err
+	}
+	if cached.clientset != nil {
+		return cached.clientset, nil
+	}
+
+	// Build the clientset outside of the lock, as reading the configuration may require a round-trip with its store
+	var clientset *kubernetes.Clientset
+	config, err := getImpersonatedRestConfig(contextName, impersonation)
+	if err == nil {
+		clientset, err = kubernetes.NewForConfig(config)
+	}
+
+	// Keep the clientset, unless another one was built meanwhile
+	r.lock.Lock()
+	isCurrent := r.entries[contextName] == entry
+	if isCurrent {
+		if err != nil {
+			entry.lastError = err
+		} else {
+			kept := r.getCachedClientsets(entry, impersonation)
+			if kept.clientset == nil {
+				kept.clientset = clientset
+			}
+			clientset = kept.clientset
+			entry.lastError = nil
+		}
+	}
+	r.lock.Unlock()
+
+	// If the context was modified meanwhile, the clientset is built again with the new configuration
+	if !isCurrent {
+		return r.GetImpersonatedClientset(contextName, impersonation)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return clientset, nil
+}
+
+// GetImpersonatedMetrics gives a metrics clientset for the given context impersonating the given identity, building
+// it if needed. Without impersonation, the clientset uses the user of the context
+func (r *Registry) GetImpersonatedMetrics(contextName string, impersonation *Impersonation) (*metrics.Clientset, error) {
+
+	// Try to get it from the cache
+	entry, cached, err := r.getEntry(contextName, impersonation)
+	if err != nil {
+		return nil, err
+	}
+	if cached.metrics != nil {
+		return cached.metrics, nil
+	}
+
+	// Build the clientset outside of the lock, as reading the configuration may require a round-trip with its store
+	var versioned *metrics.Clientset
+	config, err := getImpersonatedRestConfig(contextName, impersonation)
+	if err == nil {
+		versioned, err = metrics.NewForConfig(config)
+	}
+
+	// Keep the clientset, unless another one was built meanwhile
+	r.lock.Lock()
+	isCurrent := r.entries[contextName] == entry
+	if isCurrent {
+		if err != nil {
+			entry.lastError = err
+		} else {
+			kept := r.getCachedClientsets(entry, impersonation)
+			if kept.metrics == nil {
+				kept.metrics = versioned
+			}
+			versioned = kept.metrics
+			entry.lastError = nil
+		}
+	}
+	r.lock.Unlock()
+
+	// If the context was modified meanwhile, the clientset is built again with the new configuration
+	if !isCurrent {
+		return r.GetImpersonatedMetrics(contextName, impersonation)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return versioned, nil
+}
+
+// getEntry returns the entry of a context along with a copy of its cached clientsets for an impersonation
+func (r *Registry) getEntry(contextName string, impersonation *Impersonation) (*registryEntry, clientsets, error) {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	entry, ok := r.entries[contextName]
+	if !ok {
+		return nil, clientsets{}, &NotFoundError{contextName}
+	}
+
+	return entry, *r.getCachedClientsets(entry, impersonation), nil
+}
+
+// getCachedClientsets returns the clientsets of a context for an impersonation, creating an empty set if needed. The
+// lock of the registry must be held
+func (r *Registry) getCachedClientsets(entry *registryEntry, impersonation *Impersonation) *clientsets {
+
+	if impersonation == nil {
+		return &entry.clientsets
+	}
+
+	key := impersonation.key()
+
+	// Mark the identity as the most recently used
+	for i, usedKey := range entry.impersonatedUsages {
+		if usedKey == key {
+			entry.impersonatedUsages = append(entry.impersonatedUsages[:i], entry.impersonatedUsages[i+1:]...)
+			break
+		}
+	}
+	entry.impersonatedUsages = append(entry.impersonatedUsages, key)
+
+	if entry.impersonated == nil {
+		entry.impersonated = make(map[string]*clientsets)
+	}
+
+	cached, ok := entry.impersonated[key]
+	if !ok {
+		cached = &clientsets{}
+		entry.impersonated[key] = cached
+
+		// Drop the least recently used identities
+		for len(entry.impersonatedUsages) > r.impersonationCacheSize {
+			delete(entry.impersonated, entry.impersonatedUsages[0])
+			entry.impersonatedUsages = entry.impersonatedUsages[1:]
+		}
+	}
+
+	return cached
+}
@@ -0,0 +1,288 @@
+package context
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"time"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// buildContextRestConfig builds the configuration for connecting to the given context of the configuration, along
+// with its default namespace. The contexts of the configuration file take precedence over the in-cluster context
+func buildContextRestConfig(kubeConfig *KubeConfig, contextName string) (*rest.Config, string, error) {
+
+	if context := findContext(kubeConfig, contextName); context != nil {
+		restConfig, err := buildRestConfig(kubeConfig, contextName)
+		return restConfig, context.DefinitionContext.Namespace, err
+	}
+
+	if len(inClusterContextName) > 0 && contextName == inClusterContextName {
+		restConfig, err := buildInClusterRestConfig()
+		return restConfig, getInClusterNamespace(), err
+	}
+
+	return nil, "", &NotFoundError{contextName}
+}
+
+// buildRestConfig builds the configuration for connecting to the given context directly from the parsed configuration.
+// Contrary to clientcmd.BuildConfigFromFlags, the current context of the configuration is not used, so nothing has
+// to be written in the context configuration file
+func buildRestConfig(config *KubeConfig, contextName string) (*rest.Config, error) {
+
+	apiConfig, err := toAPIConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := apiConfig.Contexts[contextName]; !ok {
+		return nil, &NotFoundError{contextName}
+	}
+
+	restConfig, err := clientcmd.NewNonInteractiveClientConfig(*apiConfig, contextName, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build the connection configuration of the context \"%s\" due to: %v", contextName, err.Error())
+	}
+
+	if cluster := findCluster(config, apiConfig.Contexts[contextName].Cluster); cluster != nil {
+		if err = applyClusterExtra(restConfig, cluster); err != nil {
+			return nil, err
+		}
+	}
+
+	return restConfig, nil
+}
+
+// applyClusterExtra applies the entries of a cluster that are not modelled by the Kubernetes client used by the
+// application (proxy-url and tls-server-name), and which are only kept in the Extra fields
+func applyClusterExtra(config *rest.Config, cluster *NamedCluster) error {
+
+	if serverName, ok := cluster.DefinitionCluster.Extra[clusterTLSServerNameKey].(string); ok {
+		config.TLSClientConfig.ServerName = serverName
+	}
+
+	if value, ok := cluster.DefinitionCluster.Extra[clusterProxyURLKey].(string); ok && len(value) > 0 {
+		proxyURL, err := url.Parse(value)
+		if err != nil || len(proxyURL.Scheme) == 0 || len(proxyURL.Host) == 0 {
+			return fmt.Errorf("the proxy \"%s\" of the cluster \"%s\" is not a valid URL", value, cluster.Name)
+		}
+		setProxy(config, proxyURL)
+	}
+
+	return nil
+}
+
+// The entries of the clusters kept in their Extra fields, as not modelled by the Kubernetes client
+const (
+	clusterProxyURLKey      = "proxy-url"
+	clusterTLSServerNameKey = "tls-server-name"
+)
+
+// toAPIConfig converts the configuration to the structure used by the Kubernetes client
+func toAPIConfig(config *KubeConfig) (*clientcmdapi.Config, error) {
+
+	apiConfig := clientcmdapi.NewConfig()
+
+	for _, cluster := range config.Clusters {
+
+		certificateAuthorityData, err := decodeData(cluster.DefinitionCluster.CertificateAuthorityData)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode the certificate authority of the cluster \"%s\" due to: %v", cluster.Name, err.Error())
+		}
+
+		apiConfig.Clusters[cluster.Name] = &clientcmdapi.Cluster{
+			Server:                   cluster.DefinitionCluster.Server,
+			InsecureSkipTLSVerify:    cluster.DefinitionCluster.InsecureSkipTLSVerify,
+			CertificateAuthority:     resolvePath(cluster.DefinitionCluster.CertificateAuthority),
+			CertificateAuthorityData: certificateAuthorityData,
+		}
+	}
+
+	for _, user := range config.Users {
+
+		// The credentials are only decrypted in memory
+		if err := DecryptUserCredentials(&user); err != nil {
+			return nil, err
+		}
+
+		clientCertificateData, err := decodeData(user.DefinitionUser.ClientCertificateData)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode the client certificate of the user \"%s\" due to: %v", user.Name, err.Error())
+		}
+
+		clientKeyData, err := decodeData(user.DefinitionUser.ClientKeyData)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode the client key of the user \"%s\" due to: %v", user.Name, err.Error())
+		}
+
+		authInfo := &clientcmdapi.AuthInfo{
+			Username:              user.DefinitionUser.UserName,
+			Password:              user.DefinitionUser.Password,
+			ClientCertificate:     resolvePath(user.DefinitionUser.ClientCertificate),
+			ClientKey:             resolvePath(user.DefinitionUser.ClientKey),
+			ClientCertificateData: clientCertificateData,
+			ClientKeyData:         clientKeyData,
+			Token:                 user.DefinitionUser.Token,
+			TokenFile:             resolvePath(user.DefinitionUser.TokenFile),
+		}
+
+		if exec := user.DefinitionUser.Exec; exec != nil {
+			authInfo.Exec = &clientcmdapi.ExecConfig{
+				Command:    exec.Command,
+				Args:       exec.Args,
+				APIVersion: exec.APIVersion,
+			}
+			for _, env := range exec.Env {
+				authInfo.Exec.Env = append(authInfo.Exec.Env, clientcmdapi.ExecEnvVar{Name: env.Name, Value: env.Value})
+			}
+		}
+
+		if authProvider := user.DefinitionUser.AuthProvider; authProvider != nil {
+			authInfo.AuthProvider = &clientcmdapi.AuthProviderConfig{
+				Name:   authProvider.Name,
+				Config: authProvider.Config,
+			}
+		}
+
+		apiConfig.AuthInfos[user.Name] = authInfo
+	}
+
+	for _, context := range config.Contexts {
+		apiConfig.Contexts[context.Name] = &clientcmdapi.Context{
+			Cluster:   context.DefinitionContext.Cluster,
+			AuthInfo:  context.DefinitionContext.User,
+			Namespace: context.DefinitionContext.Namespace,
+		}
+	}
+
+	apiConfig.CurrentContext = config.CurrentContext
+
+	return apiConfig, nil
+}
+
+// ValidateClientSettings checks that the settings of the clients of a context are usable
+func ValidateClientSettings(settings ParamClientSettings) error {
+
+	if settings.QPS < 0 {
+		return fmt.Errorf("the QPS %v can not be negative", settings.QPS)
+	}
+
+	if settings.Burst < 0 {
+		return fmt.Errorf("the burst %v can not be negative", settings.Burst)
+	}
+
+	if len(settings.Timeout) > 0 {
+		timeout, err := time.ParseDuration(settings.Timeout)
+		if err != nil {
+			return fmt.Errorf("the timeout \"%s\" is not a valid duration", settings.Timeout)
+		}
+		if timeout < 0 {
+			return fmt.Errorf("the timeout \"%s\" can not be negative", settings.Timeout)
+		}
+	}
+
+	if len(settings.ProxyURL) > 0 {
+		proxyURL, err := url.Parse(settings.ProxyURL)
+		if err != nil || len(proxyURL.Scheme) == 0 || len(proxyURL.Host) == 0 {
+			return fmt.Errorf("the proxy \"%s\" is not a valid URL", settings.ProxyURL)
+		}
+	}
+
+	return nil
+}
+
+// applyClientSettings applies the settings of the clients of a context to its connection configuration. Note that the
+// timeout also applies to the watches, which are then regularly restarted
+func applyClientSettings(config *rest.Config, settings DefinitionClientSettings) error {
+
+	if settings.QPS > 0 {
+		config.QPS = settings.QPS
+		// Without an explicit burst, allow one second of requests at once
+		config.Burst = int(math.Ceil(float64(settings.QPS)))
+	}
+
+	if settings.Burst > 0 {
+		config.Burst = settings.Burst
+	}
+
+	if len(settings.Timeout) > 0 {
+		timeout, err := time.ParseDuration(settings.Timeout)
+		if err != nil {
+			return fmt.Errorf("the timeout \"%s\" is not a valid duration", settings.Timeout)
+		}
+		config.Timeout = timeout
+	}
+
+	if len(settings.UserAgentSuffix) > 0 {
+		config.UserAgent = rest.DefaultKubernetesUserAgent() + " " + settings.UserAgentSuffix
+	}
+
+	if len(settings.ProxyURL) > 0 {
+		proxyURL, err := url.Parse(settings.ProxyURL)
+		if err != nil {
+			return fmt.Errorf("the proxy \"%s\" is not a valid URL", settings.ProxyURL)
+		}
+
+		setProxy(config, proxyURL)
+	}
+
+	return nil
+}
+
+// setProxy sends the requests of a connection configuration through a proxy
+func setProxy(config *rest.Config, proxyURL *url.URL) {
+
+	// The transports are shared between the clients having the same TLS configuration, so the proxy is only set on a
+	// copy
+	config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		if transport, ok := rt.(*http.Transport); ok {
+			return copyTransportWithProxy(transport, proxyURL)
+		}
+		return rt
+	}
+}
+
+// copyTransportWithProxy returns a copy of a transport using a proxy. As http.Transport.Clone is not available before
+// Go 1.13, the fields set by the Kubernetes client are copied one by one, and HTTP/2 is configured again for the copy
+func copyTransportWithProxy(transport *http.Transport, proxyURL *url.URL) *http.Transport {
+
+	return utilnet.SetTransportDefaults(&http.Transport{
+		Proxy:                  http.ProxyURL(proxyURL),
+		DialContext:            transport.DialContext,
+		TLSClientConfig:        transport.TLSClientConfig.Clone(),
+		TLSHandshakeTimeout:    transport.TLSHandshakeTimeout,
+		DisableKeepAlives:      transport.DisableKeepAlives,
+		DisableCompression:     transport.DisableCompression,
+		MaxIdleConns:           transport.MaxIdleConns,
+		MaxIdleConnsPerHost:    transport.MaxIdleConnsPerHost,
+		MaxConnsPerHost:        transport.MaxConnsPerHost,
+		IdleConnTimeout:        transport.IdleConnTimeout,
+		ResponseHeaderTimeout:  transport.ResponseHeaderTimeout,
+		ExpectContinueTimeout:  transport.ExpectContinueTimeout,
+		MaxResponseHeaderBytes: transport.MaxResponseHeaderBytes,
+	})
+}
+
+// decodeData decodes a base64 field of the configuration (the *-data fields)
+func decodeData(data string) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return base64.StdEncoding.DecodeString(data)
+}
+
+// resolvePath resolves a path found in the configuration. As for kubectl, relative paths are relative to the
+// directory of the context configuration file
+func resolvePath(fileName string) string {
+	if len(fileName) == 0 || filepath.IsAbs(fileName) {
+		return fileName
+	}
+	return filepath.Join(filepath.Dir(contextConfigurationFileName), fileName)
+}
@@ -0,0 +1,110 @@
+package context
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestBuildRestConfigClusterExtra(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		extra              map[string]interface{}
+		expectedServerName string
+		expectedProxy      string
+		expectError        bool
+	}{
+		{
+			name: "no extra",
+		},
+		{
+			name:               "server name",
+			extra:              map[string]interface{}{"tls-server-name": "kubernetes.example.com"},
+			expectedServerName: "kubernetes.example.com",
+		},
+		{
+			name:          "proxy",
+			extra:         map[string]interface{}{"proxy-url": "http://proxy.example.com:3128"},
+			expectedProxy: "http://proxy.example.com:3128",
+		},
+		{
+			name:        "invalid proxy",
+			extra:       map[string]interface{}{"proxy-url": "proxy.example.com"},
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			config := &KubeConfig{
+				Clusters: []NamedCluster{{Name: "cluster", DefinitionCluster: DefinitionCluster{Server: "https://10.0.0.1:6443", Extra: test.extra}}},
+				Users:    []NamedUser{{Name: "user", DefinitionUser: DefinitionUser{Token: "token"}}},
+				Contexts: []NamedContext{{Name: "context", DefinitionContext: DefinitionContext{Cluster: "cluster", User: "user"}}},
+			}
+
+			restConfig, err := buildRestConfig(config, "context")
+			if test.expectError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if restConfig.TLSClientConfig.ServerName != test.expectedServerName {
+				t.Errorf("expected the server name \"%s\", got \"%s\"", test.expectedServerName, restConfig.TLSClientConfig.ServerName)
+			}
+
+			if len(test.expectedProxy) == 0 {
+				if restConfig.WrapTransport != nil {
+					t.Error("unexpected proxy")
+				}
+				return
+			}
+
+			if restConfig.WrapTransport == nil {
+				t.Fatal("the proxy is not set")
+			}
+			transport, ok := restConfig.WrapTransport(&http.Transport{}).(*http.Transport)
+			if !ok || transport.Proxy == nil {
+				t.Fatal("the proxy is not set on the transport")
+			}
+			request, _ := http.NewRequest(http.MethodGet, "https://10.0.0.1:6443", nil)
+			proxyURL, err := transport.Proxy(request)
+			if err != nil || proxyURL == nil || proxyURL.String() != test.expectedProxy {
+				t.Errorf("expected the proxy %s, got %v", test.expectedProxy, proxyURL)
+			}
+		})
+	}
+}
+
+func TestCopyTransportWithProxy(t *testing.T) {
+
+	original := &http.Transport{
+		TLSClientConfig:     &tls.Config{ServerName: "kubernetes.example.com"},
+		MaxIdleConnsPerHost: 25,
+	}
+	proxyURL, _ := url.Parse("http://proxy.example.com:3128")
+
+	transport := copyTransportWithProxy(original, proxyURL)
+
+	if original.Proxy != nil {
+		t.Error("the original transport was modified")
+	}
+	if transport.TLSClientConfig == original.TLSClientConfig || transport.TLSClientConfig.ServerName != "kubernetes.example.com" {
+		t.Error("the TLS configuration was not copied")
+	}
+	if transport.MaxIdleConnsPerHost != 25 {
+		t.Error("the transport settings were not copied")
+	}
+
+	request, _ := http.NewRequest(http.MethodGet, "https://10.0.0.1:6443", nil)
+	if used, err := transport.Proxy(request); err != nil || used == nil || used.String() != proxyURL.String() {
+		t.Errorf("expected the proxy %v, got %v", proxyURL, used)
+	}
+}
@@ -0,0 +1,135 @@
+package context
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/twuillemin/kuboxy/internal/configuration"
+	"k8s.io/client-go/kubernetes"
+)
+
+// The backends available for storing the context configuration
+const (
+	// FileStore keeps the context configuration in a local file
+	FileStore = "file"
+	// MemoryStore keeps the context configuration in memory only
+	MemoryStore = "memory"
+	// SecretStore keeps the context configuration in a Kubernetes Secret of the hosting cluster
+	SecretStore = "secret"
+)
+
+// ConfigStore is the backend storing the content of the context configuration, a kubectl configuration in YAML
+type ConfigStore interface {
+	// Load returns the content of the configuration, nil if the configuration does not exist yet
+	Load() ([]byte, error)
+	// Save replaces the content of the configuration
+	Save(data []byte) error
+	// Watch returns a channel signaled when the content of the configuration may have been modified by someone else,
+	// until the stop channel is closed
+	Watch(stopChannel <-chan struct{}) <-chan struct{}
+}
+
+// NewConfigStore creates the backend defined in the configuration of the application
+func NewConfigStore(applicationConfiguration configuration.ApplicationConfiguration) (ConfigStore, error) {
+
+	switch applicationConfiguration.ContextStore {
+
+	case "", FileStore:
+		return NewFileConfigStore(applicationConfiguration.KubeContextConfigurationFile), nil
+
+	case MemoryStore:
+		return NewMemoryConfigStore(nil), nil
+
+	case SecretStore:
+		restConfig, err := buildInClusterRestConfig()
+		if err != nil {
+			return nil, fmt.Errorf("the secret store is only available when running in a cluster: %v", err.Error())
+		}
+
+		clientset, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		namespace := applicationConfiguration.ContextStoreSecretNamespace
+		if len(namespace) == 0 {
+			namespace = getInClusterNamespace()
+		}
+
+		return NewSecretConfigStore(clientset, namespace, applicationConfiguration.ContextStoreSecretName), nil
+	}
+
+	return nil, fmt.Errorf("the context store \"%s\" is unknown, it must be one of: %s, %s or %s", applicationConfiguration.ContextStore, FileStore, MemoryStore, SecretStore)
+}
+
+// memoryConfigStore is a ConfigStore keeping the configuration in memory, mostly for tests
+type memoryConfigStore struct {
+	lock     sync.Mutex
+	data     []byte
+	watchers []chan struct{}
+}
+
+// NewMemoryConfigStore creates a ConfigStore keeping the configuration in memory, starting with the given content
+func NewMemoryConfigStore(data []byte) ConfigStore {
+	return &memoryConfigStore{
+		data:     data,
+		watchers: make([]chan struct{}, 0),
+	}
+}
+
+// Load returns the content of the configuration
+func (store *memoryConfigStore) Load() ([]byte, error) {
+
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	return store.data, nil
+}
+
+// Save replaces the content of the configuration and signals the watchers
+func (store *memoryConfigStore) Save(data []byte) error {
+
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	store.data = data
+
+	var signal struct{}
+	for _, watcher := range store.watchers {
+		// Don't block, a single pending signal is enough for reloading
+		select {
+		case watcher <- signal:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// Watch returns a channel signaled each time the configuration is saved
+func (store *memoryConfigStore) Watch(stopChannel <-chan struct{}) <-chan struct{} {
+
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	watcher := make(chan struct{}, 1)
+	store.watchers = append(store.watchers, watcher)
+
+	go func() {
+		<-stopChannel
+
+		store.lock.Lock()
+		defer store.lock.Unlock()
+
+		newWatchers := make([]chan struct{}, 0, len(store.watchers))
+		for _, existingWatcher := range store.watchers {
+			if existingWatcher != watcher {
+				newWatchers = append(newWatchers, existingWatcher)
+			}
+		}
+		store.watchers = newWatchers
+		close(watcher)
+	}()
+
+	return watcher
+}
@@ -0,0 +1,122 @@
+package context
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// fileConfigStore is a ConfigStore keeping the configuration in a local file
+type fileConfigStore struct {
+	fileName string
+}
+
+// NewFileConfigStore creates a ConfigStore keeping the configuration in the given file
+func NewFileConfigStore(fileName string) ConfigStore {
+	return &fileConfigStore{
+		fileName: fileName,
+	}
+}
+
+// Load reads the content of the file, nil if the file does not exist
+func (store *fileConfigStore) Load() ([]byte, error) {
+
+	data, err := ioutil.ReadFile(store.fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to read the context configuration file due to: %v", err.Error())
+	}
+
+	return data, nil
+}
+
+// Save writes the content of the file, creating the file and its directory if needed. The content is first written to
+// a temporary file which then replaces the file, so that the file is never seen partially written. As the file holds
+// credentials, it is only accessible to its owner
+func (store *fileConfigStore) Save(data []byte) (err error) {
+
+	// Replace the target of the file rather than the link, if the file is a symbolic link
+	fileName := store.fileName
+	if target, linkErr := filepath.EvalSymlinks(fileName); linkErr == nil {
+		fileName = target
+	}
+
+	directory := filepath.Dir(fileName)
+	if _, err = os.Stat(directory); err != nil {
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("unable to access to the directory for context configuration file due to: %v", err.Error())
+		}
+		if err = os.MkdirAll(directory, 0700); err != nil {
+			return fmt.Errorf("unable to create to the directory for context configuration file due to: %v", err.Error())
+		}
+	}
+
+	// The temporary file is created in the same directory, so that it can be renamed. It is only accessible to its owner
+	file, err := ioutil.TempFile(directory, "."+filepath.Base(fileName)+".tmp")
+	if err != nil {
+		return fmt.Errorf("unable to create the temporary context configuration file due to: %v", err.Error())
+	}
+
+	// Remove the temporary file if it could not replace the file
+	defer func() {
+		if err != nil {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	// Write the content to the temporary file and ensure it is on the disk before replacing the file
+	if _, err = file.Write(data); err != nil {
+		return fmt.Errorf("unable to write the configuration into the file due to: %v", err.Error())
+	}
+	if err = file.Sync(); err != nil {
+		return fmt.Errorf("unable to write the configuration into the file due to: %v", err.Error())
+	}
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("unable to write the configuration into the file due to: %v", err.Error())
+	}
+
+	if err = os.Rename(file.Name(), fileName); err != nil {
+		return fmt.Errorf("unable to replace the context configuration file due to: %v", err.Error())
+	}
+
+	return nil
+}
+
+// Watch returns a channel signaled regularly, so that the file is checked for modifications. Polling is used as the
+// file may be replaced rather than modified, for example when it is mounted from a ConfigMap
+func (store *fileConfigStore) Watch(stopChannel <-chan struct{}) <-chan struct{} {
+	return pollChanges(watchInterval, stopChannel)
+}
+
+// pollChanges returns a channel signaled at each interval until the stop channel is closed
+func pollChanges(interval time.Duration, stopChannel <-chan struct{}) <-chan struct{} {
+
+	signals := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		defer close(signals)
+
+		var signal struct{}
+		for {
+			select {
+			case <-ticker.C:
+				select {
+				case signals <- signal:
+				case <-stopChannel:
+					return
+				}
+			case <-stopChannel:
+				return
+			}
+		}
+	}()
+
+	return signals
+}
@@ -0,0 +1,139 @@
+package context
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DefaultSecretName is the default name of the Secret keeping the context configuration
+const DefaultSecretName = "kuboxy-contexts"
+
+// The key of the Secret holding the context configuration
+const secretConfigKey = "config"
+
+// secretConfigStore is a ConfigStore keeping the configuration in a Kubernetes Secret
+type secretConfigStore struct {
+	clientset kubernetes.Interface
+	namespace string
+	name      string
+}
+
+// NewSecretConfigStore creates a ConfigStore keeping the configuration in the given Secret. If no name is given, the
+// default name is used
+func NewSecretConfigStore(clientset kubernetes.Interface, namespace string, name string) ConfigStore {
+
+	if len(name) == 0 {
+		name = DefaultSecretName
+	}
+
+	return &secretConfigStore{
+		clientset: clientset,
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+// Load reads the content of the Secret, nil if the Secret does not exist
+func (store *secretConfigStore) Load() ([]byte, error) {
+
+	secret, err := store.clientset.CoreV1().Secrets(store.namespace).Get(store.name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to read the secret %s/%s due to: %v", store.namespace, store.name, err.Error())
+	}
+
+	return secret.Data[secretConfigKey], nil
+}
+
+// Save writes the content of the Secret, creating the Secret if needed
+func (store *secretConfigStore) Save(data []byte) error {
+
+	secrets := store.clientset.CoreV1().Secrets(store.namespace)
+
+	secret, err := secrets.Get(store.name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to read the secret %s/%s due to: %v", store.namespace, store.name, err.Error())
+		}
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      store.name,
+				Namespace: store.namespace,
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{secretConfigKey: data},
+		}
+		if _, err = secrets.Create(secret); err != nil {
+			return fmt.Errorf("unable to create the secret %s/%s due to: %v", store.namespace, store.name, err.Error())
+		}
+		return nil
+	}
+
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	secret.Data[secretConfigKey] = data
+
+	if _, err = secrets.Update(secret); err != nil {
+		return fmt.Errorf("unable to update the secret %s/%s due to: %v", store.namespace, store.name, err.Error())
+	}
+
+	return nil
+}
+
+// Watch returns a channel signaled each time the Secret is modified. If the watch is interrupted by the server, it is
+// started again
+func (store *secretConfigStore) Watch(stopChannel <-chan struct{}) <-chan struct{} {
+
+	signals := make(chan struct{})
+
+	go func() {
+		defer close(signals)
+
+		var signal struct{}
+		for {
+			watcher, err := store.clientset.CoreV1().Secrets(store.namespace).Watch(metav1.ListOptions{
+				FieldSelector: fields.OneTermEqualSelector("metadata.name", store.name).String(),
+			})
+			if err != nil {
+				fmt.Printf("Unable to watch the secret %s/%s due to: %v\n", store.namespace, store.name, err.Error())
+				select {
+				case <-time.After(watchInterval):
+					continue
+				case <-stopChannel:
+					return
+				}
+			}
+
+		watchLoop:
+			for {
+				select {
+				case _, ok := <-watcher.ResultChan():
+					if !ok {
+						break watchLoop
+					}
+					select {
+					case signals <- signal:
+					case <-stopChannel:
+						watcher.Stop()
+						return
+					}
+				case <-stopChannel:
+					watcher.Stop()
+					return
+				}
+			}
+		}
+	}()
+
+	return signals
+}
@@ -0,0 +1,151 @@
+package context
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSecretConfigStoreLoad(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		existing []corev1.Secret
+		expected []byte
+	}{
+		{
+			name:     "missing secret",
+			expected: nil,
+		},
+		{
+			name: "secret without the key",
+			existing: []corev1.Secret{
+				{ObjectMeta: metav1.ObjectMeta{Name: DefaultSecretName, Namespace: "kuboxy"}, Data: map[string][]byte{"other": []byte("x")}},
+			},
+			expected: nil,
+		},
+		{
+			name: "secret with the configuration",
+			existing: []corev1.Secret{
+				{ObjectMeta: metav1.ObjectMeta{Name: DefaultSecretName, Namespace: "kuboxy"}, Data: map[string][]byte{secretConfigKey: []byte("kind: Config")}},
+			},
+			expected: []byte("kind: Config"),
+		},
+		{
+			name: "secret in another namespace",
+			existing: []corev1.Secret{
+				{ObjectMeta: metav1.ObjectMeta{Name: DefaultSecretName, Namespace: "other"}, Data: map[string][]byte{secretConfigKey: []byte("kind: Config")}},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			clientset := fake.NewSimpleClientset()
+			for i := range test.existing {
+				if _, err := clientset.CoreV1().Secrets(test.existing[i].Namespace).Create(&test.existing[i]); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			data, err := NewSecretConfigStore(clientset, "kuboxy", "").Load()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(data) != string(test.expected) {
+				t.Errorf("expected %q, got %q", test.expected, data)
+			}
+		})
+	}
+}
+
+func TestSecretConfigStoreSave(t *testing.T) {
+
+	clientset := fake.NewSimpleClientset()
+	store := NewSecretConfigStore(clientset, "kuboxy", "contexts")
+
+	// The secret is created on first save
+	if err := store.Save([]byte("first")); err != nil {
+		t.Fatalf("unable to create the secret: %v", err)
+	}
+
+	secret, err := clientset.CoreV1().Secrets("kuboxy").Get("contexts", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("the secret was not created: %v", err)
+	}
+	if secret.Type != corev1.SecretTypeOpaque {
+		t.Errorf("expected an opaque secret, got %s", secret.Type)
+	}
+	if string(secret.Data[secretConfigKey]) != "first" {
+		t.Errorf("expected the first content, got %q", secret.Data[secretConfigKey])
+	}
+
+	// The other keys of the secret are kept when updating
+	secret.Data["other"] = []byte("kept")
+	if _, err = clientset.CoreV1().Secrets("kuboxy").Update(secret); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = store.Save([]byte("second")); err != nil {
+		t.Fatalf("unable to update the secret: %v", err)
+	}
+
+	data, err := store.Load()
+	if err != nil {
+		t.Fatalf("unable to load the secret: %v", err)
+	}
+	if string(data) != "second" {
+		t.Errorf("expected the second content, got %q", data)
+	}
+
+	secret, err = clientset.CoreV1().Secrets("kuboxy").Get("contexts", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(secret.Data["other"]) != "kept" {
+		t.Errorf("the other keys of the secret were not kept: %v", secret.Data)
+	}
+}
+
+func TestSecretConfigStoreWatch(t *testing.T) {
+
+	clientset := fake.NewSimpleClientset()
+	store := NewSecretConfigStore(clientset, "kuboxy", "")
+
+	stopChannel := make(chan struct{})
+	signals := store.Watch(stopChannel)
+
+	// The watch is started asynchronously, so save until a signal is received
+	received := false
+	for i := 0; i < 50 && !received; i++ {
+		if err := store.Save([]byte("content")); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case <-signals:
+			received = true
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	if !received {
+		t.Fatal("no signal received after saving the secret")
+	}
+
+	// The channel is closed once stopped
+	close(stopChannel)
+	for {
+		select {
+		case _, ok := <-signals:
+			if !ok {
+				return
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("the channel was not closed after stopping the watch")
+		}
+	}
+}
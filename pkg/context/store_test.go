@@ -0,0 +1,149 @@
+package context
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileConfigStore(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "kuboxy-store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	fileName := filepath.Join(directory, "sub", "config")
+	store := NewFileConfigStore(fileName)
+
+	// A missing file is not an error
+	data, err := store.Load()
+	if err != nil {
+		t.Fatalf("unexpected error for a missing file: %v", err)
+	}
+	if data != nil {
+		t.Errorf("expected no content for a missing file, got %q", data)
+	}
+
+	// The file and its directory are created, the file being only accessible to its owner
+	for _, content := range []string{"a longer first content", "second"} {
+		if err = store.Save([]byte(content)); err != nil {
+			t.Fatalf("unable to save: %v", err)
+		}
+
+		data, err = store.Load()
+		if err != nil {
+			t.Fatalf("unable to load: %v", err)
+		}
+		if string(data) != content {
+			t.Errorf("expected %q, got %q", content, data)
+		}
+	}
+
+	info, err := os.Stat(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected the permissions 0600, got %v", info.Mode().Perm())
+	}
+
+	// No temporary file is left
+	files, err := ioutil.ReadDir(filepath.Dir(fileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("expected only the configuration file, got %d files", len(files))
+	}
+}
+
+func TestFileConfigStoreSymbolicLink(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "kuboxy-store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	target := filepath.Join(directory, "target")
+	link := filepath.Join(directory, "link")
+	if err = ioutil.WriteFile(target, []byte("before"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Symlink(target, link); err != nil {
+		t.Skipf("symbolic links are not available: %v", err)
+	}
+
+	if err = NewFileConfigStore(link).Save([]byte("after")); err != nil {
+		t.Fatalf("unable to save: %v", err)
+	}
+
+	// The link is kept and the target is replaced
+	if info, err := os.Lstat(link); err != nil || info.Mode()&os.ModeSymlink == 0 {
+		t.Errorf("the symbolic link was replaced")
+	}
+	data, err := ioutil.ReadFile(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "after" {
+		t.Errorf("expected the target to be replaced, got %q", data)
+	}
+}
+
+func TestMemoryConfigStore(t *testing.T) {
+
+	store := NewMemoryConfigStore([]byte("initial"))
+
+	stopChannel := make(chan struct{})
+	signals := store.Watch(stopChannel)
+
+	data, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "initial" {
+		t.Errorf("expected the initial content, got %q", data)
+	}
+
+	// Several saves only leave a single pending signal
+	for _, content := range []string{"first", "second"} {
+		if err = store.Save([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	select {
+	case <-signals:
+	case <-time.After(time.Second):
+		t.Fatal("no signal received after saving")
+	}
+	select {
+	case <-signals:
+		t.Fatal("unexpected second signal")
+	default:
+	}
+
+	data, err = store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "second" {
+		t.Errorf("expected the last content, got %q", data)
+	}
+
+	// The channel is closed once stopped
+	close(stopChannel)
+	select {
+	case _, ok := <-signals:
+		if ok {
+			t.Error("unexpected signal after stopping")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the channel was not closed after stopping the watch")
+	}
+}
@@ -0,0 +1,235 @@
+package context
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+// The delay between two checks of the context configuration, when the store has to be polled
+const watchInterval = 2 * time.Second
+
+// ConfigurationChange lists the contexts impacted by a modification of the context configuration. A context is
+// changed when its definition, its cluster, its user or its client settings are modified
+type ConfigurationChange struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+	Changed []string `json:"changed"`
+}
+
+// contextSnapshot is everything defining the connection of a context
+type contextSnapshot struct {
+	context        NamedContext
+	cluster        *NamedCluster
+	user           *NamedUser
+	clientSettings *NamedClientSettings
+}
+
+// The state of the context configuration when last read
+var watchState = struct {
+	lock      sync.Mutex
+	content   []byte
+	snapshots map[string]contextSnapshot
+	clients   []chan ConfigurationChange
+}{
+	snapshots: make(map[string]contextSnapshot),
+	clients:   make([]chan ConfigurationChange, 0),
+}
+
+// WatchContexts starts watching the store of the context configuration, until the given channel is closed. When the
+// configuration is modified, the contexts are reloaded and the clients are notified of the changes
+func WatchContexts(stopChannel <-chan struct{}) {
+
+	signals := configStore.Watch(stopChannel)
+
+	go func() {
+		for {
+			select {
+			case _, ok := <-signals:
+				if !ok {
+					return
+				}
+				if _, err := ReloadContexts(); err != nil {
+					fmt.Printf("Unable to reload the context configuration due to: %v\n", err.Error())
+				}
+			case <-stopChannel:
+				return
+			}
+		}
+	}()
+}
+
+// ReloadContexts reads the context configuration and compares it to the previous read. The added contexts are
+// registered, the removed contexts are unregistered and the clientsets of the changed contexts are dropped. If the
+// configuration was not modified, nil is returned
+func ReloadContexts() (*ConfigurationChange, error) {
+
+	change, clients, err := applyConfigurationChange()
+	if change == nil || err != nil {
+		return nil, err
+	}
+
+	// The clients are notified without holding the lock, so that a slow client doesn't block the other reloads
+	for _, client := range clients {
+		select {
+		case client <- *change:
+		case <-time.After(100 * time.Millisecond):
+			fmt.Printf("One of the client was not able to receive a configuration change in its channel in given time \n")
+		}
+	}
+
+	return change, nil
+}
+
+// applyConfigurationChange reads the context configuration and applies its modifications to the registry. It returns
+// the change, nil if the configuration was not modified, and the clients to notify at the time of the change
+func applyConfigurationChange() (*ConfigurationChange, []chan ConfigurationChange, error) {
+
+	watchState.lock.Lock()
+	defer watchState.lock.Unlock()
+
+	content, err := configStore.Load()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Nothing to do if the configuration was not modified
+	if bytes.Equal(content, watchState.content) {
+		return nil, nil, nil
+	}
+
+	config, err := unmarshalKubeConfig(content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshots := takeSnapshots(config)
+	change := diffSnapshots(watchState.snapshots, snapshots)
+
+	watchState.content = content
+	watchState.snapshots = snapshots
+
+	if len(change.Added) == 0 && len(change.Removed) == 0 && len(change.Changed) == 0 {
+		return nil, nil, nil
+	}
+
+	for _, contextName := range change.Added {
+		registry.Register(contextName)
+	}
+	for _, contextName := range change.Removed {
+		unregisterContext(contextName)
+	}
+	registry.Invalidate(change.Changed...)
+
+	clients := make([]chan ConfigurationChange, len(watchState.clients))
+	copy(clients, watchState.clients)
+
+	return &change, clients, nil
+}
+
+// AddConfigurationChangeClient adds a new client that will receive the changes of the configuration
+func AddConfigurationChangeClient(client chan ConfigurationChange) {
+
+	watchState.lock.Lock()
+	defer watchState.lock.Unlock()
+
+	watchState.clients = append(watchState.clients, client)
+}
+
+// RemoveConfigurationChangeClient removes a client from receiving the changes of the configuration
+func RemoveConfigurationChangeClient(client chan ConfigurationChange) {
+
+	watchState.lock.Lock()
+	defer watchState.lock.Unlock()
+
+	newClients := make([]chan ConfigurationChange, 0, len(watchState.clients))
+	for _, existingClient := range watchState.clients {
+		if existingClient != client {
+			newClients = append(newClients, existingClient)
+		}
+	}
+	watchState.clients = newClients
+}
+
+// initWatchState keeps the current state of the context configuration, so that only the later modifications
+// are reported
+func initWatchState() error {
+
+	watchState.lock.Lock()
+	defer watchState.lock.Unlock()
+
+	content, err := configStore.Load()
+	if err != nil {
+		return err
+	}
+
+	config, err := unmarshalKubeConfig(content)
+	if err != nil {
+		return err
+	}
+
+	watchState.content = content
+	watchState.snapshots = takeSnapshots(config)
+
+	return nil
+}
+
+// takeSnapshots extracts the definition of all the contexts of a configuration
+func takeSnapshots(config *KubeConfig) map[string]contextSnapshot {
+
+	snapshots := make(map[string]contextSnapshot, len(config.Contexts))
+	for _, context := range config.Contexts {
+		snapshots[context.Name] = contextSnapshot{
+			context:        context,
+			cluster:        findCluster(config, context.DefinitionContext.Cluster),
+			user:           findUser(config, context.DefinitionContext.User),
+			clientSettings: findClientSettings(config, context.Name),
+		}
+	}
+
+	// The client settings of the in-cluster context are also followed
+	if len(inClusterContextName) > 0 {
+		if _, ok := snapshots[inClusterContextName]; !ok {
+			snapshots[inClusterContextName] = contextSnapshot{
+				context:        NamedContext{Name: inClusterContextName},
+				clientSettings: findClientSettings(config, inClusterContextName),
+			}
+		}
+	}
+
+	return snapshots
+}
+
+// diffSnapshots computes the contexts added, removed and changed between two snapshots
+func diffSnapshots(previous map[string]contextSnapshot, current map[string]contextSnapshot) ConfigurationChange {
+
+	change := ConfigurationChange{
+		Added:   make([]string, 0),
+		Removed: make([]string, 0),
+		Changed: make([]string, 0),
+	}
+
+	for contextName, snapshot := range current {
+		previousSnapshot, ok := previous[contextName]
+		if !ok {
+			change.Added = append(change.Added, contextName)
+		} else if !reflect.DeepEqual(previousSnapshot, snapshot) {
+			change.Changed = append(change.Changed, contextName)
+		}
+	}
+
+	for contextName := range previous {
+		if _, ok := current[contextName]; !ok {
+			change.Removed = append(change.Removed, contextName)
+		}
+	}
+
+	sort.Strings(change.Added)
+	sort.Strings(change.Removed)
+	sort.Strings(change.Changed)
+
+	return change
+}
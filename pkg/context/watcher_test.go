@@ -0,0 +1,47 @@
+package context
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWatchContexts(t *testing.T) {
+
+	loadTestContexts(t, existingKubeConfig)
+
+	// A client not reading its channel must not prevent the other clients from being notified
+	blockedClient := make(chan ConfigurationChange)
+	client := make(chan ConfigurationChange, 1)
+	AddConfigurationChangeClient(blockedClient)
+	AddConfigurationChangeClient(client)
+	defer RemoveConfigurationChangeClient(blockedClient)
+	defer RemoveConfigurationChangeClient(client)
+
+	stopWatching := make(chan struct{})
+	defer close(stopWatching)
+	WatchContexts(stopWatching)
+
+	if err := SetContext("watched", ParamContext{User: "admin", Cluster: "production"}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := ConfigurationChange{Added: []string{"watched"}, Removed: []string{}, Changed: []string{}}
+	select {
+	case change := <-client:
+		if !reflect.DeepEqual(change, expected) {
+			t.Errorf("expected the change %+v, got %+v", expected, change)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the change of the configuration was not notified")
+	}
+
+	// The configuration is already up to date
+	change, err := ReloadContexts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change != nil {
+		t.Errorf("expected no change, got %+v", change)
+	}
+}
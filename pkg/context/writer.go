@@ -1,37 +1,15 @@
 package context
 
 import (
-	"errors"
 	"fmt"
+	"sync"
+
 	"gopkg.in/yaml.v2"
-	"os"
 )
 
-// UseContext defines the current default context
-func UseContext(contextName string) error {
-
-	config, err := GetKubeConfig()
-	if err != nil {
-		return err
-	}
-
-	// Check if the context exist
-	found := false
-	for _, context := range config.Contexts {
-		if context.Name == contextName {
-			found = true
-			break
-		}
-	}
-	if !found {
-		return errors.New("the context to be used as default does not exist")
-	}
-
-	// Update the configuration
-	config.CurrentContext = contextName
-
-	return writeConfigFile(config)
-}
+// The lock serializing the modifications of the configuration, each one reading the configuration from its store,
+// modifying it and writing it back
+var configLock sync.Mutex
 
 // SetUserWithUserNamePassword adds or updates a user with the given credentials
 func SetUserWithUserNamePassword(userName string, credentials ParamCredentialsUserNamePassword) error {
@@ -75,15 +53,78 @@ func SetUserWithCertificateFileEmbedded(userName string, credentials ParamCreden
 		})
 }
 
+// SetUserWithToken adds or updates a user with the given bearer token
+func SetUserWithToken(userName string, credentials ParamCredentialsToken) error {
+
+	return createOrUpdateUser(
+		userName,
+		NamedUser{
+			Name: userName,
+			DefinitionUser: DefinitionUser{
+				Token: credentials.Token,
+			},
+		})
+}
+
+// SetUserWithTokenFile adds or updates a user with the bearer token given as a local file
+func SetUserWithTokenFile(userName string, credentials ParamCredentialsTokenFile) error {
+
+	return createOrUpdateUser(
+		userName,
+		NamedUser{
+			Name: userName,
+			DefinitionUser: DefinitionUser{
+				TokenFile: credentials.TokenFile,
+			},
+		})
+}
+
+// SetUserWithExec adds or updates a user with the credentials provided by an external command
+func SetUserWithExec(userName string, credentials ParamCredentialsExec) error {
+
+	return createOrUpdateUser(
+		userName,
+		NamedUser{
+			Name: userName,
+			DefinitionUser: DefinitionUser{
+				Exec: &ExecConfig{
+					Command:    credentials.Command,
+					Args:       credentials.Args,
+					Env:        credentials.Env,
+					APIVersion: credentials.APIVersion,
+				},
+			},
+		})
+}
+
+// SetUserWithAuthProvider adds or updates a user with the credentials provided by an authentication provider
+func SetUserWithAuthProvider(userName string, credentials ParamCredentialsAuthProvider) error {
+
+	return createOrUpdateUser(
+		userName,
+		NamedUser{
+			Name: userName,
+			DefinitionUser: DefinitionUser{
+				AuthProvider: &AuthProviderConfig{
+					Name:   credentials.Name,
+					Config: credentials.Config,
+				},
+			},
+		})
+}
+
 // createOrUpdateUser creates or updates the given userName entry with the given user object
 func createOrUpdateUser(userName string, user NamedUser) error {
 
+	configLock.Lock()
+	defer configLock.Unlock()
+
 	config, err := GetKubeConfig()
 	if err != nil {
 		return err
 	}
 
-	// Check if the user exist exist
+	// Check if the user exists
 	userIndex := -1
 	for i, u := range config.Users {
 		if u.Name == userName {
@@ -96,10 +137,20 @@ func createOrUpdateUser(userName string, user NamedUser) error {
 	if userIndex < 0 {
 		config.Users = append(config.Users, user)
 	} else {
+		// Keep the entries not modelled by the application
+		user.Extra = config.Users[userIndex].Extra
+		user.DefinitionUser.Extra = config.Users[userIndex].DefinitionUser.Extra
 		config.Users[userIndex] = user
 	}
 
-	return writeConfigFile(config)
+	if err = writeConfigFile(config); err != nil {
+		return err
+	}
+
+	// The contexts using the user have to reconnect with the new credentials
+	registry.Invalidate(getContextNamesUsing(config, func(context DefinitionContext) bool { return context.User == userName })...)
+
+	return nil
 }
 
 // SetClusterInsecure adds or updates a cluster without validating the server certificate
@@ -146,15 +197,18 @@ func SetClusterCertificateEmbedded(clusterName string, cluster ParamClusterCerti
 		})
 }
 
-// createOrUpdateUser creates or updates the given userName entry with the given user object
+// createOrUpdateCluster creates or updates the given clusterName entry with the given cluster object
 func createOrUpdateCluster(clusterName string, cluster NamedCluster) error {
 
+	configLock.Lock()
+	defer configLock.Unlock()
+
 	config, err := GetKubeConfig()
 	if err != nil {
 		return err
 	}
 
-	// Check if the user exist exist
+	// Check if the cluster exists
 	clusterIndex := -1
 	for i, c := range config.Clusters {
 		if c.Name == clusterName {
@@ -167,10 +221,20 @@ func createOrUpdateCluster(clusterName string, cluster NamedCluster) error {
 	if clusterIndex < 0 {
 		config.Clusters = append(config.Clusters, cluster)
 	} else {
+		// Keep the entries not modelled by the application
+		cluster.Extra = config.Clusters[clusterIndex].Extra
+		cluster.DefinitionCluster.Extra = config.Clusters[clusterIndex].DefinitionCluster.Extra
 		config.Clusters[clusterIndex] = cluster
 	}
 
-	return writeConfigFile(config)
+	if err = writeConfigFile(config); err != nil {
+		return err
+	}
+
+	// The contexts using the cluster have to reconnect to the new server
+	registry.Invalidate(getContextNamesUsing(config, func(context DefinitionContext) bool { return context.Cluster == clusterName })...)
+
+	return nil
 }
 
 // SetContext adds or updates a context
@@ -188,18 +252,21 @@ func SetContext(contextName string, context ParamContext) error {
 		})
 }
 
-// createOrUpdateUser creates or updates the given userName entry with the given user object
-func createOrUpdateContext(clusterName string, context NamedContext) error {
+// createOrUpdateContext creates or updates the given contextName entry with the given context object
+func createOrUpdateContext(contextName string, context NamedContext) error {
+
+	configLock.Lock()
+	defer configLock.Unlock()
 
 	config, err := GetKubeConfig()
 	if err != nil {
 		return err
 	}
 
-	// Check if the user exist exist
+	// Check if the context exists
 	contextIndex := -1
 	for i, c := range config.Contexts {
-		if c.Name == clusterName {
+		if c.Name == contextName {
 			contextIndex = i
 			break
 		}
@@ -209,33 +276,312 @@ func createOrUpdateContext(clusterName string, context NamedContext) error {
 	if contextIndex < 0 {
 		config.Contexts = append(config.Contexts, context)
 	} else {
+		// Keep the entries not modelled by the application
+		context.Extra = config.Contexts[contextIndex].Extra
+		context.DefinitionContext.Extra = config.Contexts[contextIndex].DefinitionContext.Extra
 		config.Contexts[contextIndex] = context
 	}
 
-	return writeConfigFile(config)
+	if err = writeConfigFile(config); err != nil {
+		return err
+	}
+
+	// Make the context immediately available, dropping the previous clientsets if any
+	registry.Register(contextName)
+
+	return nil
 }
 
-// writeConfigFile writes the given configuration into the context configuration file
-func writeConfigFile(config *KubeConfig) (err error) {
+// SetClientSettings creates or updates the settings of the clients of the given context. The clientsets of the
+// context are rebuilt on next use
+func SetClientSettings(contextName string, settings ParamClientSettings) error {
+
+	if err := ValidateClientSettings(settings); err != nil {
+		return err
+	}
 
-	file, err := os.OpenFile(contextConfigurationFileName, os.O_CREATE|os.O_WRONLY, 0600)
+	configLock.Lock()
+	defer configLock.Unlock()
+
+	if !registry.Contains(contextName) {
+		return &NotFoundError{contextName}
+	}
+
+	config, err := GetKubeConfig()
 	if err != nil {
-		return fmt.Errorf("unable to open the context configuration file due to: %v", err.Error())
+		return err
 	}
 
-	defer func() {
-		err = file.Close()
-	}()
+	definition := DefinitionClientSettings{
+		QPS:             settings.QPS,
+		Burst:           settings.Burst,
+		Timeout:         settings.Timeout,
+		UserAgentSuffix: settings.UserAgentSuffix,
+		ProxyURL:        settings.ProxyURL,
+	}
 
-	data, err := yaml.Marshal(config)
+	if existing := findClientSettings(config, contextName); existing != nil {
+		existing.DefinitionClientSettings = definition
+	} else {
+		config.ClientSettings = append(config.ClientSettings, NamedClientSettings{
+			Name:                     contextName,
+			DefinitionClientSettings: definition,
+		})
+	}
+
+	if err = writeConfigFile(config); err != nil {
+		return err
+	}
+
+	registry.Invalidate(contextName)
+
+	return nil
+}
+
+// DeleteClientSettings removes the settings of the clients of the given context, which then uses the default settings
+func DeleteClientSettings(contextName string) error {
+
+	configLock.Lock()
+	defer configLock.Unlock()
+
+	if !registry.Contains(contextName) {
+		return &NotFoundError{contextName}
+	}
+
+	config, err := GetKubeConfig()
 	if err != nil {
-		return fmt.Errorf("unable to marshall the given configuration due to: %v", err.Error())
+		return err
+	}
+
+	if !removeClientSettings(config, []string{contextName}) {
+		return nil
+	}
+
+	if err = writeConfigFile(config); err != nil {
+		return err
+	}
+
+	registry.Invalidate(contextName)
+
+	return nil
+}
+
+// getContextNamesUsing returns the name of all the contexts of the configuration matching the given predicate
+func getContextNamesUsing(config *KubeConfig, predicate func(context DefinitionContext) bool) []string {
+
+	result := make([]string, 0)
+	for _, context := range config.Contexts {
+		if predicate(context.DefinitionContext) {
+			result = append(result, context.Name)
+		}
+	}
+
+	return result
+}
+
+// DeleteUser removes the given user. If some contexts are still using the user, the deletion is refused with a
+// ReferencedError, unless cascade is set, in which case these contexts are removed as well. The names of the removed
+// contexts are returned
+func DeleteUser(userName string, cascade bool) ([]string, error) {
+
+	configLock.Lock()
+	defer configLock.Unlock()
+
+	config, err := GetKubeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the user exist
+	userIndex := -1
+	for i, u := range config.Users {
+		if u.Name == userName {
+			userIndex = i
+			break
+		}
+	}
+	if userIndex < 0 {
+		return nil, &ObjectNotFoundError{"user", userName}
+	}
+
+	// Check the contexts using the user
+	contextNames := getContextNamesUsing(config, func(context DefinitionContext) bool { return context.User == userName })
+	if len(contextNames) > 0 && !cascade {
+		return nil, &ReferencedError{"user", userName, contextNames}
+	}
+
+	config.Users = append(config.Users[:userIndex], config.Users[userIndex+1:]...)
+	removeContexts(config, contextNames)
+
+	if err = writeConfigFile(config); err != nil {
+		return nil, err
+	}
+
+	for _, contextName := range contextNames {
+		unregisterContext(contextName)
+	}
+
+	return contextNames, nil
+}
+
+// DeleteCluster removes the given cluster. If some contexts are still using the cluster, the deletion is refused
+// with a ReferencedError, unless cascade is set, in which case these contexts are removed as well. The names of the
+// removed contexts are returned
+func DeleteCluster(clusterName string, cascade bool) ([]string, error) {
+
+	configLock.Lock()
+	defer configLock.Unlock()
+
+	config, err := GetKubeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the cluster exist
+	clusterIndex := -1
+	for i, c := range config.Clusters {
+		if c.Name == clusterName {
+			clusterIndex = i
+			break
+		}
+	}
+	if clusterIndex < 0 {
+		return nil, &ObjectNotFoundError{"cluster", clusterName}
+	}
+
+	// Check the contexts using the cluster
+	contextNames := getContextNamesUsing(config, func(context DefinitionContext) bool { return context.Cluster == clusterName })
+	if len(contextNames) > 0 && !cascade {
+		return nil, &ReferencedError{"cluster", clusterName, contextNames}
+	}
+
+	config.Clusters = append(config.Clusters[:clusterIndex], config.Clusters[clusterIndex+1:]...)
+	removeContexts(config, contextNames)
+
+	if err = writeConfigFile(config); err != nil {
+		return nil, err
+	}
+
+	for _, contextName := range contextNames {
+		unregisterContext(contextName)
 	}
 
-	// Write the content to the config file
-	if _, err = file.Write(data); err != nil {
-		return fmt.Errorf("unable to write the configuration into the file due to: %v", err.Error())
+	return contextNames, nil
+}
+
+// DeleteContext removes the given context and forgets its cached clientsets
+func DeleteContext(contextName string) error {
+
+	configLock.Lock()
+	defer configLock.Unlock()
+
+	config, err := GetKubeConfig()
+	if err != nil {
+		return err
 	}
 
+	if !removeContexts(config, []string{contextName}) {
+		return &NotFoundError{contextName}
+	}
+
+	if err = writeConfigFile(config); err != nil {
+		return err
+	}
+
+	unregisterContext(contextName)
+
 	return nil
 }
+
+// unregisterContext forgets a context removed from the configuration file. If the context was shadowing the in-cluster
+// context, the in-cluster context is declared again
+func unregisterContext(contextName string) {
+	if len(inClusterContextName) > 0 && contextName == inClusterContextName {
+		registry.Register(contextName)
+		return
+	}
+	registry.Unregister(contextName)
+}
+
+// removeContexts removes the given contexts from the configuration. If the current context is removed, the current
+// context is reset. Returns true if at least one context was removed
+func removeContexts(config *KubeConfig, contextNames []string) bool {
+
+	toRemove := make(map[string]bool)
+	for _, contextName := range contextNames {
+		toRemove[contextName] = true
+	}
+
+	contexts := make([]NamedContext, 0, len(config.Contexts))
+	for _, context := range config.Contexts {
+		if !toRemove[context.Name] {
+			contexts = append(contexts, context)
+		}
+	}
+
+	if toRemove[config.CurrentContext] {
+		config.CurrentContext = ""
+	}
+
+	removed := len(contexts) != len(config.Contexts)
+	config.Contexts = contexts
+
+	// Forget the settings of the removed contexts, save for the in-cluster context which is still available
+	settingsToRemove := make([]string, 0, len(contextNames))
+	for _, contextName := range contextNames {
+		if len(inClusterContextName) == 0 || contextName != inClusterContextName {
+			settingsToRemove = append(settingsToRemove, contextName)
+		}
+	}
+	removeClientSettings(config, settingsToRemove)
+
+	return removed
+}
+
+// removeClientSettings removes the settings of the clients of the given contexts from the configuration. Returns true
+// if at least one settings was removed
+func removeClientSettings(config *KubeConfig, contextNames []string) bool {
+
+	toRemove := make(map[string]bool)
+	for _, contextName := range contextNames {
+		toRemove[contextName] = true
+	}
+
+	clientSettings := make([]NamedClientSettings, 0, len(config.ClientSettings))
+	for _, settings := range config.ClientSettings {
+		if !toRemove[settings.Name] {
+			clientSettings = append(clientSettings, settings)
+		}
+	}
+
+	removed := len(clientSettings) != len(config.ClientSettings)
+	config.ClientSettings = clientSettings
+
+	return removed
+}
+
+// writeConfigFile writes the given configuration into its store. If the encryption is enabled, the credentials are
+// encrypted
+func writeConfigFile(config *KubeConfig) error {
+
+	users, err := encryptUsersCredentials(config.Users)
+	if err != nil {
+		return err
+	}
+
+	encryptedConfig := *config
+	encryptedConfig.Users = users
+
+	return writeKubeConfig(configStore, &encryptedConfig)
+}
+
+// writeKubeConfig writes the given configuration into a store
+func writeKubeConfig(store ConfigStore, config *KubeConfig) error {
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("unable to marshall the given configuration due to: %v", err.Error())
+	}
+
+	return store.Save(data)
+}
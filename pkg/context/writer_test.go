@@ -0,0 +1,107 @@
+package context
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentModifications(t *testing.T) {
+
+	loadTestContexts(t, existingKubeConfig)
+
+	const count = 20
+
+	// Each goroutine creates its own user, cluster and context, none of them must be lost
+	var waitGroup sync.WaitGroup
+	errors := make(chan error, 3*count)
+	for i := 0; i < count; i++ {
+		waitGroup.Add(1)
+		go func(index int) {
+			defer waitGroup.Done()
+			name := fmt.Sprintf("concurrent-%d", index)
+			errors <- SetUserWithToken(name, ParamCredentialsToken{Token: name})
+			errors <- SetClusterInsecure(name, ParamClusterInsecure{Server: "https://" + name + ".example.com"})
+			errors <- SetContext(name, ParamContext{User: name, Cluster: name})
+		}(i)
+	}
+	waitGroup.Wait()
+	close(errors)
+
+	for err := range errors {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	config, err := GetKubeConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		actual   int
+		expected int
+	}{
+		{"users", len(config.Users), count + 1},
+		{"clusters", len(config.Clusters), count + 1},
+		{"contexts", len(config.Contexts), count + 1},
+	}
+
+	for _, test := range tests {
+		if test.actual != test.expected {
+			t.Errorf("expected %d %s, got %d", test.expected, test.name, test.actual)
+		}
+	}
+
+	registered := make(map[string]bool)
+	for _, contextName := range GetContextNames() {
+		registered[contextName] = true
+	}
+	for _, context := range config.Contexts {
+		if !registered[context.Name] {
+			t.Errorf("the context %s is not registered", context.Name)
+		}
+	}
+}
+
+func TestModificationsOfMissingObjects(t *testing.T) {
+
+	loadTestContexts(t, existingKubeConfig)
+
+	tests := []struct {
+		name      string
+		modify    func() error
+		checkType func(err error) bool
+	}{
+		{
+			name:      "delete a user",
+			modify:    func() error { _, err := DeleteUser("missing", false); return err },
+			checkType: func(err error) bool { _, ok := err.(*ObjectNotFoundError); return ok },
+		},
+		{
+			name:      "delete a cluster",
+			modify:    func() error { _, err := DeleteCluster("missing", true); return err },
+			checkType: func(err error) bool { _, ok := err.(*ObjectNotFoundError); return ok },
+		},
+		{
+			name:      "set the client settings",
+			modify:    func() error { return SetClientSettings("missing", ParamClientSettings{}) },
+			checkType: func(err error) bool { _, ok := err.(*NotFoundError); return ok },
+		},
+		{
+			name:      "delete the client settings",
+			modify:    func() error { return DeleteClientSettings("missing") },
+			checkType: func(err error) bool { _, ok := err.(*NotFoundError); return ok },
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.modify(); !test.checkType(err) {
+				t.Errorf("expected a not found error, got %v", err)
+			}
+		})
+	}
+}
@@ -5,6 +5,11 @@ package event
 //go:generate go run gen/gen_event_namespace.go
 
 import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/twuillemin/kuboxy/pkg/context"
 	"k8s.io/client-go/kubernetes"
 	metrics "k8s.io/metrics/pkg/client/clientset/versioned"
 )
@@ -56,3 +61,380 @@ type namespaceReceiver struct {
 
 // The list of all contextReceivers by context name
 var contextReceivers = make(map[string]*contextReceiver)
+
+// The lock protecting the contextReceivers and their receivers
+var contextReceiversLock sync.Mutex
+
+// StopContextReceivers stops all the receivers of the given context and forgets them. It should be called when a
+// context is removed from the configuration
+func StopContextReceivers(contextName string) {
+
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
+	stopContextReceivers(contextName)
+}
+
+// StopAllContextReceivers stops the receivers of all the contexts and forgets them. It should be called when the
+// application stops
+func StopAllContextReceivers() {
+
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
+	for contextName := range contextReceivers {
+		stopContextReceivers(contextName)
+	}
+}
+
+// stopContextReceivers stops all the receivers of the given context and forgets them. The lock of the
+// contextReceivers must be held
+func stopContextReceivers(contextName string) {
+
+	ctxReceiver, ok := contextReceivers[contextName]
+	if !ok {
+		return
+	}
+
+	ctxReceiver.stop()
+
+	delete(contextReceivers, contextName)
+}
+
+// ConfigurationEvent is the event sent to its clients when the contexts configuration is modified
+type ConfigurationEvent struct {
+	EventType           Type
+	ConfigurationChange context.ConfigurationChange
+}
+
+// The clients receiving the ConfigurationEvent
+var configurationEventClients = struct {
+	lock    sync.Mutex
+	clients []chan ConfigurationEvent
+}{
+	clients: make([]chan ConfigurationEvent, 0),
+}
+
+// FollowConfigurationChanges follows the modifications of the contexts configuration. The receivers of the removed
+// contexts are stopped and the receivers of the changed contexts are restarted with their new configuration, keeping
+// their clients. The clients of the ConfigurationEvent are then notified
+func FollowConfigurationChanges() {
+
+	changeChannel := make(chan context.ConfigurationChange)
+	context.AddConfigurationChangeClient(changeChannel)
+
+	go func() {
+		for change := range changeChannel {
+			applyConfigurationChange(change)
+			sendAllConfigurationEventClients(ConfigurationEvent{Update, change})
+		}
+	}()
+}
+
+// AddConfigurationEventClient adds a new client that will receive the ConfigurationEvent
+func AddConfigurationEventClient(client chan ConfigurationEvent) {
+
+	configurationEventClients.lock.Lock()
+	defer configurationEventClients.lock.Unlock()
+
+	configurationEventClients.clients = append(configurationEventClients.clients, client)
+}
+
+// RemoveConfigurationEventClient removes a client from receiving the ConfigurationEvent
+func RemoveConfigurationEventClient(client chan ConfigurationEvent) {
+
+	configurationEventClients.lock.Lock()
+	defer configurationEventClients.lock.Unlock()
+
+	newClients := make([]chan ConfigurationEvent, 0, len(configurationEventClients.clients))
+	for _, existingClient := range configurationEventClients.clients {
+		if existingClient != client {
+			newClients = append(newClients, existingClient)
+		}
+	}
+	configurationEventClients.clients = newClients
+}
+
+// sendAllConfigurationEventClients send a message on each one of the referenced clients
+func sendAllConfigurationEventClients(event ConfigurationEvent) {
+
+	configurationEventClients.lock.Lock()
+	defer configurationEventClients.lock.Unlock()
+
+	for _, client := range configurationEventClients.clients {
+		select {
+		case client <- event:
+			break
+		case <-time.After(100 * time.Millisecond):
+			fmt.Printf("One of the client was not able to receive a Configuration event in its channel in given time \n")
+			break
+		}
+	}
+}
+
+// applyConfigurationChange stops the receivers of the removed contexts and restarts the receivers of the changed
+// contexts
+func applyConfigurationChange(change context.ConfigurationChange) {
+
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
+	for _, contextName := range change.Removed {
+		stopContextReceivers(contextName)
+	}
+
+	for _, contextName := range change.Changed {
+		if err := restartContextReceivers(contextName); err != nil {
+			fmt.Printf("Unable to restart the receivers of the context %s due to: %v\n", contextName, err.Error())
+			stopContextReceivers(contextName)
+		}
+	}
+}
+
+// restartContextReceivers restarts the receivers of the given context with new clientsets, so that they follow the
+// new configuration of the context. The clients of the receivers are kept. The lock of the contextReceivers must be
+// held
+func restartContextReceivers(contextName string) error {
+
+	ctxReceiver, ok := contextReceivers[contextName]
+	if !ok {
+		return nil
+	}
+
+	clientset, err := context.GetClientset(contextName)
+	if err != nil {
+		return err
+	}
+
+	metrics, err := context.GetMetrics(contextName)
+	if err != nil {
+		return err
+	}
+
+	ctxReceiver.restart(clientset, metrics)
+
+	return nil
+}
+
+// stop stops all the receivers of the context
+func (ctxReceiver *contextReceiver) stop() {
+
+	if ctxReceiver.namespaceEventReceiver != nil {
+		ctxReceiver.namespaceEventReceiver.stop()
+	}
+	if ctxReceiver.nodeEventReceiver != nil {
+		ctxReceiver.nodeEventReceiver.stop()
+	}
+	if ctxReceiver.persistentVolumeEventReceiver != nil {
+		ctxReceiver.persistentVolumeEventReceiver.stop()
+	}
+	if ctxReceiver.clusterRoleEventReceiver != nil {
+		ctxReceiver.clusterRoleEventReceiver.stop()
+	}
+	if ctxReceiver.clusterRoleBindingEventReceiver != nil {
+		ctxReceiver.clusterRoleBindingEventReceiver.stop()
+	}
+	if ctxReceiver.storageClassEventReceiver != nil {
+		ctxReceiver.storageClassEventReceiver.stop()
+	}
+	if ctxReceiver.nodeMetricsEventReceiver != nil {
+		ctxReceiver.nodeMetricsEventReceiver.stop()
+	}
+
+	for _, nsReceiver := range ctxReceiver.namespaceReceivers {
+		nsReceiver.stop()
+	}
+}
+
+// stop stops all the receivers of the namespace
+func (nsReceiver *namespaceReceiver) stop() {
+
+	if nsReceiver.serviceEventReceiver != nil {
+		nsReceiver.serviceEventReceiver.stop()
+	}
+	if nsReceiver.podEventReceiver != nil {
+		nsReceiver.podEventReceiver.stop()
+	}
+	if nsReceiver.persistentVolumeClaimEventReceiver != nil {
+		nsReceiver.persistentVolumeClaimEventReceiver.stop()
+	}
+	if nsReceiver.configMapEventReceiver != nil {
+		nsReceiver.configMapEventReceiver.stop()
+	}
+	if nsReceiver.secretEventReceiver != nil {
+		nsReceiver.secretEventReceiver.stop()
+	}
+	if nsReceiver.serviceAccountEventReceiver != nil {
+		nsReceiver.serviceAccountEventReceiver.stop()
+	}
+	if nsReceiver.replicationControllerEventReceiver != nil {
+		nsReceiver.replicationControllerEventReceiver.stop()
+	}
+	if nsReceiver.deploymentEventReceiver != nil {
+		nsReceiver.deploymentEventReceiver.stop()
+	}
+	if nsReceiver.statefulSetEventReceiver != nil {
+		nsReceiver.statefulSetEventReceiver.stop()
+	}
+	if nsReceiver.daemonSetEventReceiver != nil {
+		nsReceiver.daemonSetEventReceiver.stop()
+	}
+	if nsReceiver.replicaSetEventReceiver != nil {
+		nsReceiver.replicaSetEventReceiver.stop()
+	}
+	if nsReceiver.networkPolicyEventReceiver != nil {
+		nsReceiver.networkPolicyEventReceiver.stop()
+	}
+	if nsReceiver.roleEventReceiver != nil {
+		nsReceiver.roleEventReceiver.stop()
+	}
+	if nsReceiver.roleBindingEventReceiver != nil {
+		nsReceiver.roleBindingEventReceiver.stop()
+	}
+	if nsReceiver.jobEventReceiver != nil {
+		nsReceiver.jobEventReceiver.stop()
+	}
+	if nsReceiver.cronJobEventReceiver != nil {
+		nsReceiver.cronJobEventReceiver.stop()
+	}
+	if nsReceiver.podMetricsEventReceiver != nil {
+		nsReceiver.podMetricsEventReceiver.stop()
+	}
+}
+
+// restart restarts all the receivers of the context with the given clientsets, keeping their clients
+func (ctxReceiver *contextReceiver) restart(clientset *kubernetes.Clientset, metrics *metrics.Clientset) {
+
+	ctxReceiver.clientset = clientset
+	ctxReceiver.metrics = metrics
+
+	if receiver := ctxReceiver.namespaceEventReceiver; receiver != nil {
+		receiver.stop()
+		ctxReceiver.namespaceEventReceiver = newNamespaceEventReceiver(clientset)
+		ctxReceiver.namespaceEventReceiver.clients = receiver.clients
+	}
+	if receiver := ctxReceiver.nodeEventReceiver; receiver != nil {
+		receiver.stop()
+		ctxReceiver.nodeEventReceiver = newNodeEventReceiver(clientset)
+		ctxReceiver.nodeEventReceiver.clients = receiver.clients
+	}
+	if receiver := ctxReceiver.persistentVolumeEventReceiver; receiver != nil {
+		receiver.stop()
+		ctxReceiver.persistentVolumeEventReceiver = newPersistentVolumeEventReceiver(clientset)
+		ctxReceiver.persistentVolumeEventReceiver.clients = receiver.clients
+	}
+	if receiver := ctxReceiver.clusterRoleEventReceiver; receiver != nil {
+		receiver.stop()
+		ctxReceiver.clusterRoleEventReceiver = newClusterRoleEventReceiver(clientset)
+		ctxReceiver.clusterRoleEventReceiver.clients = receiver.clients
+	}
+	if receiver := ctxReceiver.clusterRoleBindingEventReceiver; receiver != nil {
+		receiver.stop()
+		ctxReceiver.clusterRoleBindingEventReceiver = newClusterRoleBindingEventReceiver(clientset)
+		ctxReceiver.clusterRoleBindingEventReceiver.clients = receiver.clients
+	}
+	if receiver := ctxReceiver.storageClassEventReceiver; receiver != nil {
+		receiver.stop()
+		ctxReceiver.storageClassEventReceiver = newStorageClassEventReceiver(clientset)
+		ctxReceiver.storageClassEventReceiver.clients = receiver.clients
+	}
+	if receiver := ctxReceiver.nodeMetricsEventReceiver; receiver != nil {
+		receiver.stop()
+		ctxReceiver.nodeMetricsEventReceiver = newNodeMetricsEventReceiver(metrics)
+		ctxReceiver.nodeMetricsEventReceiver.clients = receiver.clients
+	}
+
+	for namespace, nsReceiver := range ctxReceiver.namespaceReceivers {
+		nsReceiver.restart(clientset, metrics, namespace)
+	}
+}
+
+// restart restarts all the receivers of the namespace with the given clientsets, keeping their clients
+func (nsReceiver *namespaceReceiver) restart(clientset *kubernetes.Clientset, metrics *metrics.Clientset, namespace string) {
+
+	if receiver := nsReceiver.serviceEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.serviceEventReceiver = newServiceEventReceiver(clientset, namespace)
+		nsReceiver.serviceEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.podEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.podEventReceiver = newPodEventReceiver(clientset, namespace)
+		nsReceiver.podEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.persistentVolumeClaimEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.persistentVolumeClaimEventReceiver = newPersistentVolumeClaimEventReceiver(clientset, namespace)
+		nsReceiver.persistentVolumeClaimEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.configMapEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.configMapEventReceiver = newConfigMapEventReceiver(clientset, namespace)
+		nsReceiver.configMapEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.secretEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.secretEventReceiver = newSecretEventReceiver(clientset, namespace)
+		nsReceiver.secretEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.serviceAccountEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.serviceAccountEventReceiver = newServiceAccountEventReceiver(clientset, namespace)
+		nsReceiver.serviceAccountEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.replicationControllerEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.replicationControllerEventReceiver = newReplicationControllerEventReceiver(clientset, namespace)
+		nsReceiver.replicationControllerEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.deploymentEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.deploymentEventReceiver = newDeploymentEventReceiver(clientset, namespace)
+		nsReceiver.deploymentEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.statefulSetEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.statefulSetEventReceiver = newStatefulSetEventReceiver(clientset, namespace)
+		nsReceiver.statefulSetEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.daemonSetEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.daemonSetEventReceiver = newDaemonSetEventReceiver(clientset, namespace)
+		nsReceiver.daemonSetEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.replicaSetEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.replicaSetEventReceiver = newReplicaSetEventReceiver(clientset, namespace)
+		nsReceiver.replicaSetEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.networkPolicyEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.networkPolicyEventReceiver = newNetworkPolicyEventReceiver(clientset, namespace)
+		nsReceiver.networkPolicyEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.roleEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.roleEventReceiver = newRoleEventReceiver(clientset, namespace)
+		nsReceiver.roleEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.roleBindingEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.roleBindingEventReceiver = newRoleBindingEventReceiver(clientset, namespace)
+		nsReceiver.roleBindingEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.jobEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.jobEventReceiver = newJobEventReceiver(clientset, namespace)
+		nsReceiver.jobEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.cronJobEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.cronJobEventReceiver = newCronJobEventReceiver(clientset, namespace)
+		nsReceiver.cronJobEventReceiver.clients = receiver.clients
+	}
+	if receiver := nsReceiver.podMetricsEventReceiver; receiver != nil {
+		receiver.stop()
+		nsReceiver.podMetricsEventReceiver = newPodMetricsEventReceiver(metrics, namespace)
+		nsReceiver.podMetricsEventReceiver.clients = receiver.clients
+	}
+}
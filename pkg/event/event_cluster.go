@@ -2,7 +2,7 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 //
-// This file was generated by gen_event_cluster.go at 2019-06-17 12:12:05.585980266 +0300 EEST m=+0.001063304
+// This file was generated by gen_event_cluster.go at 2026-10-18 06:21:39.221511158 +0000 UTC m=+0.001022069
 package event
 
 import (
@@ -17,6 +17,9 @@ import (
 // is a copy and could be freely modified bt the caller
 func GetNamespaces(contextName string) []corev1.Namespace {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -33,6 +36,9 @@ func GetNamespaces(contextName string) []corev1.Namespace {
 // AddNamespaceEventClient adds a new client that will received events
 func AddNamespaceEventClient(contextName string, client chan NamespaceEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -48,8 +54,9 @@ func AddNamespaceEventClient(contextName string, client chan NamespaceEvent) err
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -73,6 +80,9 @@ func AddNamespaceEventClient(contextName string, client chan NamespaceEvent) err
 // RemoveNamespaceEventClient removes a client from receiving events
 func RemoveNamespaceEventClient(contextName string, client chan NamespaceEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -99,6 +109,9 @@ func RemoveNamespaceEventClient(contextName string, client chan NamespaceEvent)
 // is a copy and could be freely modified bt the caller
 func GetNodes(contextName string) []corev1.Node {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -115,6 +128,9 @@ func GetNodes(contextName string) []corev1.Node {
 // AddNodeEventClient adds a new client that will received events
 func AddNodeEventClient(contextName string, client chan NodeEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -130,8 +146,9 @@ func AddNodeEventClient(contextName string, client chan NodeEvent) error {
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -155,6 +172,9 @@ func AddNodeEventClient(contextName string, client chan NodeEvent) error {
 // RemoveNodeEventClient removes a client from receiving events
 func RemoveNodeEventClient(contextName string, client chan NodeEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -181,6 +201,9 @@ func RemoveNodeEventClient(contextName string, client chan NodeEvent) {
 // is a copy and could be freely modified bt the caller
 func GetPersistentVolumes(contextName string) []corev1.PersistentVolume {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -197,6 +220,9 @@ func GetPersistentVolumes(contextName string) []corev1.PersistentVolume {
 // AddPersistentVolumeEventClient adds a new client that will received events
 func AddPersistentVolumeEventClient(contextName string, client chan PersistentVolumeEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -212,8 +238,9 @@ func AddPersistentVolumeEventClient(contextName string, client chan PersistentVo
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -237,6 +264,9 @@ func AddPersistentVolumeEventClient(contextName string, client chan PersistentVo
 // RemovePersistentVolumeEventClient removes a client from receiving events
 func RemovePersistentVolumeEventClient(contextName string, client chan PersistentVolumeEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -263,6 +293,9 @@ func RemovePersistentVolumeEventClient(contextName string, client chan Persisten
 // is a copy and could be freely modified bt the caller
 func GetClusterRoles(contextName string) []rbacv1.ClusterRole {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -279,6 +312,9 @@ func GetClusterRoles(contextName string) []rbacv1.ClusterRole {
 // AddClusterRoleEventClient adds a new client that will received events
 func AddClusterRoleEventClient(contextName string, client chan ClusterRoleEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -294,8 +330,9 @@ func AddClusterRoleEventClient(contextName string, client chan ClusterRoleEvent)
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -319,6 +356,9 @@ func AddClusterRoleEventClient(contextName string, client chan ClusterRoleEvent)
 // RemoveClusterRoleEventClient removes a client from receiving events
 func RemoveClusterRoleEventClient(contextName string, client chan ClusterRoleEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -345,6 +385,9 @@ func RemoveClusterRoleEventClient(contextName string, client chan ClusterRoleEve
 // is a copy and could be freely modified bt the caller
 func GetClusterRoleBindings(contextName string) []rbacv1.ClusterRoleBinding {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -361,6 +404,9 @@ func GetClusterRoleBindings(contextName string) []rbacv1.ClusterRoleBinding {
 // AddClusterRoleBindingEventClient adds a new client that will received events
 func AddClusterRoleBindingEventClient(contextName string, client chan ClusterRoleBindingEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -376,8 +422,9 @@ func AddClusterRoleBindingEventClient(contextName string, client chan ClusterRol
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -401,6 +448,9 @@ func AddClusterRoleBindingEventClient(contextName string, client chan ClusterRol
 // RemoveClusterRoleBindingEventClient removes a client from receiving events
 func RemoveClusterRoleBindingEventClient(contextName string, client chan ClusterRoleBindingEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -427,6 +477,9 @@ func RemoveClusterRoleBindingEventClient(contextName string, client chan Cluster
 // is a copy and could be freely modified bt the caller
 func GetStorageClasses(contextName string) []storagev1.StorageClass {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -443,6 +496,9 @@ func GetStorageClasses(contextName string) []storagev1.StorageClass {
 // AddStorageClassEventClient adds a new client that will received events
 func AddStorageClassEventClient(contextName string, client chan StorageClassEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -458,8 +514,9 @@ func AddStorageClassEventClient(contextName string, client chan StorageClassEven
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -483,6 +540,9 @@ func AddStorageClassEventClient(contextName string, client chan StorageClassEven
 // RemoveStorageClassEventClient removes a client from receiving events
 func RemoveStorageClassEventClient(contextName string, client chan StorageClassEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -509,6 +569,9 @@ func RemoveStorageClassEventClient(contextName string, client chan StorageClassE
 // is a copy and could be freely modified bt the caller
 func GetNodeMetricses(contextName string) []metricsv1beta1.NodeMetrics {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -525,6 +588,9 @@ func GetNodeMetricses(contextName string) []metricsv1beta1.NodeMetrics {
 // AddNodeMetricsEventClient adds a new client that will received events
 func AddNodeMetricsEventClient(contextName string, client chan NodeMetricsEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -540,8 +606,9 @@ func AddNodeMetricsEventClient(contextName string, client chan NodeMetricsEvent)
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -565,6 +632,9 @@ func AddNodeMetricsEventClient(contextName string, client chan NodeMetricsEvent)
 // RemoveNodeMetricsEventClient removes a client from receiving events
 func RemoveNodeMetricsEventClient(contextName string, client chan NodeMetricsEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -2,7 +2,7 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 //
-// This file was generated by gen_event_definition.go at 2019-06-17 12:12:05.353683112 +0300 EEST m=+0.002937813
+// This file was generated by gen_event_definition.go at 2026-10-18 06:21:38.874293274 +0000 UTC m=+0.000967134
 package event
 
 import (
@@ -94,10 +94,9 @@ func newNamespaceEventReceiver(clientset *kubernetes.Clientset) *namespaceEventR
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *namespaceEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -218,10 +217,9 @@ func newNodeEventReceiver(clientset *kubernetes.Clientset) *nodeEventReceiver {
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *nodeEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -342,10 +340,9 @@ func newPersistentVolumeEventReceiver(clientset *kubernetes.Clientset) *persiste
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *persistentVolumeEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -466,10 +463,9 @@ func newClusterRoleEventReceiver(clientset *kubernetes.Clientset) *clusterRoleEv
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *clusterRoleEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -590,10 +586,9 @@ func newClusterRoleBindingEventReceiver(clientset *kubernetes.Clientset) *cluste
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *clusterRoleBindingEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -714,10 +709,9 @@ func newStorageClassEventReceiver(clientset *kubernetes.Clientset) *storageClass
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *storageClassEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -837,10 +831,9 @@ func newServiceEventReceiver(clientset *kubernetes.Clientset, namespace string)
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *serviceEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -960,10 +953,9 @@ func newPodEventReceiver(clientset *kubernetes.Clientset, namespace string) *pod
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *podEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -1083,10 +1075,9 @@ func newPersistentVolumeClaimEventReceiver(clientset *kubernetes.Clientset, name
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *persistentVolumeClaimEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -1206,10 +1197,9 @@ func newConfigMapEventReceiver(clientset *kubernetes.Clientset, namespace string
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *configMapEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -1329,10 +1319,9 @@ func newReplicationControllerEventReceiver(clientset *kubernetes.Clientset, name
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *replicationControllerEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -1452,10 +1441,9 @@ func newSecretEventReceiver(clientset *kubernetes.Clientset, namespace string) *
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *secretEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -1575,10 +1563,9 @@ func newServiceAccountEventReceiver(clientset *kubernetes.Clientset, namespace s
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *serviceAccountEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -1698,10 +1685,9 @@ func newDeploymentEventReceiver(clientset *kubernetes.Clientset, namespace strin
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *deploymentEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -1821,10 +1807,9 @@ func newStatefulSetEventReceiver(clientset *kubernetes.Clientset, namespace stri
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *statefulSetEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -1944,10 +1929,9 @@ func newDaemonSetEventReceiver(clientset *kubernetes.Clientset, namespace string
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *daemonSetEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -2067,10 +2051,9 @@ func newReplicaSetEventReceiver(clientset *kubernetes.Clientset, namespace strin
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *replicaSetEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -2190,10 +2173,9 @@ func newNetworkPolicyEventReceiver(clientset *kubernetes.Clientset, namespace st
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *networkPolicyEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -2313,10 +2295,9 @@ func newRoleEventReceiver(clientset *kubernetes.Clientset, namespace string) *ro
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *roleEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -2436,10 +2417,9 @@ func newRoleBindingEventReceiver(clientset *kubernetes.Clientset, namespace stri
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *roleBindingEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -2559,10 +2539,9 @@ func newJobEventReceiver(clientset *kubernetes.Clientset, namespace string) *job
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *jobEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -2682,10 +2661,9 @@ func newCronJobEventReceiver(clientset *kubernetes.Clientset, namespace string)
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *cronJobEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -2805,10 +2783,9 @@ func newNodeMetricsEventReceiver(metrics *metrics.Clientset) *nodeMetricsEventRe
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *nodeMetricsEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -2928,10 +2905,9 @@ func newPodMetricsEventReceiver(metrics *metrics.Clientset, namespace string) *p
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *podMetricsEventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
@@ -2,7 +2,7 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 //
-// This file was generated by gen_event_namespace.go at 2019-06-17 12:12:05.819183589 +0300 EEST m=+0.001086680
+// This file was generated by gen_event_namespace.go at 2026-10-18 06:21:39.582901759 +0000 UTC m=+0.001061406
 package event
 
 import (
@@ -20,6 +20,9 @@ import (
 // is a copy and could be freely modified bt the caller
 func GetServices(contextName string, namespace string) []corev1.Service {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -41,6 +44,9 @@ func GetServices(contextName string, namespace string) []corev1.Service {
 // AddServiceEventClient adds a new client that will received events
 func AddServiceEventClient(contextName string, namespace string, client chan ServiceEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -56,8 +62,9 @@ func AddServiceEventClient(contextName string, namespace string, client chan Ser
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -87,6 +94,9 @@ func AddServiceEventClient(contextName string, namespace string, client chan Ser
 // RemoveServiceEventClient removes a client from receiving events
 func RemoveServiceEventClient(contextName string, namespace string, client chan ServiceEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -119,6 +129,9 @@ func RemoveServiceEventClient(contextName string, namespace string, client chan
 // is a copy and could be freely modified bt the caller
 func GetPods(contextName string, namespace string) []corev1.Pod {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -140,6 +153,9 @@ func GetPods(contextName string, namespace string) []corev1.Pod {
 // AddPodEventClient adds a new client that will received events
 func AddPodEventClient(contextName string, namespace string, client chan PodEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -155,8 +171,9 @@ func AddPodEventClient(contextName string, namespace string, client chan PodEven
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -186,6 +203,9 @@ func AddPodEventClient(contextName string, namespace string, client chan PodEven
 // RemovePodEventClient removes a client from receiving events
 func RemovePodEventClient(contextName string, namespace string, client chan PodEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -218,6 +238,9 @@ func RemovePodEventClient(contextName string, namespace string, client chan PodE
 // is a copy and could be freely modified bt the caller
 func GetPersistentVolumeClaims(contextName string, namespace string) []corev1.PersistentVolumeClaim {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -239,6 +262,9 @@ func GetPersistentVolumeClaims(contextName string, namespace string) []corev1.Pe
 // AddPersistentVolumeClaimEventClient adds a new client that will received events
 func AddPersistentVolumeClaimEventClient(contextName string, namespace string, client chan PersistentVolumeClaimEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -254,8 +280,9 @@ func AddPersistentVolumeClaimEventClient(contextName string, namespace string, c
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -285,6 +312,9 @@ func AddPersistentVolumeClaimEventClient(contextName string, namespace string, c
 // RemovePersistentVolumeClaimEventClient removes a client from receiving events
 func RemovePersistentVolumeClaimEventClient(contextName string, namespace string, client chan PersistentVolumeClaimEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -317,6 +347,9 @@ func RemovePersistentVolumeClaimEventClient(contextName string, namespace string
 // is a copy and could be freely modified bt the caller
 func GetConfigMaps(contextName string, namespace string) []corev1.ConfigMap {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -338,6 +371,9 @@ func GetConfigMaps(contextName string, namespace string) []corev1.ConfigMap {
 // AddConfigMapEventClient adds a new client that will received events
 func AddConfigMapEventClient(contextName string, namespace string, client chan ConfigMapEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -353,8 +389,9 @@ func AddConfigMapEventClient(contextName string, namespace string, client chan C
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -384,6 +421,9 @@ func AddConfigMapEventClient(contextName string, namespace string, client chan C
 // RemoveConfigMapEventClient removes a client from receiving events
 func RemoveConfigMapEventClient(contextName string, namespace string, client chan ConfigMapEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -416,6 +456,9 @@ func RemoveConfigMapEventClient(contextName string, namespace string, client cha
 // is a copy and could be freely modified bt the caller
 func GetReplicationControllers(contextName string, namespace string) []corev1.ReplicationController {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -437,6 +480,9 @@ func GetReplicationControllers(contextName string, namespace string) []corev1.Re
 // AddReplicationControllerEventClient adds a new client that will received events
 func AddReplicationControllerEventClient(contextName string, namespace string, client chan ReplicationControllerEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -452,8 +498,9 @@ func AddReplicationControllerEventClient(contextName string, namespace string, c
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -483,6 +530,9 @@ func AddReplicationControllerEventClient(contextName string, namespace string, c
 // RemoveReplicationControllerEventClient removes a client from receiving events
 func RemoveReplicationControllerEventClient(contextName string, namespace string, client chan ReplicationControllerEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -515,6 +565,9 @@ func RemoveReplicationControllerEventClient(contextName string, namespace string
 // is a copy and could be freely modified bt the caller
 func GetSecrets(contextName string, namespace string) []corev1.Secret {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -536,6 +589,9 @@ func GetSecrets(contextName string, namespace string) []corev1.Secret {
 // AddSecretEventClient adds a new client that will received events
 func AddSecretEventClient(contextName string, namespace string, client chan SecretEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -551,8 +607,9 @@ func AddSecretEventClient(contextName string, namespace string, client chan Secr
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -582,6 +639,9 @@ func AddSecretEventClient(contextName string, namespace string, client chan Secr
 // RemoveSecretEventClient removes a client from receiving events
 func RemoveSecretEventClient(contextName string, namespace string, client chan SecretEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -614,6 +674,9 @@ func RemoveSecretEventClient(contextName string, namespace string, client chan S
 // is a copy and could be freely modified bt the caller
 func GetServiceAccounts(contextName string, namespace string) []corev1.ServiceAccount {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -635,6 +698,9 @@ func GetServiceAccounts(contextName string, namespace string) []corev1.ServiceAc
 // AddServiceAccountEventClient adds a new client that will received events
 func AddServiceAccountEventClient(contextName string, namespace string, client chan ServiceAccountEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -650,8 +716,9 @@ func AddServiceAccountEventClient(contextName string, namespace string, client c
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -681,6 +748,9 @@ func AddServiceAccountEventClient(contextName string, namespace string, client c
 // RemoveServiceAccountEventClient removes a client from receiving events
 func RemoveServiceAccountEventClient(contextName string, namespace string, client chan ServiceAccountEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -713,6 +783,9 @@ func RemoveServiceAccountEventClient(contextName string, namespace string, clien
 // is a copy and could be freely modified bt the caller
 func GetDeployments(contextName string, namespace string) []appsv1.Deployment {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -734,6 +807,9 @@ func GetDeployments(contextName string, namespace string) []appsv1.Deployment {
 // AddDeploymentEventClient adds a new client that will received events
 func AddDeploymentEventClient(contextName string, namespace string, client chan DeploymentEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -749,8 +825,9 @@ func AddDeploymentEventClient(contextName string, namespace string, client chan
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -780,6 +857,9 @@ func AddDeploymentEventClient(contextName string, namespace string, client chan
 // RemoveDeploymentEventClient removes a client from receiving events
 func RemoveDeploymentEventClient(contextName string, namespace string, client chan DeploymentEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -812,6 +892,9 @@ func RemoveDeploymentEventClient(contextName string, namespace string, client ch
 // is a copy and could be freely modified bt the caller
 func GetStatefulSets(contextName string, namespace string) []appsv1.StatefulSet {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -833,6 +916,9 @@ func GetStatefulSets(contextName string, namespace string) []appsv1.StatefulSet
 // AddStatefulSetEventClient adds a new client that will received events
 func AddStatefulSetEventClient(contextName string, namespace string, client chan StatefulSetEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -848,8 +934,9 @@ func AddStatefulSetEventClient(contextName string, namespace string, client chan
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -879,6 +966,9 @@ func AddStatefulSetEventClient(contextName string, namespace string, client chan
 // RemoveStatefulSetEventClient removes a client from receiving events
 func RemoveStatefulSetEventClient(contextName string, namespace string, client chan StatefulSetEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -911,6 +1001,9 @@ func RemoveStatefulSetEventClient(contextName string, namespace string, client c
 // is a copy and could be freely modified bt the caller
 func GetDaemonSets(contextName string, namespace string) []appsv1.DaemonSet {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -932,6 +1025,9 @@ func GetDaemonSets(contextName string, namespace string) []appsv1.DaemonSet {
 // AddDaemonSetEventClient adds a new client that will received events
 func AddDaemonSetEventClient(contextName string, namespace string, client chan DaemonSetEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -947,8 +1043,9 @@ func AddDaemonSetEventClient(contextName string, namespace string, client chan D
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -978,6 +1075,9 @@ func AddDaemonSetEventClient(contextName string, namespace string, client chan D
 // RemoveDaemonSetEventClient removes a client from receiving events
 func RemoveDaemonSetEventClient(contextName string, namespace string, client chan DaemonSetEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -1010,6 +1110,9 @@ func RemoveDaemonSetEventClient(contextName string, namespace string, client cha
 // is a copy and could be freely modified bt the caller
 func GetReplicaSets(contextName string, namespace string) []appsv1.ReplicaSet {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -1031,6 +1134,9 @@ func GetReplicaSets(contextName string, namespace string) []appsv1.ReplicaSet {
 // AddReplicaSetEventClient adds a new client that will received events
 func AddReplicaSetEventClient(contextName string, namespace string, client chan ReplicaSetEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -1046,8 +1152,9 @@ func AddReplicaSetEventClient(contextName string, namespace string, client chan
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -1077,6 +1184,9 @@ func AddReplicaSetEventClient(contextName string, namespace string, client chan
 // RemoveReplicaSetEventClient removes a client from receiving events
 func RemoveReplicaSetEventClient(contextName string, namespace string, client chan ReplicaSetEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -1109,6 +1219,9 @@ func RemoveReplicaSetEventClient(contextName string, namespace string, client ch
 // is a copy and could be freely modified bt the caller
 func GetNetworkPolicies(contextName string, namespace string) []networkingv1.NetworkPolicy {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -1130,6 +1243,9 @@ func GetNetworkPolicies(contextName string, namespace string) []networkingv1.Net
 // AddNetworkPolicyEventClient adds a new client that will received events
 func AddNetworkPolicyEventClient(contextName string, namespace string, client chan NetworkPolicyEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -1145,8 +1261,9 @@ func AddNetworkPolicyEventClient(contextName string, namespace string, client ch
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -1176,6 +1293,9 @@ func AddNetworkPolicyEventClient(contextName string, namespace string, client ch
 // RemoveNetworkPolicyEventClient removes a client from receiving events
 func RemoveNetworkPolicyEventClient(contextName string, namespace string, client chan NetworkPolicyEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -1208,6 +1328,9 @@ func RemoveNetworkPolicyEventClient(contextName string, namespace string, client
 // is a copy and could be freely modified bt the caller
 func GetRoles(contextName string, namespace string) []rbacv1.Role {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -1229,6 +1352,9 @@ func GetRoles(contextName string, namespace string) []rbacv1.Role {
 // AddRoleEventClient adds a new client that will received events
 func AddRoleEventClient(contextName string, namespace string, client chan RoleEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -1244,8 +1370,9 @@ func AddRoleEventClient(contextName string, namespace string, client chan RoleEv
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -1275,6 +1402,9 @@ func AddRoleEventClient(contextName string, namespace string, client chan RoleEv
 // RemoveRoleEventClient removes a client from receiving events
 func RemoveRoleEventClient(contextName string, namespace string, client chan RoleEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -1307,6 +1437,9 @@ func RemoveRoleEventClient(contextName string, namespace string, client chan Rol
 // is a copy and could be freely modified bt the caller
 func GetRoleBindings(contextName string, namespace string) []rbacv1.RoleBinding {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -1328,6 +1461,9 @@ func GetRoleBindings(contextName string, namespace string) []rbacv1.RoleBinding
 // AddRoleBindingEventClient adds a new client that will received events
 func AddRoleBindingEventClient(contextName string, namespace string, client chan RoleBindingEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -1343,8 +1479,9 @@ func AddRoleBindingEventClient(contextName string, namespace string, client chan
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -1374,6 +1511,9 @@ func AddRoleBindingEventClient(contextName string, namespace string, client chan
 // RemoveRoleBindingEventClient removes a client from receiving events
 func RemoveRoleBindingEventClient(contextName string, namespace string, client chan RoleBindingEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -1406,6 +1546,9 @@ func RemoveRoleBindingEventClient(contextName string, namespace string, client c
 // is a copy and could be freely modified bt the caller
 func GetJobs(contextName string, namespace string) []batchv1.Job {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -1427,6 +1570,9 @@ func GetJobs(contextName string, namespace string) []batchv1.Job {
 // AddJobEventClient adds a new client that will received events
 func AddJobEventClient(contextName string, namespace string, client chan JobEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -1442,8 +1588,9 @@ func AddJobEventClient(contextName string, namespace string, client chan JobEven
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -1473,6 +1620,9 @@ func AddJobEventClient(contextName string, namespace string, client chan JobEven
 // RemoveJobEventClient removes a client from receiving events
 func RemoveJobEventClient(contextName string, namespace string, client chan JobEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -1505,6 +1655,9 @@ func RemoveJobEventClient(contextName string, namespace string, client chan JobE
 // is a copy and could be freely modified bt the caller
 func GetCronJobs(contextName string, namespace string) []batchv1beta1.CronJob {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -1526,6 +1679,9 @@ func GetCronJobs(contextName string, namespace string) []batchv1beta1.CronJob {
 // AddCronJobEventClient adds a new client that will received events
 func AddCronJobEventClient(contextName string, namespace string, client chan CronJobEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -1541,8 +1697,9 @@ func AddCronJobEventClient(contextName string, namespace string, client chan Cro
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -1572,6 +1729,9 @@ func AddCronJobEventClient(contextName string, namespace string, client chan Cro
 // RemoveCronJobEventClient removes a client from receiving events
 func RemoveCronJobEventClient(contextName string, namespace string, client chan CronJobEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -1604,6 +1764,9 @@ func RemoveCronJobEventClient(contextName string, namespace string, client chan
 // is a copy and could be freely modified bt the caller
 func GetPodMetricses(contextName string, namespace string) []metricsv1beta1.PodMetrics {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -1625,6 +1788,9 @@ func GetPodMetricses(contextName string, namespace string) []metricsv1beta1.PodM
 // AddPodMetricsEventClient adds a new client that will received events
 func AddPodMetricsEventClient(contextName string, namespace string, client chan PodMetricsEvent) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -1640,8 +1806,9 @@ func AddPodMetricsEventClient(contextName string, namespace string, client chan
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -1671,6 +1838,9 @@ func AddPodMetricsEventClient(contextName string, namespace string, client chan
 // RemovePodMetricsEventClient removes a client from receiving events
 func RemovePodMetricsEventClient(contextName string, namespace string, client chan PodMetricsEvent) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -66,6 +66,9 @@ import (
 // is a copy and could be freely modified bt the caller
 func Get{{ .Plural }}(contextName string) []{{ .FullName }} {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -82,6 +85,9 @@ func Get{{ .Plural }}(contextName string) []{{ .FullName }} {
 // Add{{ .Name }}EventClient adds a new client that will received events
 func Add{{ .Name }}EventClient(contextName string, client chan {{ .Name }}Event) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -97,8 +103,9 @@ func Add{{ .Name }}EventClient(contextName string, client chan {{ .Name }}Event)
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 
 		contextReceivers[contextName] = ctxReceiver
@@ -124,6 +131,9 @@ func Add{{ .Name }}EventClient(contextName string, client chan {{ .Name }}Event)
 // Remove{{ .Name }}EventClient removes a client from receiving events
 func Remove{{ .Name }}EventClient(contextName string, client chan {{ .Name }}Event) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -157,10 +157,9 @@ func new{{ .Name }}EventReceiver(clientset *kubernetes.Clientset) *{{ .Variable
 	return &receiver
 }
 
-// stop stops receiving events from the cluster.
+// stop stops receiving events from the cluster. Closing the channel stops all the goroutines of the controller
 func (eventReceiver *{{ .Variable }}EventReceiver) stop() {
-	var stopFlag struct{}
-	eventReceiver.stopChannel <- stopFlag
+	close(eventReceiver.stopChannel)
 }
 
 // addClient adds a new client to the event receiver
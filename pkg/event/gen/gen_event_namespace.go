@@ -69,6 +69,9 @@ import (
 // is a copy and could be freely modified bt the caller
 func Get{{ .Plural }}(contextName string, namespace string) []{{ .FullName }} {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
 		return nil
@@ -90,6 +93,9 @@ func Get{{ .Plural }}(contextName string, namespace string) []{{ .FullName }} {
 // Add{{ .Name }}EventClient adds a new client that will received events
 func Add{{ .Name }}EventClient(contextName string, namespace string, client chan {{ .Name }}Event) error {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the receiver or create it
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -105,8 +111,9 @@ func Add{{ .Name }}EventClient(contextName string, namespace string, client chan
 		}
 
 		ctxReceiver = &contextReceiver{
-			clientset: clientset,
-			metrics:   metrics,
+			clientset:          clientset,
+			metrics:            metrics,
+			namespaceReceivers: make(map[string]*namespaceReceiver),
 		}
 		
 		contextReceivers[contextName] = ctxReceiver
@@ -138,6 +145,9 @@ func Add{{ .Name }}EventClient(contextName string, namespace string, client chan
 // Remove{{ .Name }}EventClient removes a client from receiving events
 func Remove{{ .Name }}EventClient(contextName string, namespace string, client chan {{ .Name }}Event) {
 
+	contextReceiversLock.Lock()
+	defer contextReceiversLock.Unlock()
+
 	// Get the context receiver
 	ctxReceiver, ok := contextReceivers[contextName]
 	if !ok {
@@ -56,44 +56,55 @@ import (
 )
 {{ range .ObjectDefinitions }}
 // Get{{ .Plural }} returns all the {{ .Name }}.
-func Get{{ .Plural }}(contextName string) ([]{{ .FullName }}, error) {
+func Get{{ .Plural }}(contextName string, impersonation *context.Impersonation) ([]{{ .FullName }}, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.Get{{ .Plural }}(contextName); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.Get{{ .Plural }}(contextName); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.Get{{ .Plural }}(clientset)
 }
 
 // Get{{ .Name }} returns the {{ .Name }} by its name.
-func Get{{ .Name }}(contextName string, name string) (*{{ .FullName }}, error) {
+func Get{{ .Name }}(contextName string, impersonation *context.Impersonation, name string) (*{{ .FullName }}, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.Get{{ .Plural }}(contextName); results != nil {
-		for _, {{ .Variable }} := range results {
-			if {{ .Variable }}.Name == name {
-				return &{{ .Variable }}, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.Get{{ .Plural }}(contextName); results != nil {
+			for _, {{ .Variable }} := range results {
+				if {{ .Variable }}.Name == name {
+					return &{{ .Variable }}, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.Get{{ .Name }}(clientset, name)
 }
 
 // Create{{ .Name }} creates the {{ .Name }} with the given model.
-func Create{{ .Name }}(contextName string, {{ .Variable }} *{{ .FullName }}) (*{{ .FullName }}, error) {
+func Create{{ .Name }}(contextName string, impersonation *context.Impersonation, {{ .Variable }} *{{ .FullName }}) (*{{ .FullName }}, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -102,9 +113,14 @@ func Create{{ .Name }}(contextName string, {{ .Variable }} *{{ .FullName }}) (*{
 }
 
 // Update{{ .Name }} updates the {{ .Name }} with the given model.
-func Update{{ .Name }}(contextName string, {{ .Variable }} *{{ .FullName }}) (*{{ .FullName }}, error) {
+func Update{{ .Name }}(contextName string, impersonation *context.Impersonation, {{ .Variable }} *{{ .FullName }}) (*{{ .FullName }}, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -113,9 +129,14 @@ func Update{{ .Name }}(contextName string, {{ .Variable }} *{{ .FullName }}) (*{
 }
 
 // Delete{{ .Name }} deletes the {{ .Name }} by its name.
-func Delete{{ .Name }}(contextName string, name string) error {
+func Delete{{ .Name }}(contextName string, impersonation *context.Impersonation, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -54,35 +54,41 @@ import (
 )
 {{ range .ObjectDefinitions }}
 // Get{{ .Plural }} returns all the {{ .Name }}.
-func Get{{ .Plural }}(contextName string) ([]{{ .FullName }}, error) {
+func Get{{ .Plural }}(contextName string, impersonation *context.Impersonation) ([]{{ .FullName }}, error) {
 
-	metrics, err := context.GetMetrics(contextName)
+	metrics, err := context.GetImpersonatedMetrics(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.Get{{ .Plural }}(contextName); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.Get{{ .Plural }}(contextName); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.Get{{ .Plural }}(metrics)
 }
 
 // Get{{ .Name }} returns the {{ .Name }} by its name.
-func Get{{ .Name }}(contextName string, name string) (*{{ .FullName }}, error) {
+func Get{{ .Name }}(contextName string, impersonation *context.Impersonation, name string) (*{{ .FullName }}, error) {
 
-	metrics, err := context.GetMetrics(contextName)
+	metrics, err := context.GetImpersonatedMetrics(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.Get{{ .Plural }}(contextName); results != nil {
-		for _, {{ .Variable }} := range results {
-			if {{ .Variable }}.Name == name {
-				return &{{ .Variable }}, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.Get{{ .Plural }}(contextName); results != nil {
+			for _, {{ .Variable }} := range results {
+				if {{ .Variable }}.Name == name {
+					return &{{ .Variable }}, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.Get{{ .Name }}(metrics, name)
@@ -59,15 +59,18 @@ import (
 )
 {{ range .ObjectDefinitions }}
 // Get{{ .Plural }} returns all the {{ .Name }}. If an empty namespace is given, returns all the {{ .Name }}
-func Get{{ .Plural }}(contextName string, namespace string) ([]{{ .FullName }}, error) {
+func Get{{ .Plural }}(contextName string, impersonation *context.Impersonation, namespace string) ([]{{ .FullName }}, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.Get{{ .Plural }}(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.Get{{ .Plural }}(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.Get{{ .Plural }}(clientset, namespace)
@@ -75,20 +78,23 @@ func Get{{ .Plural }}(contextName string, namespace string) ([]{{ .FullName }},
 
 // Get{{ .Name }} returns the {{ .Name }} by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func Get{{ .Name }}(contextName string, namespace string, name string) (*{{ .FullName }}, error) {
+func Get{{ .Name }}(contextName string, impersonation *context.Impersonation, namespace string, name string) (*{{ .FullName }}, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.Get{{ .Plural }}(contextName, namespace); results != nil {
-		for _, {{ .Variable }} := range results {
-			if {{ .Variable }}.Name == name {
-				return &{{ .Variable }}, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.Get{{ .Plural }}(contextName, namespace); results != nil {
+			for _, {{ .Variable }} := range results {
+				if {{ .Variable }}.Name == name {
+					return &{{ .Variable }}, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.Get{{ .Name }}(clientset, namespace, name)
@@ -96,9 +102,14 @@ func Get{{ .Name }}(contextName string, namespace string, name string) (*{{ .Ful
 
 // Create{{ .Name }} creates the {{ .Name }} with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func Create{{ .Name }}(contextName string, namespace string, {{ .Variable }} *{{ .FullName }}) (*{{ .FullName }}, error) {
+func Create{{ .Name }}(contextName string, impersonation *context.Impersonation, namespace string, {{ .Variable }} *{{ .FullName }}) (*{{ .FullName }}, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -108,9 +119,14 @@ func Create{{ .Name }}(contextName string, namespace string, {{ .Variable }} *{{
 
 // Update{{ .Name }} updates the {{ .Name }} with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func Update{{ .Name }}(contextName string, namespace string, {{ .Variable }} *{{ .FullName }}) (*{{ .FullName }}, error) {
+func Update{{ .Name }}(contextName string, impersonation *context.Impersonation, namespace string, {{ .Variable }} *{{ .FullName }}) (*{{ .FullName }}, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -120,9 +136,14 @@ func Update{{ .Name }}(contextName string, namespace string, {{ .Variable }} *{{
 
 // Delete{{ .Name }} deletes the {{ .Name }} by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func Delete{{ .Name }}(contextName string, namespace string, name string) error {
+func Delete{{ .Name }}(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
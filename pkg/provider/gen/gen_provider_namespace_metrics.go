@@ -54,15 +54,18 @@ import (
 )
 {{ range .ObjectDefinitions }}
 // Get{{ .Plural }} returns all the {{ .Name }}. If an empty namespace is given, returns all the {{ .Name }}
-func Get{{ .Plural }}(contextName string, namespace string) ([]{{ .FullName }}, error) {
+func Get{{ .Plural }}(contextName string, impersonation *context.Impersonation, namespace string) ([]{{ .FullName }}, error) {
 
-	metrics, err := context.GetMetrics(contextName)
+	metrics, err := context.GetImpersonatedMetrics(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.Get{{ .Plural }}(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.Get{{ .Plural }}(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.Get{{ .Plural }}(metrics, namespace)
@@ -70,20 +73,23 @@ func Get{{ .Plural }}(contextName string, namespace string) ([]{{ .FullName }},
 
 // Get{{ .Name }} returns the {{ .Name }} by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func Get{{ .Name }}(contextName string, namespace string, name string) (*{{ .FullName }}, error) {
+func Get{{ .Name }}(contextName string, impersonation *context.Impersonation, namespace string, name string) (*{{ .FullName }}, error) {
 
-	metrics, err := context.GetMetrics(contextName)
+	metrics, err := context.GetImpersonatedMetrics(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.Get{{ .Plural }}(contextName, namespace); results != nil {
-		for _, {{ .Variable }} := range results {
-			if {{ .Variable }}.Name == name {
-				return &{{ .Variable }}, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.Get{{ .Plural }}(contextName, namespace); results != nil {
+			for _, {{ .Variable }} := range results {
+				if {{ .Variable }}.Name == name {
+					return &{{ .Variable }}, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.Get{{ .Name }}(metrics, namespace, name)
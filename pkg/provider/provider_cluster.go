@@ -2,7 +2,7 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 //
-// This file was generated by gen_provider_cluster.go at 2019-06-17 12:12:20.794025957 +0300 EEST m=+0.001747689
+// This file was generated by gen_provider_cluster.go at 2026-10-18 06:39:21.146743115 +0000 UTC m=+0.001248364
 package provider
 
 import (
@@ -15,44 +15,55 @@ import (
 )
 
 // GetNamespaces returns all the Namespace.
-func GetNamespaces(contextName string) ([]corev1.Namespace, error) {
+func GetNamespaces(contextName string, impersonation *context.Impersonation) ([]corev1.Namespace, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetNamespaces(contextName); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetNamespaces(contextName); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetNamespaces(clientset)
 }
 
 // GetNamespace returns the Namespace by its name.
-func GetNamespace(contextName string, name string) (*corev1.Namespace, error) {
+func GetNamespace(contextName string, impersonation *context.Impersonation, name string) (*corev1.Namespace, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetNamespaces(contextName); results != nil {
-		for _, namespace := range results {
-			if namespace.Name == name {
-				return &namespace, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetNamespaces(contextName); results != nil {
+			for _, namespace := range results {
+				if namespace.Name == name {
+					return &namespace, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetNamespace(clientset, name)
 }
 
 // CreateNamespace creates the Namespace with the given model.
-func CreateNamespace(contextName string, namespace *corev1.Namespace) (*corev1.Namespace, error) {
+func CreateNamespace(contextName string, impersonation *context.Impersonation, namespace *corev1.Namespace) (*corev1.Namespace, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -61,9 +72,14 @@ func CreateNamespace(contextName string, namespace *corev1.Namespace) (*corev1.N
 }
 
 // UpdateNamespace updates the Namespace with the given model.
-func UpdateNamespace(contextName string, namespace *corev1.Namespace) (*corev1.Namespace, error) {
+func UpdateNamespace(contextName string, impersonation *context.Impersonation, namespace *corev1.Namespace) (*corev1.Namespace, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -72,9 +88,14 @@ func UpdateNamespace(contextName string, namespace *corev1.Namespace) (*corev1.N
 }
 
 // DeleteNamespace deletes the Namespace by its name.
-func DeleteNamespace(contextName string, name string) error {
+func DeleteNamespace(contextName string, impersonation *context.Impersonation, name string) error {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -83,44 +104,55 @@ func DeleteNamespace(contextName string, name string) error {
 }
 
 // GetNodes returns all the Node.
-func GetNodes(contextName string) ([]corev1.Node, error) {
+func GetNodes(contextName string, impersonation *context.Impersonation) ([]corev1.Node, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetNodes(contextName); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetNodes(contextName); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetNodes(clientset)
 }
 
 // GetNode returns the Node by its name.
-func GetNode(contextName string, name string) (*corev1.Node, error) {
+func GetNode(contextName string, impersonation *context.Impersonation, name string) (*corev1.Node, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetNodes(contextName); results != nil {
-		for _, node := range results {
-			if node.Name == name {
-				return &node, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetNodes(contextName); results != nil {
+			for _, node := range results {
+				if node.Name == name {
+					return &node, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetNode(clientset, name)
 }
 
 // CreateNode creates the Node with the given model.
-func CreateNode(contextName string, node *corev1.Node) (*corev1.Node, error) {
+func CreateNode(contextName string, impersonation *context.Impersonation, node *corev1.Node) (*corev1.Node, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -129,9 +161,14 @@ func CreateNode(contextName string, node *corev1.Node) (*corev1.Node, error) {
 }
 
 // UpdateNode updates the Node with the given model.
-func UpdateNode(contextName string, node *corev1.Node) (*corev1.Node, error) {
+func UpdateNode(contextName string, impersonation *context.Impersonation, node *corev1.Node) (*corev1.Node, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -140,9 +177,14 @@ func UpdateNode(contextName string, node *corev1.Node) (*corev1.Node, error) {
 }
 
 // DeleteNode deletes the Node by its name.
-func DeleteNode(contextName string, name string) error {
+func DeleteNode(contextName string, impersonation *context.Impersonation, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -151,44 +193,55 @@ func DeleteNode(contextName string, name string) error {
 }
 
 // GetPersistentVolumes returns all the PersistentVolume.
-func GetPersistentVolumes(contextName string) ([]corev1.PersistentVolume, error) {
+func GetPersistentVolumes(contextName string, impersonation *context.Impersonation) ([]corev1.PersistentVolume, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetPersistentVolumes(contextName); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetPersistentVolumes(contextName); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetPersistentVolumes(clientset)
 }
 
 // GetPersistentVolume returns the PersistentVolume by its name.
-func GetPersistentVolume(contextName string, name string) (*corev1.PersistentVolume, error) {
+func GetPersistentVolume(contextName string, impersonation *context.Impersonation, name string) (*corev1.PersistentVolume, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetPersistentVolumes(contextName); results != nil {
-		for _, persistentVolume := range results {
-			if persistentVolume.Name == name {
-				return &persistentVolume, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetPersistentVolumes(contextName); results != nil {
+			for _, persistentVolume := range results {
+				if persistentVolume.Name == name {
+					return &persistentVolume, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetPersistentVolume(clientset, name)
 }
 
 // CreatePersistentVolume creates the PersistentVolume with the given model.
-func CreatePersistentVolume(contextName string, persistentVolume *corev1.PersistentVolume) (*corev1.PersistentVolume, error) {
+func CreatePersistentVolume(contextName string, impersonation *context.Impersonation, persistentVolume *corev1.PersistentVolume) (*corev1.PersistentVolume, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -197,9 +250,14 @@ func CreatePersistentVolume(contextName string, persistentVolume *corev1.Persist
 }
 
 // UpdatePersistentVolume updates the PersistentVolume with the given model.
-func UpdatePersistentVolume(contextName string, persistentVolume *corev1.PersistentVolume) (*corev1.PersistentVolume, error) {
+func UpdatePersistentVolume(contextName string, impersonation *context.Impersonation, persistentVolume *corev1.PersistentVolume) (*corev1.PersistentVolume, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -208,9 +266,14 @@ func UpdatePersistentVolume(contextName string, persistentVolume *corev1.Persist
 }
 
 // DeletePersistentVolume deletes the PersistentVolume by its name.
-func DeletePersistentVolume(contextName string, name string) error {
+func DeletePersistentVolume(contextName string, impersonation *context.Impersonation, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -219,44 +282,55 @@ func DeletePersistentVolume(contextName string, name string) error {
 }
 
 // GetClusterRoles returns all the ClusterRole.
-func GetClusterRoles(contextName string) ([]rbacv1.ClusterRole, error) {
+func GetClusterRoles(contextName string, impersonation *context.Impersonation) ([]rbacv1.ClusterRole, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetClusterRoles(contextName); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetClusterRoles(contextName); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetClusterRoles(clientset)
 }
 
 // GetClusterRole returns the ClusterRole by its name.
-func GetClusterRole(contextName string, name string) (*rbacv1.ClusterRole, error) {
+func GetClusterRole(contextName string, impersonation *context.Impersonation, name string) (*rbacv1.ClusterRole, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetClusterRoles(contextName); results != nil {
-		for _, clusterRole := range results {
-			if clusterRole.Name == name {
-				return &clusterRole, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetClusterRoles(contextName); results != nil {
+			for _, clusterRole := range results {
+				if clusterRole.Name == name {
+					return &clusterRole, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetClusterRole(clientset, name)
 }
 
 // CreateClusterRole creates the ClusterRole with the given model.
-func CreateClusterRole(contextName string, clusterRole *rbacv1.ClusterRole) (*rbacv1.ClusterRole, error) {
+func CreateClusterRole(contextName string, impersonation *context.Impersonation, clusterRole *rbacv1.ClusterRole) (*rbacv1.ClusterRole, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -265,9 +339,14 @@ func CreateClusterRole(contextName string, clusterRole *rbacv1.ClusterRole) (*rb
 }
 
 // UpdateClusterRole updates the ClusterRole with the given model.
-func UpdateClusterRole(contextName string, clusterRole *rbacv1.ClusterRole) (*rbacv1.ClusterRole, error) {
+func UpdateClusterRole(contextName string, impersonation *context.Impersonation, clusterRole *rbacv1.ClusterRole) (*rbacv1.ClusterRole, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -276,9 +355,14 @@ func UpdateClusterRole(contextName string, clusterRole *rbacv1.ClusterRole) (*rb
 }
 
 // DeleteClusterRole deletes the ClusterRole by its name.
-func DeleteClusterRole(contextName string, name string) error {
+func DeleteClusterRole(contextName string, impersonation *context.Impersonation, name string) error {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -287,44 +371,55 @@ func DeleteClusterRole(contextName string, name string) error {
 }
 
 // GetClusterRoleBindings returns all the ClusterRoleBinding.
-func GetClusterRoleBindings(contextName string) ([]rbacv1.ClusterRoleBinding, error) {
+func GetClusterRoleBindings(contextName string, impersonation *context.Impersonation) ([]rbacv1.ClusterRoleBinding, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetClusterRoleBindings(contextName); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetClusterRoleBindings(contextName); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetClusterRoleBindings(clientset)
 }
 
 // GetClusterRoleBinding returns the ClusterRoleBinding by its name.
-func GetClusterRoleBinding(contextName string, name string) (*rbacv1.ClusterRoleBinding, error) {
+func GetClusterRoleBinding(contextName string, impersonation *context.Impersonation, name string) (*rbacv1.ClusterRoleBinding, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetClusterRoleBindings(contextName); results != nil {
-		for _, clusterRoleBinding := range results {
-			if clusterRoleBinding.Name == name {
-				return &clusterRoleBinding, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetClusterRoleBindings(contextName); results != nil {
+			for _, clusterRoleBinding := range results {
+				if clusterRoleBinding.Name == name {
+					return &clusterRoleBinding, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetClusterRoleBinding(clientset, name)
 }
 
 // CreateClusterRoleBinding creates the ClusterRoleBinding with the given model.
-func CreateClusterRoleBinding(contextName string, clusterRoleBinding *rbacv1.ClusterRoleBinding) (*rbacv1.ClusterRoleBinding, error) {
+func CreateClusterRoleBinding(contextName string, impersonation *context.Impersonation, clusterRoleBinding *rbacv1.ClusterRoleBinding) (*rbacv1.ClusterRoleBinding, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -333,9 +428,14 @@ func CreateClusterRoleBinding(contextName string, clusterRoleBinding *rbacv1.Clu
 }
 
 // UpdateClusterRoleBinding updates the ClusterRoleBinding with the given model.
-func UpdateClusterRoleBinding(contextName string, clusterRoleBinding *rbacv1.ClusterRoleBinding) (*rbacv1.ClusterRoleBinding, error) {
+func UpdateClusterRoleBinding(contextName string, impersonation *context.Impersonation, clusterRoleBinding *rbacv1.ClusterRoleBinding) (*rbacv1.ClusterRoleBinding, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -344,9 +444,14 @@ func UpdateClusterRoleBinding(contextName string, clusterRoleBinding *rbacv1.Clu
 }
 
 // DeleteClusterRoleBinding deletes the ClusterRoleBinding by its name.
-func DeleteClusterRoleBinding(contextName string, name string) error {
+func DeleteClusterRoleBinding(contextName string, impersonation *context.Impersonation, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -355,44 +460,55 @@ func DeleteClusterRoleBinding(contextName string, name string) error {
 }
 
 // GetStorageClasses returns all the StorageClass.
-func GetStorageClasses(contextName string) ([]storagev1.StorageClass, error) {
+func GetStorageClasses(contextName string, impersonation *context.Impersonation) ([]storagev1.StorageClass, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetStorageClasses(contextName); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetStorageClasses(contextName); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetStorageClasses(clientset)
 }
 
 // GetStorageClass returns the StorageClass by its name.
-func GetStorageClass(contextName string, name string) (*storagev1.StorageClass, error) {
+func GetStorageClass(contextName string, impersonation *context.Impersonation, name string) (*storagev1.StorageClass, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetStorageClasses(contextName); results != nil {
-		for _, storageClass := range results {
-			if storageClass.Name == name {
-				return &storageClass, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetStorageClasses(contextName); results != nil {
+			for _, storageClass := range results {
+				if storageClass.Name == name {
+					return &storageClass, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetStorageClass(clientset, name)
 }
 
 // CreateStorageClass creates the StorageClass with the given model.
-func CreateStorageClass(contextName string, storageClass *storagev1.StorageClass) (*storagev1.StorageClass, error) {
+func CreateStorageClass(contextName string, impersonation *context.Impersonation, storageClass *storagev1.StorageClass) (*storagev1.StorageClass, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -401,9 +517,14 @@ func CreateStorageClass(contextName string, storageClass *storagev1.StorageClass
 }
 
 // UpdateStorageClass updates the StorageClass with the given model.
-func UpdateStorageClass(contextName string, storageClass *storagev1.StorageClass) (*storagev1.StorageClass, error) {
+func UpdateStorageClass(contextName string, impersonation *context.Impersonation, storageClass *storagev1.StorageClass) (*storagev1.StorageClass, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -412,9 +533,14 @@ func UpdateStorageClass(contextName string, storageClass *storagev1.StorageClass
 }
 
 // DeleteStorageClass deletes the StorageClass by its name.
-func DeleteStorageClass(contextName string, name string) error {
+func DeleteStorageClass(contextName string, impersonation *context.Impersonation, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
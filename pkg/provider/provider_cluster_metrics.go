@@ -2,7 +2,7 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 //
-// This file was generated by gen_provider_cluster_metrics.go at 2019-06-17 12:12:21.012007208 +0300 EEST m=+0.000977640
+// This file was generated by gen_provider_cluster_metrics.go at 2026-10-18 06:04:39.286206657 +0000 UTC m=+0.000868165
 package provider
 
 import (
@@ -13,35 +13,41 @@ import (
 )
 
 // GetNodeMetricses returns all the NodeMetrics.
-func GetNodeMetricses(contextName string) ([]metricsv1beta1.NodeMetrics, error) {
+func GetNodeMetricses(contextName string, impersonation *context.Impersonation) ([]metricsv1beta1.NodeMetrics, error) {
 
-	metrics, err := context.GetMetrics(contextName)
+	metrics, err := context.GetImpersonatedMetrics(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetNodeMetricses(contextName); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetNodeMetricses(contextName); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetNodeMetricses(metrics)
 }
 
 // GetNodeMetrics returns the NodeMetrics by its name.
-func GetNodeMetrics(contextName string, name string) (*metricsv1beta1.NodeMetrics, error) {
+func GetNodeMetrics(contextName string, impersonation *context.Impersonation, name string) (*metricsv1beta1.NodeMetrics, error) {
 
-	metrics, err := context.GetMetrics(contextName)
+	metrics, err := context.GetImpersonatedMetrics(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetNodeMetricses(contextName); results != nil {
-		for _, nodeMetrics := range results {
-			if nodeMetrics.Name == name {
-				return &nodeMetrics, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetNodeMetricses(contextName); results != nil {
+			for _, nodeMetrics := range results {
+				if nodeMetrics.Name == name {
+					return &nodeMetrics, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetNodeMetrics(metrics, name)
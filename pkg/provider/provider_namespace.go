@@ -2,7 +2,7 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 //
-// This file was generated by gen_provider_namespace.go at 2019-06-17 12:12:21.223819121 +0300 EEST m=+0.001875679
+// This file was generated by gen_provider_namespace.go at 2026-10-18 06:39:21.440745566 +0000 UTC m=+0.001218871
 package provider
 
 import (
@@ -18,15 +18,18 @@ import (
 )
 
 // GetServices returns all the Service. If an empty namespace is given, returns all the Service
-func GetServices(contextName string, namespace string) ([]corev1.Service, error) {
+func GetServices(contextName string, impersonation *context.Impersonation, namespace string) ([]corev1.Service, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetServices(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetServices(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetServices(clientset, namespace)
@@ -34,20 +37,23 @@ func GetServices(contextName string, namespace string) ([]corev1.Service, error)
 
 // GetService returns the Service by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetService(contextName string, namespace string, name string) (*corev1.Service, error) {
+func GetService(contextName string, impersonation *context.Impersonation, namespace string, name string) (*corev1.Service, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetServices(contextName, namespace); results != nil {
-		for _, service := range results {
-			if service.Name == name {
-				return &service, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetServices(contextName, namespace); results != nil {
+			for _, service := range results {
+				if service.Name == name {
+					return &service, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetService(clientset, namespace, name)
@@ -55,9 +61,14 @@ func GetService(contextName string, namespace string, name string) (*corev1.Serv
 
 // CreateService creates the Service with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreateService(contextName string, namespace string, service *corev1.Service) (*corev1.Service, error) {
+func CreateService(contextName string, impersonation *context.Impersonation, namespace string, service *corev1.Service) (*corev1.Service, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -67,9 +78,14 @@ func CreateService(contextName string, namespace string, service *corev1.Service
 
 // UpdateService updates the Service with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdateService(contextName string, namespace string, service *corev1.Service) (*corev1.Service, error) {
+func UpdateService(contextName string, impersonation *context.Impersonation, namespace string, service *corev1.Service) (*corev1.Service, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -79,9 +95,14 @@ func UpdateService(contextName string, namespace string, service *corev1.Service
 
 // DeleteService deletes the Service by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeleteService(contextName string, namespace string, name string) error {
+func DeleteService(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -90,15 +111,18 @@ func DeleteService(contextName string, namespace string, name string) error {
 }
 
 // GetPods returns all the Pod. If an empty namespace is given, returns all the Pod
-func GetPods(contextName string, namespace string) ([]corev1.Pod, error) {
+func GetPods(contextName string, impersonation *context.Impersonation, namespace string) ([]corev1.Pod, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetPods(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetPods(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetPods(clientset, namespace)
@@ -106,20 +130,23 @@ func GetPods(contextName string, namespace string) ([]corev1.Pod, error) {
 
 // GetPod returns the Pod by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetPod(contextName string, namespace string, name string) (*corev1.Pod, error) {
+func GetPod(contextName string, impersonation *context.Impersonation, namespace string, name string) (*corev1.Pod, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetPods(contextName, namespace); results != nil {
-		for _, pod := range results {
-			if pod.Name == name {
-				return &pod, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetPods(contextName, namespace); results != nil {
+			for _, pod := range results {
+				if pod.Name == name {
+					return &pod, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetPod(clientset, namespace, name)
@@ -127,9 +154,14 @@ func GetPod(contextName string, namespace string, name string) (*corev1.Pod, err
 
 // CreatePod creates the Pod with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreatePod(contextName string, namespace string, pod *corev1.Pod) (*corev1.Pod, error) {
+func CreatePod(contextName string, impersonation *context.Impersonation, namespace string, pod *corev1.Pod) (*corev1.Pod, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -139,9 +171,14 @@ func CreatePod(contextName string, namespace string, pod *corev1.Pod) (*corev1.P
 
 // UpdatePod updates the Pod with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdatePod(contextName string, namespace string, pod *corev1.Pod) (*corev1.Pod, error) {
+func UpdatePod(contextName string, impersonation *context.Impersonation, namespace string, pod *corev1.Pod) (*corev1.Pod, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -151,9 +188,14 @@ func UpdatePod(contextName string, namespace string, pod *corev1.Pod) (*corev1.P
 
 // DeletePod deletes the Pod by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeletePod(contextName string, namespace string, name string) error {
+func DeletePod(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -162,15 +204,18 @@ func DeletePod(contextName string, namespace string, name string) error {
 }
 
 // GetPersistentVolumeClaims returns all the PersistentVolumeClaim. If an empty namespace is given, returns all the PersistentVolumeClaim
-func GetPersistentVolumeClaims(contextName string, namespace string) ([]corev1.PersistentVolumeClaim, error) {
+func GetPersistentVolumeClaims(contextName string, impersonation *context.Impersonation, namespace string) ([]corev1.PersistentVolumeClaim, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetPersistentVolumeClaims(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetPersistentVolumeClaims(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetPersistentVolumeClaims(clientset, namespace)
@@ -178,20 +223,23 @@ func GetPersistentVolumeClaims(contextName string, namespace string) ([]corev1.P
 
 // GetPersistentVolumeClaim returns the PersistentVolumeClaim by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetPersistentVolumeClaim(contextName string, namespace string, name string) (*corev1.PersistentVolumeClaim, error) {
+func GetPersistentVolumeClaim(contextName string, impersonation *context.Impersonation, namespace string, name string) (*corev1.PersistentVolumeClaim, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetPersistentVolumeClaims(contextName, namespace); results != nil {
-		for _, persistentVolumeClaim := range results {
-			if persistentVolumeClaim.Name == name {
-				return &persistentVolumeClaim, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetPersistentVolumeClaims(contextName, namespace); results != nil {
+			for _, persistentVolumeClaim := range results {
+				if persistentVolumeClaim.Name == name {
+					return &persistentVolumeClaim, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetPersistentVolumeClaim(clientset, namespace, name)
@@ -199,9 +247,14 @@ func GetPersistentVolumeClaim(contextName string, namespace string, name string)
 
 // CreatePersistentVolumeClaim creates the PersistentVolumeClaim with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreatePersistentVolumeClaim(contextName string, namespace string, persistentVolumeClaim *corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error) {
+func CreatePersistentVolumeClaim(contextName string, impersonation *context.Impersonation, namespace string, persistentVolumeClaim *corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -211,9 +264,14 @@ func CreatePersistentVolumeClaim(contextName string, namespace string, persisten
 
 // UpdatePersistentVolumeClaim updates the PersistentVolumeClaim with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdatePersistentVolumeClaim(contextName string, namespace string, persistentVolumeClaim *corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error) {
+func UpdatePersistentVolumeClaim(contextName string, impersonation *context.Impersonation, namespace string, persistentVolumeClaim *corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -223,9 +281,14 @@ func UpdatePersistentVolumeClaim(contextName string, namespace string, persisten
 
 // DeletePersistentVolumeClaim deletes the PersistentVolumeClaim by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeletePersistentVolumeClaim(contextName string, namespace string, name string) error {
+func DeletePersistentVolumeClaim(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -234,15 +297,18 @@ func DeletePersistentVolumeClaim(contextName string, namespace string, name stri
 }
 
 // GetConfigMaps returns all the ConfigMap. If an empty namespace is given, returns all the ConfigMap
-func GetConfigMaps(contextName string, namespace string) ([]corev1.ConfigMap, error) {
+func GetConfigMaps(contextName string, impersonation *context.Impersonation, namespace string) ([]corev1.ConfigMap, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetConfigMaps(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetConfigMaps(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetConfigMaps(clientset, namespace)
@@ -250,20 +316,23 @@ func GetConfigMaps(contextName string, namespace string) ([]corev1.ConfigMap, er
 
 // GetConfigMap returns the ConfigMap by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetConfigMap(contextName string, namespace string, name string) (*corev1.ConfigMap, error) {
+func GetConfigMap(contextName string, impersonation *context.Impersonation, namespace string, name string) (*corev1.ConfigMap, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetConfigMaps(contextName, namespace); results != nil {
-		for _, configMap := range results {
-			if configMap.Name == name {
-				return &configMap, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetConfigMaps(contextName, namespace); results != nil {
+			for _, configMap := range results {
+				if configMap.Name == name {
+					return &configMap, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetConfigMap(clientset, namespace, name)
@@ -271,9 +340,14 @@ func GetConfigMap(contextName string, namespace string, name string) (*corev1.Co
 
 // CreateConfigMap creates the ConfigMap with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreateConfigMap(contextName string, namespace string, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+func CreateConfigMap(contextName string, impersonation *context.Impersonation, namespace string, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -283,9 +357,14 @@ func CreateConfigMap(contextName string, namespace string, configMap *corev1.Con
 
 // UpdateConfigMap updates the ConfigMap with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdateConfigMap(contextName string, namespace string, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+func UpdateConfigMap(contextName string, impersonation *context.Impersonation, namespace string, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -295,9 +374,14 @@ func UpdateConfigMap(contextName string, namespace string, configMap *corev1.Con
 
 // DeleteConfigMap deletes the ConfigMap by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeleteConfigMap(contextName string, namespace string, name string) error {
+func DeleteConfigMap(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -306,15 +390,18 @@ func DeleteConfigMap(contextName string, namespace string, name string) error {
 }
 
 // GetReplicationControllers returns all the ReplicationController. If an empty namespace is given, returns all the ReplicationController
-func GetReplicationControllers(contextName string, namespace string) ([]corev1.ReplicationController, error) {
+func GetReplicationControllers(contextName string, impersonation *context.Impersonation, namespace string) ([]corev1.ReplicationController, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetReplicationControllers(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetReplicationControllers(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetReplicationControllers(clientset, namespace)
@@ -322,20 +409,23 @@ func GetReplicationControllers(contextName string, namespace string) ([]corev1.R
 
 // GetReplicationController returns the ReplicationController by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetReplicationController(contextName string, namespace string, name string) (*corev1.ReplicationController, error) {
+func GetReplicationController(contextName string, impersonation *context.Impersonation, namespace string, name string) (*corev1.ReplicationController, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetReplicationControllers(contextName, namespace); results != nil {
-		for _, replicationController := range results {
-			if replicationController.Name == name {
-				return &replicationController, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetReplicationControllers(contextName, namespace); results != nil {
+			for _, replicationController := range results {
+				if replicationController.Name == name {
+					return &replicationController, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetReplicationController(clientset, namespace, name)
@@ -343,9 +433,14 @@ func GetReplicationController(contextName string, namespace string, name string)
 
 // CreateReplicationController creates the ReplicationController with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreateReplicationController(contextName string, namespace string, replicationController *corev1.ReplicationController) (*corev1.ReplicationController, error) {
+func CreateReplicationController(contextName string, impersonation *context.Impersonation, namespace string, replicationController *corev1.ReplicationController) (*corev1.ReplicationController, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -355,9 +450,14 @@ func CreateReplicationController(contextName string, namespace string, replicati
 
 // UpdateReplicationController updates the ReplicationController with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdateReplicationController(contextName string, namespace string, replicationController *corev1.ReplicationController) (*corev1.ReplicationController, error) {
+func UpdateReplicationController(contextName string, impersonation *context.Impersonation, namespace string, replicationController *corev1.ReplicationController) (*corev1.ReplicationController, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -367,9 +467,14 @@ func UpdateReplicationController(contextName string, namespace string, replicati
 
 // DeleteReplicationController deletes the ReplicationController by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeleteReplicationController(contextName string, namespace string, name string) error {
+func DeleteReplicationController(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -378,15 +483,18 @@ func DeleteReplicationController(contextName string, namespace string, name stri
 }
 
 // GetSecrets returns all the Secret. If an empty namespace is given, returns all the Secret
-func GetSecrets(contextName string, namespace string) ([]corev1.Secret, error) {
+func GetSecrets(contextName string, impersonation *context.Impersonation, namespace string) ([]corev1.Secret, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetSecrets(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetSecrets(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetSecrets(clientset, namespace)
@@ -394,20 +502,23 @@ func GetSecrets(contextName string, namespace string) ([]corev1.Secret, error) {
 
 // GetSecret returns the Secret by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetSecret(contextName string, namespace string, name string) (*corev1.Secret, error) {
+func GetSecret(contextName string, impersonation *context.Impersonation, namespace string, name string) (*corev1.Secret, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetSecrets(contextName, namespace); results != nil {
-		for _, secret := range results {
-			if secret.Name == name {
-				return &secret, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetSecrets(contextName, namespace); results != nil {
+			for _, secret := range results {
+				if secret.Name == name {
+					return &secret, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetSecret(clientset, namespace, name)
@@ -415,9 +526,14 @@ func GetSecret(contextName string, namespace string, name string) (*corev1.Secre
 
 // CreateSecret creates the Secret with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreateSecret(contextName string, namespace string, secret *corev1.Secret) (*corev1.Secret, error) {
+func CreateSecret(contextName string, impersonation *context.Impersonation, namespace string, secret *corev1.Secret) (*corev1.Secret, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -427,9 +543,14 @@ func CreateSecret(contextName string, namespace string, secret *corev1.Secret) (
 
 // UpdateSecret updates the Secret with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdateSecret(contextName string, namespace string, secret *corev1.Secret) (*corev1.Secret, error) {
+func UpdateSecret(contextName string, impersonation *context.Impersonation, namespace string, secret *corev1.Secret) (*corev1.Secret, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -439,9 +560,14 @@ func UpdateSecret(contextName string, namespace string, secret *corev1.Secret) (
 
 // DeleteSecret deletes the Secret by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeleteSecret(contextName string, namespace string, name string) error {
+func DeleteSecret(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -450,15 +576,18 @@ func DeleteSecret(contextName string, namespace string, name string) error {
 }
 
 // GetServiceAccounts returns all the ServiceAccount. If an empty namespace is given, returns all the ServiceAccount
-func GetServiceAccounts(contextName string, namespace string) ([]corev1.ServiceAccount, error) {
+func GetServiceAccounts(contextName string, impersonation *context.Impersonation, namespace string) ([]corev1.ServiceAccount, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetServiceAccounts(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetServiceAccounts(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetServiceAccounts(clientset, namespace)
@@ -466,20 +595,23 @@ func GetServiceAccounts(contextName string, namespace string) ([]corev1.ServiceA
 
 // GetServiceAccount returns the ServiceAccount by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetServiceAccount(contextName string, namespace string, name string) (*corev1.ServiceAccount, error) {
+func GetServiceAccount(contextName string, impersonation *context.Impersonation, namespace string, name string) (*corev1.ServiceAccount, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetServiceAccounts(contextName, namespace); results != nil {
-		for _, serviceAccount := range results {
-			if serviceAccount.Name == name {
-				return &serviceAccount, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetServiceAccounts(contextName, namespace); results != nil {
+			for _, serviceAccount := range results {
+				if serviceAccount.Name == name {
+					return &serviceAccount, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetServiceAccount(clientset, namespace, name)
@@ -487,9 +619,14 @@ func GetServiceAccount(contextName string, namespace string, name string) (*core
 
 // CreateServiceAccount creates the ServiceAccount with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreateServiceAccount(contextName string, namespace string, serviceAccount *corev1.ServiceAccount) (*corev1.ServiceAccount, error) {
+func CreateServiceAccount(contextName string, impersonation *context.Impersonation, namespace string, serviceAccount *corev1.ServiceAccount) (*corev1.ServiceAccount, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -499,9 +636,14 @@ func CreateServiceAccount(contextName string, namespace string, serviceAccount *
 
 // UpdateServiceAccount updates the ServiceAccount with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdateServiceAccount(contextName string, namespace string, serviceAccount *corev1.ServiceAccount) (*corev1.ServiceAccount, error) {
+func UpdateServiceAccount(contextName string, impersonation *context.Impersonation, namespace string, serviceAccount *corev1.ServiceAccount) (*corev1.ServiceAccount, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -511,9 +653,14 @@ func UpdateServiceAccount(contextName string, namespace string, serviceAccount *
 
 // DeleteServiceAccount deletes the ServiceAccount by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeleteServiceAccount(contextName string, namespace string, name string) error {
+func DeleteServiceAccount(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -522,15 +669,18 @@ func DeleteServiceAccount(contextName string, namespace string, name string) err
 }
 
 // GetDeployments returns all the Deployment. If an empty namespace is given, returns all the Deployment
-func GetDeployments(contextName string, namespace string) ([]appsv1.Deployment, error) {
+func GetDeployments(contextName string, impersonation *context.Impersonation, namespace string) ([]appsv1.Deployment, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetDeployments(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetDeployments(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetDeployments(clientset, namespace)
@@ -538,20 +688,23 @@ func GetDeployments(contextName string, namespace string) ([]appsv1.Deployment,
 
 // GetDeployment returns the Deployment by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetDeployment(contextName string, namespace string, name string) (*appsv1.Deployment, error) {
+func GetDeployment(contextName string, impersonation *context.Impersonation, namespace string, name string) (*appsv1.Deployment, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetDeployments(contextName, namespace); results != nil {
-		for _, deployment := range results {
-			if deployment.Name == name {
-				return &deployment, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetDeployments(contextName, namespace); results != nil {
+			for _, deployment := range results {
+				if deployment.Name == name {
+					return &deployment, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetDeployment(clientset, namespace, name)
@@ -559,9 +712,14 @@ func GetDeployment(contextName string, namespace string, name string) (*appsv1.D
 
 // CreateDeployment creates the Deployment with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreateDeployment(contextName string, namespace string, deployment *appsv1.Deployment) (*appsv1.Deployment, error) {
+func CreateDeployment(contextName string, impersonation *context.Impersonation, namespace string, deployment *appsv1.Deployment) (*appsv1.Deployment, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -571,9 +729,14 @@ func CreateDeployment(contextName string, namespace string, deployment *appsv1.D
 
 // UpdateDeployment updates the Deployment with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdateDeployment(contextName string, namespace string, deployment *appsv1.Deployment) (*appsv1.Deployment, error) {
+func UpdateDeployment(contextName string, impersonation *context.Impersonation, namespace string, deployment *appsv1.Deployment) (*appsv1.Deployment, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -583,9 +746,14 @@ func UpdateDeployment(contextName string, namespace string, deployment *appsv1.D
 
 // DeleteDeployment deletes the Deployment by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeleteDeployment(contextName string, namespace string, name string) error {
+func DeleteDeployment(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -594,15 +762,18 @@ func DeleteDeployment(contextName string, namespace string, name string) error {
 }
 
 // GetStatefulSets returns all the StatefulSet. If an empty namespace is given, returns all the StatefulSet
-func GetStatefulSets(contextName string, namespace string) ([]appsv1.StatefulSet, error) {
+func GetStatefulSets(contextName string, impersonation *context.Impersonation, namespace string) ([]appsv1.StatefulSet, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetStatefulSets(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetStatefulSets(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetStatefulSets(clientset, namespace)
@@ -610,20 +781,23 @@ func GetStatefulSets(contextName string, namespace string) ([]appsv1.StatefulSet
 
 // GetStatefulSet returns the StatefulSet by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetStatefulSet(contextName string, namespace string, name string) (*appsv1.StatefulSet, error) {
+func GetStatefulSet(contextName string, impersonation *context.Impersonation, namespace string, name string) (*appsv1.StatefulSet, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetStatefulSets(contextName, namespace); results != nil {
-		for _, statefulSet := range results {
-			if statefulSet.Name == name {
-				return &statefulSet, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetStatefulSets(contextName, namespace); results != nil {
+			for _, statefulSet := range results {
+				if statefulSet.Name == name {
+					return &statefulSet, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetStatefulSet(clientset, namespace, name)
@@ -631,9 +805,14 @@ func GetStatefulSet(contextName string, namespace string, name string) (*appsv1.
 
 // CreateStatefulSet creates the StatefulSet with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreateStatefulSet(contextName string, namespace string, statefulSet *appsv1.StatefulSet) (*appsv1.StatefulSet, error) {
+func CreateStatefulSet(contextName string, impersonation *context.Impersonation, namespace string, statefulSet *appsv1.StatefulSet) (*appsv1.StatefulSet, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -643,9 +822,14 @@ func CreateStatefulSet(contextName string, namespace string, statefulSet *appsv1
 
 // UpdateStatefulSet updates the StatefulSet with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdateStatefulSet(contextName string, namespace string, statefulSet *appsv1.StatefulSet) (*appsv1.StatefulSet, error) {
+func UpdateStatefulSet(contextName string, impersonation *context.Impersonation, namespace string, statefulSet *appsv1.StatefulSet) (*appsv1.StatefulSet, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -655,9 +839,14 @@ func UpdateStatefulSet(contextName string, namespace string, statefulSet *appsv1
 
 // DeleteStatefulSet deletes the StatefulSet by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeleteStatefulSet(contextName string, namespace string, name string) error {
+func DeleteStatefulSet(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -666,15 +855,18 @@ func DeleteStatefulSet(contextName string, namespace string, name string) error
 }
 
 // GetDaemonSets returns all the DaemonSet. If an empty namespace is given, returns all the DaemonSet
-func GetDaemonSets(contextName string, namespace string) ([]appsv1.DaemonSet, error) {
+func GetDaemonSets(contextName string, impersonation *context.Impersonation, namespace string) ([]appsv1.DaemonSet, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetDaemonSets(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetDaemonSets(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetDaemonSets(clientset, namespace)
@@ -682,20 +874,23 @@ func GetDaemonSets(contextName string, namespace string) ([]appsv1.DaemonSet, er
 
 // GetDaemonSet returns the DaemonSet by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetDaemonSet(contextName string, namespace string, name string) (*appsv1.DaemonSet, error) {
+func GetDaemonSet(contextName string, impersonation *context.Impersonation, namespace string, name string) (*appsv1.DaemonSet, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetDaemonSets(contextName, namespace); results != nil {
-		for _, daemonSet := range results {
-			if daemonSet.Name == name {
-				return &daemonSet, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetDaemonSets(contextName, namespace); results != nil {
+			for _, daemonSet := range results {
+				if daemonSet.Name == name {
+					return &daemonSet, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetDaemonSet(clientset, namespace, name)
@@ -703,9 +898,14 @@ func GetDaemonSet(contextName string, namespace string, name string) (*appsv1.Da
 
 // CreateDaemonSet creates the DaemonSet with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreateDaemonSet(contextName string, namespace string, daemonSet *appsv1.DaemonSet) (*appsv1.DaemonSet, error) {
+func CreateDaemonSet(contextName string, impersonation *context.Impersonation, namespace string, daemonSet *appsv1.DaemonSet) (*appsv1.DaemonSet, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -715,9 +915,14 @@ func CreateDaemonSet(contextName string, namespace string, daemonSet *appsv1.Dae
 
 // UpdateDaemonSet updates the DaemonSet with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdateDaemonSet(contextName string, namespace string, daemonSet *appsv1.DaemonSet) (*appsv1.DaemonSet, error) {
+func UpdateDaemonSet(contextName string, impersonation *context.Impersonation, namespace string, daemonSet *appsv1.DaemonSet) (*appsv1.DaemonSet, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -727,9 +932,14 @@ func UpdateDaemonSet(contextName string, namespace string, daemonSet *appsv1.Dae
 
 // DeleteDaemonSet deletes the DaemonSet by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeleteDaemonSet(contextName string, namespace string, name string) error {
+func DeleteDaemonSet(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -738,15 +948,18 @@ func DeleteDaemonSet(contextName string, namespace string, name string) error {
 }
 
 // GetReplicaSets returns all the ReplicaSet. If an empty namespace is given, returns all the ReplicaSet
-func GetReplicaSets(contextName string, namespace string) ([]appsv1.ReplicaSet, error) {
+func GetReplicaSets(contextName string, impersonation *context.Impersonation, namespace string) ([]appsv1.ReplicaSet, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetReplicaSets(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetReplicaSets(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetReplicaSets(clientset, namespace)
@@ -754,20 +967,23 @@ func GetReplicaSets(contextName string, namespace string) ([]appsv1.ReplicaSet,
 
 // GetReplicaSet returns the ReplicaSet by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetReplicaSet(contextName string, namespace string, name string) (*appsv1.ReplicaSet, error) {
+func GetReplicaSet(contextName string, impersonation *context.Impersonation, namespace string, name string) (*appsv1.ReplicaSet, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetReplicaSets(contextName, namespace); results != nil {
-		for _, replicaSet := range results {
-			if replicaSet.Name == name {
-				return &replicaSet, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetReplicaSets(contextName, namespace); results != nil {
+			for _, replicaSet := range results {
+				if replicaSet.Name == name {
+					return &replicaSet, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetReplicaSet(clientset, namespace, name)
@@ -775,9 +991,14 @@ func GetReplicaSet(contextName string, namespace string, name string) (*appsv1.R
 
 // CreateReplicaSet creates the ReplicaSet with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreateReplicaSet(contextName string, namespace string, replicaSet *appsv1.ReplicaSet) (*appsv1.ReplicaSet, error) {
+func CreateReplicaSet(contextName string, impersonation *context.Impersonation, namespace string, replicaSet *appsv1.ReplicaSet) (*appsv1.ReplicaSet, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -787,9 +1008,14 @@ func CreateReplicaSet(contextName string, namespace string, replicaSet *appsv1.R
 
 // UpdateReplicaSet updates the ReplicaSet with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdateReplicaSet(contextName string, namespace string, replicaSet *appsv1.ReplicaSet) (*appsv1.ReplicaSet, error) {
+func UpdateReplicaSet(contextName string, impersonation *context.Impersonation, namespace string, replicaSet *appsv1.ReplicaSet) (*appsv1.ReplicaSet, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -799,9 +1025,14 @@ func UpdateReplicaSet(contextName string, namespace string, replicaSet *appsv1.R
 
 // DeleteReplicaSet deletes the ReplicaSet by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeleteReplicaSet(contextName string, namespace string, name string) error {
+func DeleteReplicaSet(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -810,15 +1041,18 @@ func DeleteReplicaSet(contextName string, namespace string, name string) error {
 }
 
 // GetNetworkPolicies returns all the NetworkPolicy. If an empty namespace is given, returns all the NetworkPolicy
-func GetNetworkPolicies(contextName string, namespace string) ([]networkingv1.NetworkPolicy, error) {
+func GetNetworkPolicies(contextName string, impersonation *context.Impersonation, namespace string) ([]networkingv1.NetworkPolicy, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetNetworkPolicies(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetNetworkPolicies(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetNetworkPolicies(clientset, namespace)
@@ -826,20 +1060,23 @@ func GetNetworkPolicies(contextName string, namespace string) ([]networkingv1.Ne
 
 // GetNetworkPolicy returns the NetworkPolicy by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetNetworkPolicy(contextName string, namespace string, name string) (*networkingv1.NetworkPolicy, error) {
+func GetNetworkPolicy(contextName string, impersonation *context.Impersonation, namespace string, name string) (*networkingv1.NetworkPolicy, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetNetworkPolicies(contextName, namespace); results != nil {
-		for _, networkPolicy := range results {
-			if networkPolicy.Name == name {
-				return &networkPolicy, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetNetworkPolicies(contextName, namespace); results != nil {
+			for _, networkPolicy := range results {
+				if networkPolicy.Name == name {
+					return &networkPolicy, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetNetworkPolicy(clientset, namespace, name)
@@ -847,9 +1084,14 @@ func GetNetworkPolicy(contextName string, namespace string, name string) (*netwo
 
 // CreateNetworkPolicy creates the NetworkPolicy with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreateNetworkPolicy(contextName string, namespace string, networkPolicy *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error) {
+func CreateNetworkPolicy(contextName string, impersonation *context.Impersonation, namespace string, networkPolicy *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -859,9 +1101,14 @@ func CreateNetworkPolicy(contextName string, namespace string, networkPolicy *ne
 
 // UpdateNetworkPolicy updates the NetworkPolicy with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdateNetworkPolicy(contextName string, namespace string, networkPolicy *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error) {
+func UpdateNetworkPolicy(contextName string, impersonation *context.Impersonation, namespace string, networkPolicy *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -871,9 +1118,14 @@ func UpdateNetworkPolicy(contextName string, namespace string, networkPolicy *ne
 
 // DeleteNetworkPolicy deletes the NetworkPolicy by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeleteNetworkPolicy(contextName string, namespace string, name string) error {
+func DeleteNetworkPolicy(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -882,15 +1134,18 @@ func DeleteNetworkPolicy(contextName string, namespace string, name string) erro
 }
 
 // GetRoles returns all the Role. If an empty namespace is given, returns all the Role
-func GetRoles(contextName string, namespace string) ([]rbacv1.Role, error) {
+func GetRoles(contextName string, impersonation *context.Impersonation, namespace string) ([]rbacv1.Role, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetRoles(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetRoles(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetRoles(clientset, namespace)
@@ -898,20 +1153,23 @@ func GetRoles(contextName string, namespace string) ([]rbacv1.Role, error) {
 
 // GetRole returns the Role by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetRole(contextName string, namespace string, name string) (*rbacv1.Role, error) {
+func GetRole(contextName string, impersonation *context.Impersonation, namespace string, name string) (*rbacv1.Role, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetRoles(contextName, namespace); results != nil {
-		for _, role := range results {
-			if role.Name == name {
-				return &role, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetRoles(contextName, namespace); results != nil {
+			for _, role := range results {
+				if role.Name == name {
+					return &role, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetRole(clientset, namespace, name)
@@ -919,9 +1177,14 @@ func GetRole(contextName string, namespace string, name string) (*rbacv1.Role, e
 
 // CreateRole creates the Role with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreateRole(contextName string, namespace string, role *rbacv1.Role) (*rbacv1.Role, error) {
+func CreateRole(contextName string, impersonation *context.Impersonation, namespace string, role *rbacv1.Role) (*rbacv1.Role, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -931,9 +1194,14 @@ func CreateRole(contextName string, namespace string, role *rbacv1.Role) (*rbacv
 
 // UpdateRole updates the Role with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdateRole(contextName string, namespace string, role *rbacv1.Role) (*rbacv1.Role, error) {
+func UpdateRole(contextName string, impersonation *context.Impersonation, namespace string, role *rbacv1.Role) (*rbacv1.Role, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -943,9 +1211,14 @@ func UpdateRole(contextName string, namespace string, role *rbacv1.Role) (*rbacv
 
 // DeleteRole deletes the Role by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeleteRole(contextName string, namespace string, name string) error {
+func DeleteRole(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -954,15 +1227,18 @@ func DeleteRole(contextName string, namespace string, name string) error {
 }
 
 // GetRoleBindings returns all the RoleBinding. If an empty namespace is given, returns all the RoleBinding
-func GetRoleBindings(contextName string, namespace string) ([]rbacv1.RoleBinding, error) {
+func GetRoleBindings(contextName string, impersonation *context.Impersonation, namespace string) ([]rbacv1.RoleBinding, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetRoleBindings(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetRoleBindings(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetRoleBindings(clientset, namespace)
@@ -970,20 +1246,23 @@ func GetRoleBindings(contextName string, namespace string) ([]rbacv1.RoleBinding
 
 // GetRoleBinding returns the RoleBinding by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetRoleBinding(contextName string, namespace string, name string) (*rbacv1.RoleBinding, error) {
+func GetRoleBinding(contextName string, impersonation *context.Impersonation, namespace string, name string) (*rbacv1.RoleBinding, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetRoleBindings(contextName, namespace); results != nil {
-		for _, roleBinding := range results {
-			if roleBinding.Name == name {
-				return &roleBinding, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetRoleBindings(contextName, namespace); results != nil {
+			for _, roleBinding := range results {
+				if roleBinding.Name == name {
+					return &roleBinding, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetRoleBinding(clientset, namespace, name)
@@ -991,9 +1270,14 @@ func GetRoleBinding(contextName string, namespace string, name string) (*rbacv1.
 
 // CreateRoleBinding creates the RoleBinding with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreateRoleBinding(contextName string, namespace string, roleBinding *rbacv1.RoleBinding) (*rbacv1.RoleBinding, error) {
+func CreateRoleBinding(contextName string, impersonation *context.Impersonation, namespace string, roleBinding *rbacv1.RoleBinding) (*rbacv1.RoleBinding, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -1003,9 +1287,14 @@ func CreateRoleBinding(contextName string, namespace string, roleBinding *rbacv1
 
 // UpdateRoleBinding updates the RoleBinding with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdateRoleBinding(contextName string, namespace string, roleBinding *rbacv1.RoleBinding) (*rbacv1.RoleBinding, error) {
+func UpdateRoleBinding(contextName string, impersonation *context.Impersonation, namespace string, roleBinding *rbacv1.RoleBinding) (*rbacv1.RoleBinding, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -1015,9 +1304,14 @@ func UpdateRoleBinding(contextName string, namespace string, roleBinding *rbacv1
 
 // DeleteRoleBinding deletes the RoleBinding by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeleteRoleBinding(contextName string, namespace string, name string) error {
+func DeleteRoleBinding(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -1026,15 +1320,18 @@ func DeleteRoleBinding(contextName string, namespace string, name string) error
 }
 
 // GetJobs returns all the Job. If an empty namespace is given, returns all the Job
-func GetJobs(contextName string, namespace string) ([]batchv1.Job, error) {
+func GetJobs(contextName string, impersonation *context.Impersonation, namespace string) ([]batchv1.Job, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetJobs(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetJobs(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetJobs(clientset, namespace)
@@ -1042,20 +1339,23 @@ func GetJobs(contextName string, namespace string) ([]batchv1.Job, error) {
 
 // GetJob returns the Job by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetJob(contextName string, namespace string, name string) (*batchv1.Job, error) {
+func GetJob(contextName string, impersonation *context.Impersonation, namespace string, name string) (*batchv1.Job, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetJobs(contextName, namespace); results != nil {
-		for _, job := range results {
-			if job.Name == name {
-				return &job, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetJobs(contextName, namespace); results != nil {
+			for _, job := range results {
+				if job.Name == name {
+					return &job, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetJob(clientset, namespace, name)
@@ -1063,9 +1363,14 @@ func GetJob(contextName string, namespace string, name string) (*batchv1.Job, er
 
 // CreateJob creates the Job with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreateJob(contextName string, namespace string, job *batchv1.Job) (*batchv1.Job, error) {
+func CreateJob(contextName string, impersonation *context.Impersonation, namespace string, job *batchv1.Job) (*batchv1.Job, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -1075,9 +1380,14 @@ func CreateJob(contextName string, namespace string, job *batchv1.Job) (*batchv1
 
 // UpdateJob updates the Job with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdateJob(contextName string, namespace string, job *batchv1.Job) (*batchv1.Job, error) {
+func UpdateJob(contextName string, impersonation *context.Impersonation, namespace string, job *batchv1.Job) (*batchv1.Job, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -1087,9 +1397,14 @@ func UpdateJob(contextName string, namespace string, job *batchv1.Job) (*batchv1
 
 // DeleteJob deletes the Job by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeleteJob(contextName string, namespace string, name string) error {
+func DeleteJob(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
@@ -1098,15 +1413,18 @@ func DeleteJob(contextName string, namespace string, name string) error {
 }
 
 // GetCronJobs returns all the CronJob. If an empty namespace is given, returns all the CronJob
-func GetCronJobs(contextName string, namespace string) ([]batchv1beta1.CronJob, error) {
+func GetCronJobs(contextName string, impersonation *context.Impersonation, namespace string) ([]batchv1beta1.CronJob, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetCronJobs(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetCronJobs(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetCronJobs(clientset, namespace)
@@ -1114,20 +1432,23 @@ func GetCronJobs(contextName string, namespace string) ([]batchv1beta1.CronJob,
 
 // GetCronJob returns the CronJob by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetCronJob(contextName string, namespace string, name string) (*batchv1beta1.CronJob, error) {
+func GetCronJob(contextName string, impersonation *context.Impersonation, namespace string, name string) (*batchv1beta1.CronJob, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetCronJobs(contextName, namespace); results != nil {
-		for _, cronJob := range results {
-			if cronJob.Name == name {
-				return &cronJob, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetCronJobs(contextName, namespace); results != nil {
+			for _, cronJob := range results {
+				if cronJob.Name == name {
+					return &cronJob, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetCronJob(clientset, namespace, name)
@@ -1135,9 +1456,14 @@ func GetCronJob(contextName string, namespace string, name string) (*batchv1beta
 
 // CreateCronJob creates the CronJob with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func CreateCronJob(contextName string, namespace string, cronJob *batchv1beta1.CronJob) (*batchv1beta1.CronJob, error) {
+func CreateCronJob(contextName string, impersonation *context.Impersonation, namespace string, cronJob *batchv1beta1.CronJob) (*batchv1beta1.CronJob, error) {
 
-	clientset, err := context.GetClientset(contextName)
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
+
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -1147,9 +1473,14 @@ func CreateCronJob(contextName string, namespace string, cronJob *batchv1beta1.C
 
 // UpdateCronJob updates the CronJob with the given model. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func UpdateCronJob(contextName string, namespace string, cronJob *batchv1beta1.CronJob) (*batchv1beta1.CronJob, error) {
+func UpdateCronJob(contextName string, impersonation *context.Impersonation, namespace string, cronJob *batchv1beta1.CronJob) (*batchv1beta1.CronJob, error) {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return nil, err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -1159,9 +1490,14 @@ func UpdateCronJob(contextName string, namespace string, cronJob *batchv1beta1.C
 
 // DeleteCronJob deletes the CronJob by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func DeleteCronJob(contextName string, namespace string, name string) error {
+func DeleteCronJob(contextName string, impersonation *context.Impersonation, namespace string, name string) error {
+
+	// Refuse the modifications of the read-only contexts
+	if err := context.CheckWritable(contextName); err != nil {
+		return err
+	}
 
-	clientset, err := context.GetClientset(contextName)
+	clientset, err := context.GetImpersonatedClientset(contextName, impersonation)
 	if err != nil {
 		return err
 	}
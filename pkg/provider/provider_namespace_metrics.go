@@ -2,7 +2,7 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 //
-// This file was generated by gen_provider_namespace_metrics.go at 2019-06-17 12:12:21.420661194 +0300 EEST m=+0.000836258
+// This file was generated by gen_provider_namespace_metrics.go at 2026-10-18 06:04:39.713946562 +0000 UTC m=+0.000730127
 package provider
 
 import (
@@ -13,15 +13,18 @@ import (
 )
 
 // GetPodMetricses returns all the PodMetrics. If an empty namespace is given, returns all the PodMetrics
-func GetPodMetricses(contextName string, namespace string) ([]metricsv1beta1.PodMetrics, error) {
+func GetPodMetricses(contextName string, impersonation *context.Impersonation, namespace string) ([]metricsv1beta1.PodMetrics, error) {
 
-	metrics, err := context.GetMetrics(contextName)
+	metrics, err := context.GetImpersonatedMetrics(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetPodMetricses(contextName, namespace); results != nil {
-		return results, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetPodMetricses(contextName, namespace); results != nil {
+			return results, nil
+		}
 	}
 
 	return connector.GetPodMetricses(metrics, namespace)
@@ -29,20 +32,23 @@ func GetPodMetricses(contextName string, namespace string) ([]metricsv1beta1.Pod
 
 // GetPodMetrics returns the PodMetrics by its name. An optional namespace can be given, if none is given
 // the operation takes place in the default name space.
-func GetPodMetrics(contextName string, namespace string, name string) (*metricsv1beta1.PodMetrics, error) {
+func GetPodMetrics(contextName string, impersonation *context.Impersonation, namespace string, name string) (*metricsv1beta1.PodMetrics, error) {
 
-	metrics, err := context.GetMetrics(contextName)
+	metrics, err := context.GetImpersonatedMetrics(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	if results := event.GetPodMetricses(contextName, namespace); results != nil {
-		for _, podMetrics := range results {
-			if podMetrics.Name == name {
-				return &podMetrics, nil
+	// The cache of the events is filled on behalf of the application, so it is not used when impersonating
+	if impersonation == nil {
+		if results := event.GetPodMetricses(contextName, namespace); results != nil {
+			for _, podMetrics := range results {
+				if podMetrics.Name == name {
+					return &podMetrics, nil
+				}
 			}
+			return nil, nil
 		}
-		return nil, nil
 	}
 
 	return connector.GetPodMetrics(metrics, namespace, name)
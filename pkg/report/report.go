@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/twuillemin/kuboxy/pkg/context"
 	"github.com/twuillemin/kuboxy/pkg/provider"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -16,27 +17,28 @@ import (
 	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
-// BuildReport builds a complete status report from a cluster status
-func BuildReport(contextName string) (*ClusterStateReport, error) {
+// BuildReport builds a complete status report from a cluster status. If an impersonation is given, the cluster is
+// queried on behalf of this identity
+func BuildReport(contextName string, impersonation *context.Impersonation) (*ClusterStateReport, error) {
 
 	start := time.Now()
 
-	namespaces, err := provider.GetNamespaces(contextName)
+	namespaces, err := provider.GetNamespaces(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	nodes, err := provider.GetNodes(contextName)
+	nodes, err := provider.GetNodes(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	nodeMetricses, err := provider.GetNodeMetricses(contextName)
+	nodeMetricses, err := provider.GetNodeMetricses(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
 
-	persistentVolumes, err := provider.GetPersistentVolumes(contextName)
+	persistentVolumes, err := provider.GetPersistentVolumes(contextName, impersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -51,31 +53,31 @@ func BuildReport(contextName string) (*ClusterStateReport, error) {
 
 	// Add the information for each namespace
 	for _, namespace := range namespaces {
-		namespaceServices, e := provider.GetServices(contextName, namespace.Name)
+		namespaceServices, e := provider.GetServices(contextName, impersonation, namespace.Name)
 		if e != nil {
 			return nil, e
 		}
-		namespacePods, e := provider.GetPods(contextName, namespace.Name)
+		namespacePods, e := provider.GetPods(contextName, impersonation, namespace.Name)
 		if e != nil {
 			return nil, e
 		}
-		namespaceDeployments, e := provider.GetDeployments(contextName, namespace.Name)
+		namespaceDeployments, e := provider.GetDeployments(contextName, impersonation, namespace.Name)
 		if e != nil {
 			return nil, e
 		}
-		namespacePodMetricses, e := provider.GetPodMetricses(contextName, namespace.Name)
+		namespacePodMetricses, e := provider.GetPodMetricses(contextName, impersonation, namespace.Name)
 		if e != nil {
 			return nil, e
 		}
-		namespacePersistentVolumeClaims, e := provider.GetPersistentVolumeClaims(contextName, namespace.Name)
+		namespacePersistentVolumeClaims, e := provider.GetPersistentVolumeClaims(contextName, impersonation, namespace.Name)
 		if e != nil {
 			return nil, e
 		}
-		namespaceConfigMaps, e := provider.GetConfigMaps(contextName, namespace.Name)
+		namespaceConfigMaps, e := provider.GetConfigMaps(contextName, impersonation, namespace.Name)
 		if e != nil {
 			return nil, e
 		}
-		namespaceSecrets, e := provider.GetSecrets(contextName, namespace.Name)
+		namespaceSecrets, e := provider.GetSecrets(contextName, impersonation, namespace.Name)
 		if e != nil {
 			return nil, e
 		}
@@ -47,14 +47,15 @@ var builderTemplate = template.Must(template.New("").Parse(`// Package search re
 package search
 
 import (
+	"github.com/twuillemin/kuboxy/pkg/context"
 	"github.com/twuillemin/kuboxy/pkg/provider"
 	"github.com/twuillemin/kuboxy/pkg/types"
 )
 
-func searchClusterObjects(contextName string, searchParameter *preparedParameter, results map[types.ObjectType][]interface{}) error {
+func searchClusterObjects(contextName string, impersonation *context.Impersonation, searchParameter *preparedParameter, results map[types.ObjectType][]interface{}) error {
 {{ range .ObjectDefinitions }}
 	if _, ok := searchParameter.objectTypes[types.{{ .Name }}]; ok {
-		{{ .PluralVariable }}, err := provider.Get{{ .Plural }}(contextName)
+		{{ .PluralVariable }}, err := provider.Get{{ .Plural }}(contextName, impersonation)
 		if err != nil {
 			return err
 		}
@@ -4,6 +4,7 @@ package search
 //go:generate go run gen/gen_search_namespace.go
 
 import (
+	"github.com/twuillemin/kuboxy/pkg/context"
 	"github.com/twuillemin/kuboxy/pkg/types"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"regexp"
@@ -26,8 +27,9 @@ type preparedParameter struct {
 	objectTypes map[types.ObjectType]bool
 }
 
-// Search searches all objects matching the given parameters
-func Search(contextName string, parameter Parameter) (map[types.ObjectType][]interface{}, error) {
+// Search searches all objects matching the given parameters. If an impersonation is given, the cluster is queried
+// on behalf of this identity
+func Search(contextName string, impersonation *context.Impersonation, parameter Parameter) (map[types.ObjectType][]interface{}, error) {
 
 	searchParameter, err := prepareParameters(parameter)
 	if err != nil {
@@ -38,12 +40,12 @@ func Search(contextName string, parameter Parameter) (map[types.ObjectType][]int
 
 	// If a namespace is specified, do not search the object at the cluster level as they don't have namespace...
 	if searchParameter.Namespace == nil {
-		err = searchClusterObjects(contextName, searchParameter, results)
+		err = searchClusterObjects(contextName, impersonation, searchParameter, results)
 		if err != nil {
 			return nil, err
 		}
 	}
-	err = searchNamespaceObjects(contextName, searchParameter, results)
+	err = searchNamespaceObjects(contextName, impersonation, searchParameter, results)
 	if err != nil {
 		return nil, err
 	}
@@ -2,18 +2,19 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 //
-// This file was generated by gen_search_cluster.go at 2019-06-17 12:12:39.066636271 +0300 EEST m=+0.000670459
+// This file was generated by gen_search_cluster.go at 2026-10-18 06:02:26.771405361 +0000 UTC m=+0.000743669
 package search
 
 import (
+	"github.com/twuillemin/kuboxy/pkg/context"
 	"github.com/twuillemin/kuboxy/pkg/provider"
 	"github.com/twuillemin/kuboxy/pkg/types"
 )
 
-func searchClusterObjects(contextName string, searchParameter *preparedParameter, results map[types.ObjectType][]interface{}) error {
+func searchClusterObjects(contextName string, impersonation *context.Impersonation, searchParameter *preparedParameter, results map[types.ObjectType][]interface{}) error {
 
 	if _, ok := searchParameter.objectTypes[types.Namespace]; ok {
-		namespaces, err := provider.GetNamespaces(contextName)
+		namespaces, err := provider.GetNamespaces(contextName, impersonation)
 		if err != nil {
 			return err
 		}
@@ -27,7 +28,7 @@ func searchClusterObjects(contextName string, searchParameter *preparedParameter
 	}
 
 	if _, ok := searchParameter.objectTypes[types.Node]; ok {
-		nodes, err := provider.GetNodes(contextName)
+		nodes, err := provider.GetNodes(contextName, impersonation)
 		if err != nil {
 			return err
 		}
@@ -41,7 +42,7 @@ func searchClusterObjects(contextName string, searchParameter *preparedParameter
 	}
 
 	if _, ok := searchParameter.objectTypes[types.PersistentVolume]; ok {
-		persistentVolumes, err := provider.GetPersistentVolumes(contextName)
+		persistentVolumes, err := provider.GetPersistentVolumes(contextName, impersonation)
 		if err != nil {
 			return err
 		}
@@ -55,7 +56,7 @@ func searchClusterObjects(contextName string, searchParameter *preparedParameter
 	}
 
 	if _, ok := searchParameter.objectTypes[types.ClusterRole]; ok {
-		clusterRoles, err := provider.GetClusterRoles(contextName)
+		clusterRoles, err := provider.GetClusterRoles(contextName, impersonation)
 		if err != nil {
 			return err
 		}
@@ -69,7 +70,7 @@ func searchClusterObjects(contextName string, searchParameter *preparedParameter
 	}
 
 	if _, ok := searchParameter.objectTypes[types.ClusterRoleBinding]; ok {
-		clusterRoleBindings, err := provider.GetClusterRoleBindings(contextName)
+		clusterRoleBindings, err := provider.GetClusterRoleBindings(contextName, impersonation)
 		if err != nil {
 			return err
 		}
@@ -83,7 +84,7 @@ func searchClusterObjects(contextName string, searchParameter *preparedParameter
 	}
 
 	if _, ok := searchParameter.objectTypes[types.StorageClass]; ok {
-		storageClasses, err := provider.GetStorageClasses(contextName)
+		storageClasses, err := provider.GetStorageClasses(contextName, impersonation)
 		if err != nil {
 			return err
 		}
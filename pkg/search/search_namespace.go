@@ -2,18 +2,19 @@
 //
 // Code generated by go generate; DO NOT EDIT.
 //
-// This file was generated by gen_search_namespace.go at 2019-06-17 12:12:39.322690631 +0300 EEST m=+0.000839105
+// This file was generated by gen_search_namespace.go at 2026-10-18 06:02:27.113713444 +0000 UTC m=+0.006805614
 package search
 
 import (
+	"github.com/twuillemin/kuboxy/pkg/context"
 	"github.com/twuillemin/kuboxy/pkg/provider"
 	"github.com/twuillemin/kuboxy/pkg/types"
 )
 
-func searchNamespaceObjects(contextName string, searchParameter *preparedParameter, results map[types.ObjectType][]interface{}) error {
+func searchNamespaceObjects(contextName string, impersonation *context.Impersonation, searchParameter *preparedParameter, results map[types.ObjectType][]interface{}) error {
 
 	if _, ok := searchParameter.objectTypes[types.Service]; ok {
-		services, err := provider.GetServices(contextName, "")
+		services, err := provider.GetServices(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}
@@ -27,7 +28,7 @@ func searchNamespaceObjects(contextName string, searchParameter *preparedParamet
 	}
 
 	if _, ok := searchParameter.objectTypes[types.Pod]; ok {
-		pods, err := provider.GetPods(contextName, "")
+		pods, err := provider.GetPods(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}
@@ -41,7 +42,7 @@ func searchNamespaceObjects(contextName string, searchParameter *preparedParamet
 	}
 
 	if _, ok := searchParameter.objectTypes[types.PersistentVolumeClaim]; ok {
-		persistentVolumeClaims, err := provider.GetPersistentVolumeClaims(contextName, "")
+		persistentVolumeClaims, err := provider.GetPersistentVolumeClaims(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}
@@ -55,7 +56,7 @@ func searchNamespaceObjects(contextName string, searchParameter *preparedParamet
 	}
 
 	if _, ok := searchParameter.objectTypes[types.ConfigMap]; ok {
-		configMaps, err := provider.GetConfigMaps(contextName, "")
+		configMaps, err := provider.GetConfigMaps(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}
@@ -69,7 +70,7 @@ func searchNamespaceObjects(contextName string, searchParameter *preparedParamet
 	}
 
 	if _, ok := searchParameter.objectTypes[types.ReplicationController]; ok {
-		replicationControllers, err := provider.GetReplicationControllers(contextName, "")
+		replicationControllers, err := provider.GetReplicationControllers(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}
@@ -83,7 +84,7 @@ func searchNamespaceObjects(contextName string, searchParameter *preparedParamet
 	}
 
 	if _, ok := searchParameter.objectTypes[types.Secret]; ok {
-		secrets, err := provider.GetSecrets(contextName, "")
+		secrets, err := provider.GetSecrets(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}
@@ -97,7 +98,7 @@ func searchNamespaceObjects(contextName string, searchParameter *preparedParamet
 	}
 
 	if _, ok := searchParameter.objectTypes[types.ServiceAccount]; ok {
-		serviceAccounts, err := provider.GetServiceAccounts(contextName, "")
+		serviceAccounts, err := provider.GetServiceAccounts(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}
@@ -111,7 +112,7 @@ func searchNamespaceObjects(contextName string, searchParameter *preparedParamet
 	}
 
 	if _, ok := searchParameter.objectTypes[types.Deployment]; ok {
-		deployments, err := provider.GetDeployments(contextName, "")
+		deployments, err := provider.GetDeployments(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}
@@ -125,7 +126,7 @@ func searchNamespaceObjects(contextName string, searchParameter *preparedParamet
 	}
 
 	if _, ok := searchParameter.objectTypes[types.StatefulSet]; ok {
-		statefulSets, err := provider.GetStatefulSets(contextName, "")
+		statefulSets, err := provider.GetStatefulSets(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}
@@ -139,7 +140,7 @@ func searchNamespaceObjects(contextName string, searchParameter *preparedParamet
 	}
 
 	if _, ok := searchParameter.objectTypes[types.DaemonSet]; ok {
-		daemonSets, err := provider.GetDaemonSets(contextName, "")
+		daemonSets, err := provider.GetDaemonSets(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}
@@ -153,7 +154,7 @@ func searchNamespaceObjects(contextName string, searchParameter *preparedParamet
 	}
 
 	if _, ok := searchParameter.objectTypes[types.ReplicaSet]; ok {
-		replicaSets, err := provider.GetReplicaSets(contextName, "")
+		replicaSets, err := provider.GetReplicaSets(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}
@@ -167,7 +168,7 @@ func searchNamespaceObjects(contextName string, searchParameter *preparedParamet
 	}
 
 	if _, ok := searchParameter.objectTypes[types.NetworkPolicy]; ok {
-		networkPolicies, err := provider.GetNetworkPolicies(contextName, "")
+		networkPolicies, err := provider.GetNetworkPolicies(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}
@@ -181,7 +182,7 @@ func searchNamespaceObjects(contextName string, searchParameter *preparedParamet
 	}
 
 	if _, ok := searchParameter.objectTypes[types.Role]; ok {
-		roles, err := provider.GetRoles(contextName, "")
+		roles, err := provider.GetRoles(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}
@@ -195,7 +196,7 @@ func searchNamespaceObjects(contextName string, searchParameter *preparedParamet
 	}
 
 	if _, ok := searchParameter.objectTypes[types.RoleBinding]; ok {
-		roleBindings, err := provider.GetRoleBindings(contextName, "")
+		roleBindings, err := provider.GetRoleBindings(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}
@@ -209,7 +210,7 @@ func searchNamespaceObjects(contextName string, searchParameter *preparedParamet
 	}
 
 	if _, ok := searchParameter.objectTypes[types.Job]; ok {
-		jobs, err := provider.GetJobs(contextName, "")
+		jobs, err := provider.GetJobs(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}
@@ -223,7 +224,7 @@ func searchNamespaceObjects(contextName string, searchParameter *preparedParamet
 	}
 
 	if _, ok := searchParameter.objectTypes[types.CronJob]; ok {
-		cronJobs, err := provider.GetCronJobs(contextName, "")
+		cronJobs, err := provider.GetCronJobs(contextName, impersonation, "")
 		if err != nil {
 			return err
 		}